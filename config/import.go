@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2019. Aberic - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package config
+
+import (
+	"fmt"
+	"github.com/aberic/fabric-client/geneses"
+	"github.com/aberic/fabric-client/grpc/proto/generate"
+	"github.com/aberic/gnomon"
+	"path/filepath"
+)
+
+// InitImportConfig 根据导入联盟的crypto-config组织生成连接配置
+//
+// 仅在conn中设置了访问地址的节点会被加入配置，通道信息需另行设置
+func (c *Config) InitImportConfig(leagueDomain string, orgs []*generate.ImportedOrg, conn *generate.ImportConnection) error {
+	userName := conn.UserName
+	if userName == "" {
+		userName = "Admin"
+	}
+	var clientOrg *generate.ImportedOrg
+	for _, org := range orgs {
+		if org.OrgType == generate.OrgType_Peer && org.Name == conn.OrgName {
+			clientOrg = org
+		}
+	}
+	if nil == clientOrg {
+		return fmt.Errorf("client org %s is not imported", conn.OrgName)
+	}
+	_, clientUserPath := geneses.CryptoOrgAndNodePath(leagueDomain, clientOrg.Domain, clientOrg.Name, userName, true, geneses.CcnUser)
+	if !gnomon.File().PathExists(clientUserPath) {
+		return fmt.Errorf("user %s is not exist in client org %s", userName, conn.OrgName)
+	}
+	c.InitClient(conn.Tls, clientOrg.Name, conn.Level, geneses.CryptoConfigPath(leagueDomain),
+		filepath.Join(clientUserPath, "tls", "client.key"), filepath.Join(clientUserPath, "tls", "client.crt"))
+	for _, org := range orgs {
+		isPeer := org.OrgType == generate.OrgType_Peer
+		orgPath := filepath.Dir(geneses.CryptoOrgMspPath(leagueDomain, org.Domain, org.Name, isPeer))
+		users := map[string]string{}
+		var cryptoPath string
+		for _, user := range org.Users {
+			_, userPath := geneses.CryptoOrgAndNodePath(leagueDomain, org.Domain, org.Name, user, isPeer, geneses.CcnUser)
+			certPath, err := firstFilePath(filepath.Join(userPath, "msp", "signcerts"))
+			if nil != err {
+				return err
+			}
+			users[user] = certPath
+			if cryptoPath == "" || user == userName {
+				cryptoPath = filepath.Join(userPath, "msp")
+			}
+		}
+		tlsCACerts, err := firstFilePath(filepath.Join(orgPath, "msp", "tlscacerts"))
+		if nil != err && conn.Tls {
+			return err
+		}
+		var peers []string
+		for _, node := range org.Nodes {
+			host := geneses.NodeDomain(org.Name, org.Domain, node)
+			if isPeer {
+				if url, ok := conn.PeerURLs[host]; ok {
+					c.AddOrSetPeer(host, url, "", host, "0s", "20s", tlsCACerts, false, false, false)
+					peers = append(peers, host)
+				}
+			} else if url, ok := conn.OrdererURLs[host]; ok {
+				c.AddOrSetOrderer(host, url, host, "0s", "20s", tlsCACerts, false, false, false)
+			}
+		}
+		if isPeer {
+			c.AddOrSetOrgForOrganizations(org.Name, org.MspID, cryptoPath, users, peers, nil)
+		} else {
+			c.AddOrSetOrdererForOrganizations(org.Name, org.MspID, cryptoPath, users)
+		}
+	}
+	return nil
+}
+
+// firstFilePath 返回目录下首个文件路径，如msp/signcerts下的证书
+func firstFilePath(dirPath string) (string, error) {
+	fileNames, err := gnomon.File().LoopFileNames(dirPath)
+	if nil != err {
+		return "", err
+	}
+	if len(fileNames) == 0 {
+		return "", fmt.Errorf("%s is empty", dirPath)
+	}
+	return filepath.Join(dirPath, fileNames[0]), nil
+}
@@ -0,0 +1,179 @@
+/*
+ * Copyright (c) 2019. Aberic - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package sdk
+
+import (
+	"fmt"
+	"github.com/hyperledger/fabric-sdk-go/pkg/fabsdk"
+	common2 "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/peer"
+	"golang.org/x/protobuf/proto"
+	"strings"
+)
+
+const aclsKey = "ACLs"
+
+// defaultACLs peer在通道配置未指定资源时采用的默认访问策略，仅包括由通道策略校验的资源
+var defaultACLs = map[string]string{
+	"lscc/ChaincodeExists":           "/Channel/Application/Readers",
+	"lscc/GetDeploymentSpec":         "/Channel/Application/Readers",
+	"lscc/GetChaincodeData":          "/Channel/Application/Readers",
+	"lscc/GetInstantiatedChaincodes": "/Channel/Application/Readers",
+	"lscc/GetCollectionsConfig":      "/Channel/Application/Readers",
+	"qscc/GetChainInfo":              "/Channel/Application/Readers",
+	"qscc/GetBlockByNumber":          "/Channel/Application/Readers",
+	"qscc/GetBlockByHash":            "/Channel/Application/Readers",
+	"qscc/GetTransactionByID":        "/Channel/Application/Readers",
+	"qscc/GetBlockByTxID":            "/Channel/Application/Readers",
+	"cscc/GetConfigBlock":            "/Channel/Application/Readers",
+	"cscc/GetConfigTree":             "/Channel/Application/Readers",
+	"cscc/SimulateConfigTreeUpdate":  "/Channel/Application/Writers",
+	"peer/Propose":                   "/Channel/Application/Writers",
+	"peer/ChaincodeToChaincode":      "/Channel/Application/Writers",
+	"event/Block":                    "/Channel/Application/Readers",
+	"event/FilteredBlock":            "/Channel/Application/Readers",
+}
+
+// acls 获取通道当前生效的资源访问策略，包括通道配置中指定的ACL及未指定资源的默认策略
+func acls(orgName, orgUser, channelID, peerName string, sdk *fabsdk.FabricSDK) *Result {
+	result := Result{}
+	var (
+		config *common2.Config
+		group  *common2.ConfigGroup
+		aclMap map[string]string
+		err    error
+	)
+	if config, err = channelConfig(orgName, orgUser, channelID, peerName, sdk); nil != err {
+		result.Fail(err.Error())
+		return &result
+	}
+	if group = config.ChannelGroup.Groups["Application"]; nil == group {
+		result.Fail("config group Application is not exist")
+		return &result
+	}
+	if aclMap, err = effectiveACLs(group); nil != err {
+		result.Fail(err.Error())
+		return &result
+	}
+	result.Success(aclMap)
+	return &result
+}
+
+// updateACLs 校验策略路径后提交资源访问策略修改
+func updateACLs(orderURL, orgName, orgUser, channelID, peerName string, aclMap map[string]string,
+	signers []*ConfigSigner, sdk *fabsdk.FabricSDK) (string, error) {
+	return updateChannelConfig(orderURL, orgName, orgUser, channelID, peerName, signers, sdk,
+		func(config *common2.Config) error {
+			return setACLs(config, aclMap)
+		})
+}
+
+// effectiveACLs 以默认访问策略为基础合并Application配置组中指定的ACL，配置中指定的ACL优先
+func effectiveACLs(group *common2.ConfigGroup) (map[string]string, error) {
+	aclMap, err := groupACLs(group)
+	if nil != err {
+		return nil, err
+	}
+	for resource, policyRef := range defaultACLs {
+		if _, ok := aclMap[resource]; !ok {
+			aclMap[resource] = policyRef
+		}
+	}
+	return aclMap, nil
+}
+
+// groupACLs 解析Application配置组中的ACL映射
+func groupACLs(group *common2.ConfigGroup) (map[string]string, error) {
+	aclMap := map[string]string{}
+	value, ok := group.Values[aclsKey]
+	if !ok {
+		return aclMap, nil
+	}
+	acls := &peer.ACLs{}
+	if err := proto.Unmarshal(value.Value, acls); nil != err {
+		return nil, err
+	}
+	for resource, apiResource := range acls.Acls {
+		aclMap[resource] = apiResource.PolicyRef
+	}
+	return aclMap, nil
+}
+
+// setACLs 将aclMap合并至通道配置的ACL中，引用的策略必须存在于配置中
+func setACLs(config *common2.Config, aclMap map[string]string) error {
+	if len(aclMap) == 0 {
+		return fmt.Errorf("acls is empty")
+	}
+	group := config.ChannelGroup.Groups["Application"]
+	if nil == group {
+		return fmt.Errorf("config group Application is not exist")
+	}
+	current, err := groupACLs(group)
+	if nil != err {
+		return err
+	}
+	for resource, policyRef := range aclMap {
+		if resource == "" {
+			return fmt.Errorf("acl resource is empty")
+		}
+		if err = checkPolicyPath(config, policyRef); nil != err {
+			return fmt.Errorf("acl %s: %s", resource, err.Error())
+		}
+		current[resource] = policyRef
+	}
+	acls := &peer.ACLs{Acls: map[string]*peer.APIResource{}}
+	for resource, policyRef := range current {
+		acls.Acls[resource] = &peer.APIResource{PolicyRef: policyRef}
+	}
+	aclsBytes, err := proto.Marshal(acls)
+	if nil != err {
+		return err
+	}
+	modPolicy := "Admins"
+	if value, ok := group.Values[aclsKey]; ok && value.ModPolicy != "" {
+		modPolicy = value.ModPolicy
+	}
+	if nil == group.Values {
+		group.Values = map[string]*common2.ConfigValue{}
+	}
+	group.Values[aclsKey] = &common2.ConfigValue{Value: aclsBytes, ModPolicy: modPolicy}
+	return nil
+}
+
+// checkPolicyPath 校验策略路径是否存在，非“/”开头的路径相对于/Channel/Application
+func checkPolicyPath(config *common2.Config, policyRef string) error {
+	if policyRef == "" {
+		return fmt.Errorf("policy path is empty")
+	}
+	path := policyRef
+	if !strings.HasPrefix(path, "/") {
+		path = "/Channel/Application/" + path
+	}
+	elements := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if len(elements) < 2 || elements[0] != "Channel" {
+		return fmt.Errorf("policy path %s is invalid", policyRef)
+	}
+	group := config.ChannelGroup
+	for _, name := range elements[1 : len(elements)-1] {
+		if group = group.Groups[name]; nil == group {
+			return fmt.Errorf("policy path %s is not exist, group %s not found", policyRef, name)
+		}
+	}
+	if _, ok := group.Policies[elements[len(elements)-1]]; !ok {
+		return fmt.Errorf("policy path %s is not exist", policyRef)
+	}
+	return nil
+}
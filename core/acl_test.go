@@ -0,0 +1,79 @@
+/*
+ * Copyright (c) 2019. Aberic - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdk
+
+import (
+	common2 "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/peer"
+	"golang.org/x/protobuf/proto"
+	"testing"
+)
+
+func TestCheckPolicyPath(t *testing.T) {
+	config := &common2.Config{ChannelGroup: &common2.ConfigGroup{
+		Groups: map[string]*common2.ConfigGroup{
+			"Application": {
+				Groups:   map[string]*common2.ConfigGroup{"Org1MSP": {Policies: map[string]*common2.ConfigPolicy{"Admins": {}}}},
+				Policies: map[string]*common2.ConfigPolicy{"Readers": {}, "Writers": {}},
+			},
+		},
+		Policies: map[string]*common2.ConfigPolicy{"Readers": {}},
+	}}
+	for _, path := range []string{"Readers", "/Channel/Application/Writers", "/Channel/Application/Org1MSP/Admins", "/Channel/Readers"} {
+		if err := checkPolicyPath(config, path); nil != err {
+			t.Error(err)
+		}
+	}
+	for _, path := range []string{"", "Admins", "/Channel/Orderer/Readers", "/Application/Readers"} {
+		if err := checkPolicyPath(config, path); nil == err {
+			t.Error("policy path should not exist", path)
+		}
+	}
+	// 已有ACL及其ModPolicy在合并后保留
+	existing, err := proto.Marshal(&peer.ACLs{Acls: map[string]*peer.APIResource{
+		"lscc/GetDeploymentSpec": {PolicyRef: "/Channel/Application/Readers"},
+		"qscc/GetBlockByNumber":  {PolicyRef: "/Channel/Application/Readers"},
+	}})
+	if nil != err {
+		t.Fatal(err)
+	}
+	application := config.ChannelGroup.Groups["Application"]
+	application.Values = map[string]*common2.ConfigValue{aclsKey: {Value: existing, ModPolicy: "Writers"}}
+	if err = setACLs(config, map[string]string{"qscc/GetBlockByNumber": "/Channel/Application/Org1MSP/Admins"}); nil != err {
+		t.Fatal(err)
+	}
+	aclMap, err := groupACLs(application)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if len(aclMap) != 2 || aclMap["qscc/GetBlockByNumber"] != "/Channel/Application/Org1MSP/Admins" ||
+		aclMap["lscc/GetDeploymentSpec"] != "/Channel/Application/Readers" {
+		t.Errorf("acls should update qscc/GetBlockByNumber and keep existing entries, got %v", aclMap)
+	}
+	// 生效的ACL包括未在配置中指定资源的默认策略
+	if aclMap, err = effectiveACLs(application); nil != err {
+		t.Fatal(err)
+	}
+	if len(aclMap) != len(defaultACLs) || aclMap["qscc/GetBlockByNumber"] != "/Channel/Application/Org1MSP/Admins" ||
+		aclMap["peer/Propose"] != "/Channel/Application/Writers" {
+		t.Errorf("effective acls should merge defaults with configured entries, got %v", aclMap)
+	}
+	if modPolicy := application.Values[aclsKey].ModPolicy; modPolicy != "Writers" {
+		t.Errorf("acls mod policy should be kept, got %s", modPolicy)
+	}
+	if err = setACLs(config, map[string]string{"qscc/GetChainInfo": "/Channel/Orderer/Readers"}); nil == err {
+		t.Error("acl referring to missing policy should be rejected")
+	}
+}
@@ -0,0 +1,258 @@
+/*
+ * Copyright (c) 2019. Aberic - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package sdk
+
+import (
+	"fmt"
+	pb "github.com/aberic/fabric-client/grpc/proto/chain"
+	"github.com/hyperledger/fabric-sdk-go/pkg/fabsdk"
+	common2 "github.com/hyperledger/fabric/protos/common"
+	"golang.org/x/protobuf/proto"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const capabilitiesKey = "Capabilities"
+
+// capabilityLevel 能力等级及启用该能力所需的最低节点版本
+type capabilityLevel struct {
+	name       string
+	minVersion string
+}
+
+// knownCapabilities 各配置层级已知能力等级，按从低到高排列
+var knownCapabilities = map[pb.CapabilityGroup][]*capabilityLevel{
+	pb.CapabilityGroup_GroupChannel: {
+		{name: "V1_1", minVersion: "1.1.0"},
+		{name: "V1_3", minVersion: "1.3.0"},
+		{name: "V1_4_2", minVersion: "1.4.2"},
+		{name: "V1_4_3", minVersion: "1.4.3"},
+		{name: "V2_0", minVersion: "2.0.0"},
+	},
+	pb.CapabilityGroup_GroupOrderer: {
+		{name: "V1_1", minVersion: "1.1.0"},
+		{name: "V1_4_2", minVersion: "1.4.2"},
+		{name: "V2_0", minVersion: "2.0.0"},
+	},
+	pb.CapabilityGroup_GroupApplication: {
+		{name: "V1_1", minVersion: "1.1.0"},
+		{name: "V1_2", minVersion: "1.2.0"},
+		{name: "V1_3", minVersion: "1.3.0"},
+		{name: "V1_4_2", minVersion: "1.4.2"},
+		{name: "V2_0", minVersion: "2.0.0"},
+	},
+}
+
+// capabilities 获取通道当前各层级启用的能力
+func capabilities(orgName, orgUser, channelID, peerName string, sdk *fabsdk.FabricSDK) *Result {
+	result := Result{}
+	var (
+		config *common2.Config
+		caps   *pb.Capabilities
+		err    error
+	)
+	if config, err = channelConfig(orgName, orgUser, channelID, peerName, sdk); nil != err {
+		result.Fail(err.Error())
+		return &result
+	}
+	if caps, err = configCapabilities(config); nil != err {
+		result.Fail(err.Error())
+		return &result
+	}
+	result.Success(caps)
+	return &result
+}
+
+// upgradeCapability 校验并提交通道指定层级的能力升级
+func upgradeCapability(orderURL, orgName, orgUser, channelID, peerName string, group pb.CapabilityGroup,
+	capability, peerVersion, ordererVersion string, signers []*ConfigSigner, sdk *fabsdk.FabricSDK) (string, error) {
+	return updateChannelConfig(orderURL, orgName, orgUser, channelID, peerName, signers, sdk,
+		func(config *common2.Config) error {
+			configGroup, err := capabilityGroup(config, group)
+			if nil != err {
+				return err
+			}
+			current, err := groupCapabilities(configGroup)
+			if nil != err {
+				return err
+			}
+			if err = checkCapability(group, current, capability, peerVersion, ordererVersion); nil != err {
+				return err
+			}
+			return setCapability(configGroup, capability)
+		})
+}
+
+func configCapabilities(config *common2.Config) (*pb.Capabilities, error) {
+	caps := &pb.Capabilities{}
+	for _, group := range []pb.CapabilityGroup{pb.CapabilityGroup_GroupChannel, pb.CapabilityGroup_GroupOrderer,
+		pb.CapabilityGroup_GroupApplication} {
+		configGroup, err := capabilityGroup(config, group)
+		if nil != err {
+			continue
+		}
+		names, err := groupCapabilities(configGroup)
+		if nil != err {
+			return nil, err
+		}
+		switch group {
+		case pb.CapabilityGroup_GroupChannel:
+			caps.Channel = names
+		case pb.CapabilityGroup_GroupOrderer:
+			caps.Orderer = names
+		case pb.CapabilityGroup_GroupApplication:
+			caps.Application = names
+		}
+	}
+	return caps, nil
+}
+
+// capabilityGroup 返回能力层级对应的配置组
+func capabilityGroup(config *common2.Config, group pb.CapabilityGroup) (*common2.ConfigGroup, error) {
+	var configGroup *common2.ConfigGroup
+	switch group {
+	case pb.CapabilityGroup_GroupChannel:
+		configGroup = config.ChannelGroup
+	case pb.CapabilityGroup_GroupOrderer:
+		configGroup = config.ChannelGroup.Groups["Orderer"]
+	case pb.CapabilityGroup_GroupApplication:
+		configGroup = config.ChannelGroup.Groups["Application"]
+	default:
+		return nil, fmt.Errorf("unknown capability group %d", group)
+	}
+	if nil == configGroup {
+		return nil, fmt.Errorf("config group %s is not exist", group.String())
+	}
+	return configGroup, nil
+}
+
+// groupCapabilities 返回配置组中已启用的能力名称
+func groupCapabilities(configGroup *common2.ConfigGroup) ([]string, error) {
+	value, ok := configGroup.Values[capabilitiesKey]
+	if !ok {
+		return []string{}, nil
+	}
+	caps := &common2.Capabilities{}
+	if err := proto.Unmarshal(value.Value, caps); nil != err {
+		return nil, err
+	}
+	names := make([]string, 0, len(caps.Capabilities))
+	for name := range caps.Capabilities {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// setCapability 将配置组能力设置为目标等级，高等级能力已包含低等级能力，无需保留旧值
+func setCapability(configGroup *common2.ConfigGroup, capability string) error {
+	capsBytes, err := proto.Marshal(&common2.Capabilities{
+		Capabilities: map[string]*common2.Capability{capability: {}},
+	})
+	if nil != err {
+		return err
+	}
+	modPolicy := "Admins"
+	if value, ok := configGroup.Values[capabilitiesKey]; ok && value.ModPolicy != "" {
+		modPolicy = value.ModPolicy
+	}
+	if nil == configGroup.Values {
+		configGroup.Values = map[string]*common2.ConfigValue{}
+	}
+	configGroup.Values[capabilitiesKey] = &common2.ConfigValue{Value: capsBytes, ModPolicy: modPolicy}
+	return nil
+}
+
+// checkCapability 校验目标能力是否已知、是否高于当前等级以及网络中节点版本是否支持
+func checkCapability(group pb.CapabilityGroup, current []string, capability, peerVersion, ordererVersion string) error {
+	levels, ok := knownCapabilities[group]
+	if !ok {
+		return fmt.Errorf("unknown capability group %d", group)
+	}
+	target := capabilityIndex(levels, capability)
+	if target < 0 {
+		return fmt.Errorf("capability %s is not supported in %s", capability, group.String())
+	}
+	for _, name := range current {
+		if index := capabilityIndex(levels, name); index >= target {
+			return fmt.Errorf("capability %s is not higher than current %s", capability, name)
+		}
+	}
+	minVersion := levels[target].minVersion
+	if group == pb.CapabilityGroup_GroupChannel || group == pb.CapabilityGroup_GroupApplication {
+		if err := checkVersion("peer", peerVersion, minVersion, capability); nil != err {
+			return err
+		}
+	}
+	if group == pb.CapabilityGroup_GroupChannel || group == pb.CapabilityGroup_GroupOrderer {
+		if err := checkVersion("orderer", ordererVersion, minVersion, capability); nil != err {
+			return err
+		}
+	}
+	return nil
+}
+
+func capabilityIndex(levels []*capabilityLevel, capability string) int {
+	for index, level := range levels {
+		if level.name == capability {
+			return index
+		}
+	}
+	return -1
+}
+
+func checkVersion(nodeType, version, minVersion, capability string) error {
+	if version == "" {
+		return fmt.Errorf("%s version is required to enable capability %s", nodeType, capability)
+	}
+	compare, err := compareVersion(version, minVersion)
+	if nil != err {
+		return err
+	}
+	if compare < 0 {
+		return fmt.Errorf("capability %s requires %s version %s or later, but got %s", capability, nodeType, minVersion, version)
+	}
+	return nil
+}
+
+// compareVersion 比较形如1.4.3的版本号，v1小于、等于、大于v2时分别返回-1、0、1
+func compareVersion(v1, v2 string) (int, error) {
+	var (
+		s1, s2 = strings.Split(strings.TrimPrefix(v1, "v"), "."), strings.Split(strings.TrimPrefix(v2, "v"), ".")
+		n1, n2 int
+		err    error
+	)
+	for index := 0; index < len(s1) || index < len(s2); index++ {
+		n1, n2 = 0, 0
+		if index < len(s1) {
+			if n1, err = strconv.Atoi(s1[index]); nil != err {
+				return 0, fmt.Errorf("version %s is invalid", v1)
+			}
+		}
+		if index < len(s2) {
+			if n2, err = strconv.Atoi(s2[index]); nil != err {
+				return 0, fmt.Errorf("version %s is invalid", v2)
+			}
+		}
+		if n1 < n2 {
+			return -1, nil
+		} else if n1 > n2 {
+			return 1, nil
+		}
+	}
+	return 0, nil
+}
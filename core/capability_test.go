@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2019. Aberic - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdk
+
+import (
+	pb "github.com/aberic/fabric-client/grpc/proto/chain"
+	common2 "github.com/hyperledger/fabric/protos/common"
+	"testing"
+)
+
+func TestCheckCapability(t *testing.T) {
+	if err := checkCapability(pb.CapabilityGroup_GroupApplication, []string{"V1_3"}, "V1_4_2", "1.4.3", ""); nil != err {
+		t.Error(err)
+	}
+	if err := checkCapability(pb.CapabilityGroup_GroupApplication, []string{"V1_3"}, "V1_2", "1.4.3", ""); nil == err {
+		t.Error("downgrade should be rejected")
+	}
+	if err := checkCapability(pb.CapabilityGroup_GroupOrderer, []string{"V1_1"}, "V1_4_2", "", "1.4.1"); nil == err {
+		t.Error("orderer version 1.4.1 should not support V1_4_2")
+	}
+	if err := checkCapability(pb.CapabilityGroup_GroupChannel, []string{"V1_3"}, "V1_4_3", "1.4.3", ""); nil == err {
+		t.Error("channel capability should require orderer version")
+	}
+	if err := checkCapability(pb.CapabilityGroup_GroupOrderer, []string{"V1_1"}, "V1_3", "", "1.4.3"); nil == err {
+		t.Error("V1_3 is not an orderer capability")
+	}
+}
+
+func TestSetCapability(t *testing.T) {
+	group := &common2.ConfigGroup{}
+	if err := setCapability(group, "V1_3"); nil != err {
+		t.Fatal(err)
+	}
+	if modPolicy := group.Values[capabilitiesKey].ModPolicy; modPolicy != "Admins" {
+		t.Errorf("capabilities mod policy expect Admins, got %s", modPolicy)
+	}
+	// 升级后仅保留目标能力，沿用已有的ModPolicy
+	group.Values[capabilitiesKey].ModPolicy = "/Channel/Orderer/Admins"
+	if err := setCapability(group, "V1_4_2"); nil != err {
+		t.Fatal(err)
+	}
+	names, err := groupCapabilities(group)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if len(names) != 1 || names[0] != "V1_4_2" {
+		t.Error("capabilities expect [V1_4_2], got", names)
+	}
+	if modPolicy := group.Values[capabilitiesKey].ModPolicy; modPolicy != "/Channel/Orderer/Admins" {
+		t.Errorf("capabilities mod policy should be kept, got %s", modPolicy)
+	}
+}
+
+func TestCompareVersion(t *testing.T) {
+	for _, v := range [][]string{{"1.4.3", "1.4.2"}, {"2.0", "1.4.9"}, {"v1.10.0", "1.9.0"}} {
+		if compare, err := compareVersion(v[0], v[1]); nil != err || compare != 1 {
+			t.Error(v, compare, err)
+		}
+	}
+}
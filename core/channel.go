@@ -16,6 +16,7 @@
 package sdk
 
 import (
+	"bytes"
 	"github.com/aberic/gnomon"
 	"github.com/hyperledger/fabric-sdk-go/pkg/client/channel"
 	mspclient "github.com/hyperledger/fabric-sdk-go/pkg/client/msp"
@@ -28,7 +29,9 @@ import (
 	ch "github.com/hyperledger/fabric-sdk-go/pkg/fab/channel"
 	"github.com/hyperledger/fabric-sdk-go/pkg/fab/comm"
 	"github.com/hyperledger/fabric-sdk-go/pkg/fabsdk"
+	"github.com/hyperledger/fabric-sdk-go/third_party/github.com/hyperledger/fabric/protos/common"
 	peer2 "github.com/hyperledger/fabric-sdk-go/third_party/github.com/hyperledger/fabric/protos/peer"
+	common2 "github.com/hyperledger/fabric/protos/common"
 	"github.com/pkg/errors"
 	"time"
 )
@@ -322,3 +325,76 @@ ERR:
 	result.Fail(err.Error())
 	return &result
 }
+
+// channelConfig 从peer节点获取通道当前配置
+func channelConfig(orgName, orgUser, channelID, peerName string, sdk *fabsdk.FabricSDK) (*common2.Config, error) {
+	client, err := sdk.Context(fabsdk.WithUser(orgUser), fabsdk.WithOrg(orgName))()
+	if nil != err {
+		return nil, err
+	}
+	result := queryConfigBlock(channelID, peerName, client)
+	if result.ResultCode != Success {
+		return nil, errors.New(result.Msg)
+	}
+	block, ok := result.Data.(*common.Block)
+	if !ok {
+		return nil, errors.New("config block type error")
+	}
+	return configFromBlock(block)
+}
+
+// ConfigSigner 通道配置更新签名用户
+type ConfigSigner struct {
+	OrgName string
+	OrgUser string
+}
+
+// updateChannelConfig 从peer节点获取当前通道配置，经modify修改后计算配置更新，由signers签名后提交至排序节点
+func updateChannelConfig(orderURL, orgName, orgUser, channelID, peerName string, signers []*ConfigSigner,
+	sdk *fabsdk.FabricSDK, modify func(config *common2.Config) error) (txID string, err error) {
+	var (
+		original, updated *common2.Config
+		envelopeBytes     []byte
+		mspClient         *mspclient.Client
+		signingIdentity   msp.SigningIdentity
+		signingIdentities []msp.SigningIdentity
+		resMgmtClient     *resmgmt.Client
+		scResp            resmgmt.SaveChannelResponse
+	)
+	if original, err = channelConfig(orgName, orgUser, channelID, peerName, sdk); nil != err {
+		return
+	}
+	if updated, err = copyConfig(original); nil != err {
+		return
+	}
+	if err = modify(updated); nil != err {
+		return
+	}
+	if envelopeBytes, err = computeUpdateEnvelope(original, updated, channelID); nil != err {
+		return
+	}
+	if len(signers) == 0 {
+		signers = []*ConfigSigner{{OrgName: orgName, OrgUser: orgUser}}
+	}
+	for _, signer := range signers {
+		if mspClient, err = mspclient.New(sdk.Context(), mspclient.WithOrg(signer.OrgName)); nil != err {
+			return
+		}
+		if signingIdentity, err = mspClient.GetSigningIdentity(signer.OrgUser); nil != err {
+			return
+		}
+		signingIdentities = append(signingIdentities, signingIdentity)
+	}
+	if resMgmtClient, err = resmgmt.New(sdk.Context(fabsdk.WithUser(orgUser), fabsdk.WithOrg(orgName))); nil != err {
+		return
+	}
+	req := resmgmt.SaveChannelRequest{ChannelID: channelID,
+		ChannelConfig:     bytes.NewReader(envelopeBytes),
+		SigningIdentities: signingIdentities}
+	if scResp, err = resMgmtClient.SaveChannel(req, resmgmt.WithRetry(retry.DefaultResMgmtOpts),
+		resmgmt.WithOrdererEndpoint(orderURL)); nil != err {
+		gnomon.Log().Error("updateChannelConfig", gnomon.Log().Err(err))
+		return
+	}
+	return string(scResp.TransactionID), nil
+}
@@ -0,0 +1,107 @@
+/*
+ * Copyright (c) 2019. Aberic - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package sdk
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"github.com/hyperledger/fabric-sdk-go/pkg/fabsdk"
+	common2 "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/msp"
+	"golang.org/x/protobuf/proto"
+)
+
+const mspKey = "MSP"
+
+// addCRL 将CRL提交至通道配置中组织的MSP吊销列表
+func addCRL(orderURL, orgName, orgUser, channelID, peerName, mspOrgName string, crlPem []byte,
+	signers []*ConfigSigner, sdk *fabsdk.FabricSDK) (string, error) {
+	return updateChannelConfig(orderURL, orgName, orgUser, channelID, peerName, signers, sdk,
+		func(config *common2.Config) error {
+			return setOrgCRL(config, mspOrgName, crlPem)
+		})
+}
+
+// setOrgCRL 更新Application及Orderer中该组织MSP的吊销列表，同一签发者的旧CRL被替换
+func setOrgCRL(config *common2.Config, mspOrgName string, crlPem []byte) error {
+	crl, err := parseCRL(crlPem)
+	if nil != err {
+		return err
+	}
+	var found bool
+	for _, groupName := range []string{"Application", "Orderer"} {
+		group := config.ChannelGroup.Groups[groupName]
+		if nil == group {
+			continue
+		}
+		orgGroup := group.Groups[mspOrgName]
+		if nil == orgGroup {
+			continue
+		}
+		found = true
+		if err = setGroupCRL(orgGroup, crl, crlPem); nil != err {
+			return err
+		}
+	}
+	if !found {
+		return fmt.Errorf("org %s is not exist in channel config", mspOrgName)
+	}
+	return nil
+}
+
+func setGroupCRL(orgGroup *common2.ConfigGroup, crl *x509.RevocationList, crlPem []byte) error {
+	value, ok := orgGroup.Values[mspKey]
+	if !ok {
+		return fmt.Errorf("config value %s is not exist", mspKey)
+	}
+	mspConfig := &msp.MSPConfig{}
+	if err := proto.Unmarshal(value.Value, mspConfig); nil != err {
+		return err
+	}
+	fabricMSPConfig := &msp.FabricMSPConfig{}
+	if err := proto.Unmarshal(mspConfig.Config, fabricMSPConfig); nil != err {
+		return err
+	}
+	revocationList := [][]byte{crlPem}
+	for _, oldPem := range fabricMSPConfig.RevocationList {
+		old, err := parseCRL(oldPem)
+		if nil != err {
+			return err
+		}
+		if !bytes.Equal(old.RawIssuer, crl.RawIssuer) {
+			revocationList = append(revocationList, oldPem)
+		}
+	}
+	fabricMSPConfig.RevocationList = revocationList
+	var err error
+	if mspConfig.Config, err = proto.Marshal(fabricMSPConfig); nil != err {
+		return err
+	}
+	if value.Value, err = proto.Marshal(mspConfig); nil != err {
+		return err
+	}
+	return nil
+}
+
+func parseCRL(crlPem []byte) (*x509.RevocationList, error) {
+	block, _ := pem.Decode(crlPem)
+	if nil == block {
+		return nil, fmt.Errorf("crl pem is invalid")
+	}
+	return x509.ParseRevocationList(block.Bytes)
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2019. Aberic - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package sdk
+
+import (
+	"fmt"
+	"github.com/aberic/fabric-client/geneses"
+	"github.com/aberic/fabric-client/grpc/proto/generate"
+	common2 "github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/msp"
+	"golang.org/x/protobuf/proto"
+	"path/filepath"
+	"testing"
+)
+
+func TestRevokeCert(t *testing.T) {
+	defer cleanLocalLeague()
+	gc := &geneses.GenerateConfig{}
+	createLocalLeague(gc, t)
+	if err := gc.CreateOrg(&generate.ReqCreateOrg{OrgType: generate.OrgType_Peer, LeagueDomain: localLeagueDomain,
+		Name: org1Name, Domain: org1Domain}); nil != err {
+		t.Fatal(err)
+	}
+	for _, name := range []string{node1, node2} {
+		if err := gc.CreateOrgNode(&generate.ReqCreateOrgNode{OrgType: generate.OrgType_Peer,
+			OrgChild: localOrgChild(org1Name, org1Domain, name, true, t)}); nil != err {
+			t.Fatal(err)
+		}
+	}
+	rootCert := loadLocalCert(filepath.Join(geneses.CryptoRootCAPath(localLeagueDomain), geneses.CertRootCAName(localLeagueDomain)), t)
+	revoke := func(name string) []byte {
+		_, nodePath := geneses.CryptoOrgAndNodePath(localLeagueDomain, org1Domain, org1Name, name, true, geneses.CcnNode)
+		cert := loadLocalCert(filepath.Join(nodePath, "msp", "signcerts", geneses.CertNodeCAName(org1Name, org1Domain, name)), t)
+		crlPem, err := gc.RevokeCert(&generate.ReqRevokeCert{OrgType: generate.OrgType_Peer, LeagueDomain: localLeagueDomain,
+			OrgName: org1Name, OrgDomain: org1Domain, SerialNumber: fmt.Sprintf("%x", cert.SerialNumber), Reason: 1})
+		if nil != err {
+			t.Fatal(err)
+		}
+		crl, err := parseCRL(crlPem)
+		if nil != err {
+			t.Fatal(err)
+		}
+		if err = crl.CheckSignatureFrom(rootCert); nil != err {
+			t.Fatal(err)
+		}
+		if _, err = gc.RevokeCert(&generate.ReqRevokeCert{OrgType: generate.OrgType_Peer, LeagueDomain: localLeagueDomain,
+			OrgName: org1Name, OrgDomain: org1Domain, SerialNumber: fmt.Sprintf("%x", cert.SerialNumber)}); nil == err {
+			t.Error("revoke the same certificate twice should fail")
+		}
+		return crlPem
+	}
+	firstPem, secondPem := revoke(node1), revoke(node2)
+	crl, _ := parseCRL(secondPem)
+	if len(crl.RevokedCertificateEntries) != 2 || crl.Number.Int64() != 2 {
+		t.Errorf("crl should accumulate revoked certificates, got %d entries number %v", len(crl.RevokedCertificateEntries), crl.Number)
+	}
+
+	// 同一签发者的CRL在通道配置中被替换
+	config := mspConfigWithCRL(t, firstPem)
+	if err := setOrgCRL(config, org1Name, secondPem); nil != err {
+		t.Fatal(err)
+	}
+	fabricMSPConfig := &msp.FabricMSPConfig{}
+	mspConfig := &msp.MSPConfig{}
+	if err := proto.Unmarshal(config.ChannelGroup.Groups["Application"].Groups[org1Name].Values[mspKey].Value, mspConfig); nil != err {
+		t.Fatal(err)
+	}
+	if err := proto.Unmarshal(mspConfig.Config, fabricMSPConfig); nil != err {
+		t.Fatal(err)
+	}
+	if len(fabricMSPConfig.RevocationList) != 1 || string(fabricMSPConfig.RevocationList[0]) != string(secondPem) {
+		t.Errorf("revocation list should be replaced by the latest crl, got %d crls", len(fabricMSPConfig.RevocationList))
+	}
+	if err := setOrgCRL(config, "UnknownOrg", secondPem); nil == err {
+		t.Error("unknown org should fail")
+	}
+}
+
+func mspConfigWithCRL(t *testing.T, crlPem []byte) *common2.Config {
+	fabricMSPConfig, err := proto.Marshal(&msp.FabricMSPConfig{Name: geneses.MspID(org1Name), RevocationList: [][]byte{crlPem}})
+	if nil != err {
+		t.Fatal(err)
+	}
+	mspConfig, err := proto.Marshal(&msp.MSPConfig{Config: fabricMSPConfig})
+	if nil != err {
+		t.Fatal(err)
+	}
+	return &common2.Config{ChannelGroup: &common2.ConfigGroup{Groups: map[string]*common2.ConfigGroup{
+		"Application": {Groups: map[string]*common2.ConfigGroup{
+			org1Name: {Values: map[string]*common2.ConfigValue{mspKey: {Value: mspConfig, ModPolicy: "Admins"}}},
+		}},
+	}}}
+}
@@ -0,0 +1,507 @@
+/*
+ * Copyright (c) 2019. ENNOO - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdk
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/x509"
+	"encoding/pem"
+	"github.com/aberic/fabric-client/geneses"
+	"github.com/aberic/fabric-client/grpc/proto/generate"
+	"github.com/aberic/gnomon"
+	common2 "github.com/hyperledger/fabric/protos/common"
+	"golang.org/x/protobuf/proto"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const localLeagueDomain = "local.league01.com"
+
+func TestGenerateConfig_LocalSign(t *testing.T) {
+	defer cleanLocalLeague()
+	gc := &geneses.GenerateConfig{}
+	createLocalLeague(gc, t)
+	if err := gc.CreateOrg(&generate.ReqCreateOrg{OrgType: generate.OrgType_Peer, LeagueDomain: localLeagueDomain,
+		Name: org1Name, Domain: org1Domain}); nil != err {
+		t.Fatal(err)
+	}
+	if err := gc.CreateOrgUser(&generate.ReqCreateOrgUser{OrgType: generate.OrgType_Peer, IsAdmin: true,
+		OrgChild: localOrgChild(org1Name, org1Domain, admin, false, t)}); nil != err {
+		t.Fatal(err)
+	}
+	if err := gc.CreateOrgNode(&generate.ReqCreateOrgNode{OrgType: generate.OrgType_Peer,
+		OrgChild: localOrgChild(org1Name, org1Domain, node1, true, t)}); nil != err {
+		t.Fatal(err)
+	}
+	orgPath, nodePath := geneses.CryptoOrgAndNodePath(localLeagueDomain, org1Domain, org1Name, node1, true, geneses.CcnNode)
+	signCert := loadLocalCert(filepath.Join(nodePath, "msp", "signcerts", geneses.CertNodeCAName(org1Name, org1Domain, node1)), t)
+	rootCert := loadLocalCert(filepath.Join(geneses.CryptoRootCAPath(localLeagueDomain), geneses.CertRootCAName(localLeagueDomain)), t)
+	if err := signCert.CheckSignatureFrom(rootCert); nil != err {
+		t.Error(err)
+	}
+	tlsCert := loadLocalCert(filepath.Join(nodePath, "tls", "server.crt"), t)
+	tlsRootCert := loadLocalCert(filepath.Join(geneses.CryptoRootTLSCAPath(localLeagueDomain), geneses.CertRootTLSCAName(localLeagueDomain)), t)
+	if err := tlsCert.CheckSignatureFrom(tlsRootCert); nil != err {
+		t.Error(err)
+	}
+	if chain := loadLocalCertChain(filepath.Join(nodePath, "tls", "server.crt"), t); len(chain) != 1 {
+		t.Errorf("server.crt issued by root tls ca should not carry a chain, got %d certs", len(chain))
+	}
+	adminCertFileName := geneses.CertUserCAName(org1Name, org1Domain, admin)
+	if !gnomon.File().PathExists(filepath.Join(orgPath, "msp", "admincerts", adminCertFileName)) ||
+		!gnomon.File().PathExists(filepath.Join(nodePath, "msp", "admincerts", adminCertFileName)) {
+		t.Error("admin cert should be copied to org and node msp")
+	}
+}
+
+func TestGenerateConfig_TlsSANs(t *testing.T) {
+	defer cleanLocalLeague()
+	gc := &geneses.GenerateConfig{}
+	createLocalLeague(gc, t)
+	if err := gc.CreateOrg(&generate.ReqCreateOrg{OrgType: generate.OrgType_Peer, LeagueDomain: localLeagueDomain,
+		Name: org1Name, Domain: org1Domain}); nil != err {
+		t.Fatal(err)
+	}
+	// 未指定hosts时以CommonName作为SAN，未设置enrollRequest时取自csr
+	noHosts := localOrgChild(org1Name, org1Domain, node2, true, t)
+	noHosts.EnrollInfo.EnrollRequest.Hosts = nil
+	noRequest := localOrgChild(org1Name, org1Domain, "node3", true, t)
+	noRequest.EnrollInfo.EnrollRequest = nil
+	for _, child := range []*generate.OrgChild{localOrgChild(org1Name, org1Domain, node1, true, t), noHosts, noRequest} {
+		if err := gc.CreateOrgNode(&generate.ReqCreateOrgNode{OrgType: generate.OrgType_Peer, OrgChild: child}); nil != err {
+			t.Fatal(err)
+		}
+		_, nodePath := geneses.CryptoOrgAndNodePath(localLeagueDomain, org1Domain, org1Name, child.Name, true, geneses.CcnNode)
+		tlsCert := loadLocalCert(filepath.Join(nodePath, "tls", "server.crt"), t)
+		if err := tlsCert.VerifyHostname(geneses.NodeDomain(org1Name, org1Domain, child.Name)); nil != err {
+			t.Error(err)
+		}
+	}
+}
+
+func TestGenerateConfig_NodeOUs(t *testing.T) {
+	defer cleanLocalLeague()
+	gc := &geneses.GenerateConfig{}
+	createLocalLeague(gc, t)
+	if err := gc.CreateOrg(&generate.ReqCreateOrg{OrgType: generate.OrgType_Peer, LeagueDomain: localLeagueDomain,
+		Name: org1Name, Domain: org1Domain, NodeOUs: true}); nil != err {
+		t.Fatal(err)
+	}
+	if err := gc.CreateOrgUser(&generate.ReqCreateOrgUser{OrgType: generate.OrgType_Peer, IsAdmin: true,
+		OrgChild: localOrgChild(org1Name, org1Domain, admin, false, t)}); nil != err {
+		t.Fatal(err)
+	}
+	if err := gc.CreateOrgNode(&generate.ReqCreateOrgNode{OrgType: generate.OrgType_Peer,
+		OrgChild: localOrgChild(org1Name, org1Domain, node1, true, t)}); nil != err {
+		t.Fatal(err)
+	}
+	orgPath, nodePath := geneses.CryptoOrgAndNodePath(localLeagueDomain, org1Domain, org1Name, node1, true, geneses.CcnNode)
+	if !geneses.NodeOUsEnabled(filepath.Join(orgPath, "msp")) || !geneses.NodeOUsEnabled(filepath.Join(nodePath, "msp")) {
+		t.Fatal("config.yaml should be generated in org and node msp")
+	}
+	_, adminPath := geneses.CryptoOrgAndNodePath(localLeagueDomain, org1Domain, org1Name, admin, true, geneses.CcnAdmin)
+	checks := map[string]string{
+		filepath.Join(nodePath, "msp", "signcerts", geneses.CertNodeCAName(org1Name, org1Domain, node1)):  geneses.OUPeer,
+		filepath.Join(adminPath, "msp", "signcerts", geneses.CertUserCAName(org1Name, org1Domain, admin)): geneses.OUAdmin,
+	}
+	for certFilePath, ou := range checks {
+		cert := loadLocalCert(certFilePath, t)
+		if !strings.Contains(strings.Join(cert.Subject.OrganizationalUnit, ","), ou) {
+			t.Errorf("cert %s should carry ou %s, got %v", certFilePath, ou, cert.Subject.OrganizationalUnit)
+		}
+	}
+	if files, _ := ioutil.ReadDir(filepath.Join(nodePath, "msp", "admincerts")); len(files) > 0 {
+		t.Error("admincerts should not be propagated to node msp with NodeOUs enabled")
+	}
+	if files, _ := ioutil.ReadDir(filepath.Join(orgPath, "msp", "admincerts")); len(files) != 1 {
+		t.Error("org msp should keep the admin cert required by genesis block generation")
+	}
+	// enroll签发的证书无法保证携带NodeOU，启用NodeOU的组织拒绝非离线签发
+	enrollChild := localOrgChild(org1Name, org1Domain, node2, true, t)
+	enrollChild.LocalSign = false
+	if err := gc.CreateOrgNode(&generate.ReqCreateOrgNode{OrgType: generate.OrgType_Peer, OrgChild: enrollChild}); nil == err {
+		t.Error("enroll should fail for org with NodeOUs enabled")
+	}
+	// 拒绝后不残留节点目录，改为离线签发后可重新创建
+	if _, node2Path := geneses.CryptoOrgAndNodePath(localLeagueDomain, org1Domain, org1Name, node2, true, geneses.CcnNode); gnomon.File().PathExists(node2Path) {
+		t.Error("rejected node should not leave its directory")
+	}
+	enrollChild.LocalSign = true
+	if err := gc.CreateOrgNode(&generate.ReqCreateOrgNode{OrgType: generate.OrgType_Peer, OrgChild: enrollChild}); nil != err {
+		t.Error(err)
+	}
+}
+
+func TestGenerateConfig_IntermediateCA(t *testing.T) {
+	defer cleanLocalLeague()
+	gc := &geneses.GenerateConfig{}
+	createLocalLeague(gc, t)
+	if err := gc.CreateOrg(&generate.ReqCreateOrg{OrgType: generate.OrgType_Peer, LeagueDomain: localLeagueDomain,
+		Name: org1Name, Domain: org1Domain, IntermediateCA: &generate.IntermediateCA{
+			PriData: localKey(t, true), PriTlsData: localKey(t, true), SignAlgorithm: signAlgorithm}}); nil != err {
+		t.Fatal(err)
+	}
+	if err := gc.CreateOrgNode(&generate.ReqCreateOrgNode{OrgType: generate.OrgType_Peer,
+		OrgChild: localOrgChild(org1Name, org1Domain, node1, true, t)}); nil != err {
+		t.Fatal(err)
+	}
+	orgPath, nodePath := geneses.CryptoOrgAndNodePath(localLeagueDomain, org1Domain, org1Name, node1, true, geneses.CcnNode)
+	rootCert := loadLocalCert(filepath.Join(geneses.CryptoRootCAPath(localLeagueDomain), geneses.CertRootCAName(localLeagueDomain)), t)
+	interCert := loadLocalCert(filepath.Join(nodePath, "msp", "intermediatecerts", geneses.CertOrgCAName(org1Name, org1Domain)), t)
+	if !interCert.IsCA || interCert.MaxPathLen != 0 || !interCert.MaxPathLenZero {
+		t.Error("intermediate ca should be a ca restricted to path len 0")
+	}
+	roots, intermediates := x509.NewCertPool(), x509.NewCertPool()
+	roots.AddCert(rootCert)
+	intermediates.AddCert(interCert)
+	signCert := loadLocalCert(filepath.Join(nodePath, "msp", "signcerts", geneses.CertNodeCAName(org1Name, org1Domain, node1)), t)
+	if err := signCert.CheckSignatureFrom(interCert); nil != err {
+		t.Error(err)
+	}
+	if _, err := signCert.Verify(x509.VerifyOptions{Roots: roots, Intermediates: intermediates,
+		KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageAny}}); nil != err {
+		t.Error(err)
+	}
+	tlsRootCert := loadLocalCert(filepath.Join(geneses.CryptoRootTLSCAPath(localLeagueDomain), geneses.CertRootTLSCAName(localLeagueDomain)), t)
+	tlsInterCert := loadLocalCert(filepath.Join(orgPath, "msp", "tlsintermediatecerts", geneses.CertOrgTLSCAName(org1Name, org1Domain)), t)
+	if err := tlsInterCert.CheckSignatureFrom(tlsRootCert); nil != err {
+		t.Error(err)
+	}
+	if err := loadLocalCert(filepath.Join(nodePath, "tls", "server.crt"), t).CheckSignatureFrom(tlsInterCert); nil != err {
+		t.Error(err)
+	}
+	// 仅信任节点tls/ca.crt的客户端可依据server.crt附带的中间证书完成校验
+	chain := loadLocalCertChain(filepath.Join(nodePath, "tls", "server.crt"), t)
+	if len(chain) != 2 {
+		t.Fatalf("server.crt should carry the org tls intermediate, got %d certs", len(chain))
+	}
+	tlsRoots, tlsIntermediates := x509.NewCertPool(), x509.NewCertPool()
+	tlsRoots.AddCert(loadLocalCert(filepath.Join(nodePath, "tls", "ca.crt"), t))
+	tlsIntermediates.AddCert(chain[1])
+	if _, err := chain[0].Verify(x509.VerifyOptions{Roots: tlsRoots, Intermediates: tlsIntermediates,
+		DNSName: geneses.NodeDomain(org1Name, org1Domain, node1), KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}}); nil != err {
+		t.Error(err)
+	}
+}
+
+func TestArchiveArtifacts(t *testing.T) {
+	defer cleanLocalLeague()
+	gc := &geneses.GenerateConfig{}
+	createLocalLeague(gc, t)
+	if err := gc.CreateOrg(&generate.ReqCreateOrg{OrgType: generate.OrgType_Peer, LeagueDomain: localLeagueDomain,
+		Name: org1Name, Domain: org1Domain}); nil != err {
+		t.Fatal(err)
+	}
+	if err := gc.CreateOrgNode(&generate.ReqCreateOrgNode{OrgType: generate.OrgType_Peer,
+		OrgChild: localOrgChild(org1Name, org1Domain, node1, true, t)}); nil != err {
+		t.Fatal(err)
+	}
+	signCertName := "/" + geneses.CertNodeCAName(org1Name, org1Domain, node1)
+	names := archiveNames(&generate.ReqDownloadArtifacts{LeagueDomain: localLeagueDomain}, t)
+	if !hasArchiveName(names, signCertName) || !hasArchiveName(names, "/"+geneses.GeneratePriKeyFileName) {
+		t.Errorf("league archive should contain certs and private keys, got %v", names)
+	}
+	names = archiveNames(&generate.ReqDownloadArtifacts{LeagueDomain: localLeagueDomain, ExcludePrivateKey: true}, t)
+	if !hasArchiveName(names, signCertName) || hasArchiveName(names, "/"+geneses.GeneratePriKeyFileName) {
+		t.Errorf("league archive should exclude private keys, got %v", names)
+	}
+	names = archiveNames(&generate.ReqDownloadArtifacts{LeagueDomain: localLeagueDomain, OrgType: generate.OrgType_Peer,
+		OrgName: org1Name, OrgDomain: org1Domain, ChildName: node1}, t)
+	for _, name := range names {
+		if !strings.Contains(name, "peers/"+geneses.NodeDomain(org1Name, org1Domain, node1)) {
+			t.Errorf("node archive should only contain node files, got %s", name)
+		}
+	}
+	for _, req := range []*generate.ReqDownloadArtifacts{
+		{LeagueDomain: ".."},
+		{LeagueDomain: localLeagueDomain + "/../" + localLeagueDomain},
+		{LeagueDomain: localLeagueDomain, OrgType: generate.OrgType_Peer, OrgName: "../" + org1Name, OrgDomain: org1Domain},
+		{LeagueDomain: localLeagueDomain, OrgType: generate.OrgType_Peer, OrgName: org1Name, OrgDomain: org1Domain, ChildName: ".."},
+	} {
+		if err := geneses.ArchiveArtifacts(req, &bytes.Buffer{}); nil == err {
+			t.Errorf("archive with path traversal should be refused, got nil for %v", req)
+		}
+	}
+}
+
+func TestInspectArtifacts(t *testing.T) {
+	defer cleanLocalLeague()
+	gc := &geneses.GenerateConfig{}
+	createLocalLeague(gc, t)
+	for _, org := range []*generate.ReqCreateOrg{
+		{OrgType: generate.OrgType_Order, LeagueDomain: localLeagueDomain, Name: orderName, Domain: orderDomain},
+		{OrgType: generate.OrgType_Peer, LeagueDomain: localLeagueDomain, Name: org1Name, Domain: org1Domain, NodeOUs: true},
+	} {
+		if err := gc.CreateOrg(org); nil != err {
+			t.Fatal(err)
+		}
+		if err := gc.CreateOrgUser(&generate.ReqCreateOrgUser{OrgType: org.OrgType, IsAdmin: true,
+			OrgChild: localOrgChild(org.Name, org.Domain, admin, false, t)}); nil != err {
+			t.Fatal(err)
+		}
+	}
+	genesis := geneses.Genesis{Info: &generate.ReqGenesis{
+		League: &generate.LeagueInBlock{
+			Domain:        localLeagueDomain,
+			Addresses:     []string{strings.Join([]string{order0NodeName, ".", orderName, ".", orderDomain, ":7050"}, "")},
+			BatchTimeout:  2,
+			BatchSize:     &generate.BatchSize{MaxMessageCount: 1000, AbsoluteMaxBytes: 10 * 1024 * 1024, PreferredMaxBytes: 2 * 1024 * 1024},
+			MaxChannels:   1000,
+			ConsensusType: generate.ConsensusType_solo,
+		},
+		Orgs: []*generate.OrgInBlock{
+			{Domain: orderDomain, Name: orderName, Type: generate.OrgType_Order},
+			{Domain: org1Domain, Name: org1Name, Type: generate.OrgType_Peer},
+		},
+	}}
+	if err := genesis.Init(); nil != err {
+		t.Fatal(err)
+	}
+	if err := genesis.CreateGenesisBlock("default"); nil != err {
+		t.Fatal(err)
+	}
+	if err := genesis.CreateChannelCreateTx("default", channelID); nil != err {
+		t.Fatal(err)
+	}
+	str, err := geneses.InspectBlock(localLeagueDomain, nil)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if !strings.Contains(str, geneses.MspID(orderName)) || !strings.Contains(str, "solo") {
+		t.Error("genesis block json should contain orderer msp id and consensus type")
+	}
+	// 存在启用NodeOU的组织时开启Channel V1_4_3能力
+	if !strings.Contains(str, `"V1_4_3"`) {
+		t.Error("genesis block json should enable channel capability V1_4_3 for NodeOUs org")
+	}
+	if str, err = geneses.InspectChannelTx(localLeagueDomain, channelID, nil); nil != err {
+		t.Fatal(err)
+	}
+	if !strings.Contains(str, `"`+org1Name+`"`) || !strings.Contains(str, `"`+channelID+`"`) {
+		t.Error("channel tx json should contain channel id and peer org")
+	}
+	if _, err = geneses.InspectBlock("", []byte("invalid block")); nil == err {
+		t.Error("invalid block data should fail")
+	}
+}
+
+func TestLeagueCertExpiries(t *testing.T) {
+	defer cleanLocalLeague()
+	gc := &geneses.GenerateConfig{}
+	createLocalLeague(gc, t)
+	if err := gc.CreateOrg(&generate.ReqCreateOrg{OrgType: generate.OrgType_Peer, LeagueDomain: localLeagueDomain,
+		Name: org1Name, Domain: org1Domain}); nil != err {
+		t.Fatal(err)
+	}
+	// 无法解析的证书文件跳过，不影响其他证书
+	badPath := filepath.Join(geneses.CryptoConfigPath(localLeagueDomain), "bad-cert.pem")
+	badCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("bad cert")})
+	if err := ioutil.WriteFile(badPath, badCert, 0644); nil != err {
+		t.Fatal(err)
+	}
+	expiries, err := geneses.LeagueCertExpiries(localLeagueDomain, time.Now())
+	if nil != err {
+		t.Fatal(err)
+	}
+	if len(expiries) == 0 {
+		t.Error("league certs should be scanned")
+	}
+	for _, expiry := range expiries {
+		if expiry.Path == badPath {
+			t.Error("unparsable cert should be skipped")
+		}
+	}
+}
+
+func TestPrintOrg(t *testing.T) {
+	defer cleanLocalLeague()
+	gc := &geneses.GenerateConfig{}
+	createLocalLeague(gc, t)
+	if err := gc.CreateOrg(&generate.ReqCreateOrg{OrgType: generate.OrgType_Peer, LeagueDomain: localLeagueDomain,
+		Name: org1Name, Domain: org1Domain}); nil != err {
+		t.Fatal(err)
+	}
+	org := &generate.OrgInBlock{Domain: org1Domain, Name: org1Name, Type: generate.OrgType_Peer, AnchorPeers: []*generate.AnchorPeer{
+		{Host: geneses.NodeDomain(org1Name, org1Domain, node1), Port: 7051},
+	}}
+	if _, _, err := geneses.PrintOrg(localLeagueDomain, org); nil == err {
+		t.Error("org without admin cert should be rejected")
+	}
+	if err := gc.CreateOrgUser(&generate.ReqCreateOrgUser{OrgType: generate.OrgType_Peer, IsAdmin: true,
+		OrgChild: localOrgChild(org1Name, org1Domain, admin, false, t)}); nil != err {
+		t.Fatal(err)
+	}
+	data, json, err := geneses.PrintOrg(localLeagueDomain, org)
+	if nil != err {
+		t.Fatal(err)
+	}
+	group := &common2.ConfigGroup{}
+	if err = proto.Unmarshal(data, group); nil != err {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"MSP", "AnchorPeers"} {
+		if _, ok := group.Values[key]; !ok {
+			t.Errorf("org config group should contain value %s", key)
+		}
+	}
+	for _, key := range []string{"Readers", "Writers", "Admins", "Endorsement"} {
+		if _, ok := group.Policies[key]; !ok {
+			t.Errorf("org config group should contain policy %s", key)
+		}
+	}
+	if !strings.Contains(json, geneses.MspID(org1Name)) || !strings.Contains(json, geneses.NodeDomain(org1Name, org1Domain, node1)) {
+		t.Error("org json should contain msp id and anchor peer")
+	}
+}
+
+func archiveNames(req *generate.ReqDownloadArtifacts, t *testing.T) []string {
+	var buf bytes.Buffer
+	if err := geneses.ArchiveArtifacts(req, &buf); nil != err {
+		t.Fatal(err)
+	}
+	gr, err := gzip.NewReader(&buf)
+	if nil != err {
+		t.Fatal(err)
+	}
+	var names []string
+	tr := tar.NewReader(gr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if nil != err {
+			t.Fatal(err)
+		}
+		names = append(names, header.Name)
+	}
+	return names
+}
+
+func hasArchiveName(names []string, suffix string) bool {
+	for _, name := range names {
+		if strings.HasSuffix(name, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+func createLocalLeague(gc *geneses.GenerateConfig, t *testing.T) {
+	if err := gc.CreateLeague(&generate.ReqCreateLeague{
+		Domain:        localLeagueDomain,
+		PriData:       localKey(t, true),
+		PriTlsData:    localKey(t, true),
+		Csr:           &generate.CSR{Country: []string{"CN"}, Organization: []string{"league01"}, CommonName: localLeagueDomain},
+		SignAlgorithm: signAlgorithm,
+	}); nil != err {
+		t.Fatal(err)
+	}
+}
+
+// localOrgChild 生成密钥及csr并构造离线签发请求
+func localOrgChild(orgName, orgDomain, childName string, isNode bool, t *testing.T) *generate.OrgChild {
+	child, _ := localOrgChildWithKey(orgName, orgDomain, childName, isNode, t)
+	return child
+}
+
+// localOrgChildWithKey 同localOrgChild，并返回csr所用私钥
+func localOrgChildWithKey(orgName, orgDomain, childName string, isNode bool, t *testing.T) (*generate.OrgChild, []byte) {
+	var commonName string
+	if isNode {
+		commonName = strings.Split(geneses.CertNodeCAName(orgName, orgDomain, childName), "-")[0]
+	} else {
+		commonName = strings.Split(geneses.CertUserCAName(orgName, orgDomain, childName), "-")[0]
+	}
+	name := &generate.CSR{Country: []string{"CN"}, Organization: []string{orgName}, CommonName: commonName}
+	gc := &geneses.GenerateConfig{}
+	priKey := localKey(t, true)
+	if err := gc.CreateCsr(&generate.ReqCreateCsr{LeagueDomain: localLeagueDomain, OrgName: orgName, OrgDomain: orgDomain,
+		PriKey: priKey, Name: name, SignAlgorithm: signAlgorithm}); nil != err {
+		t.Fatal(err)
+	}
+	csrPem, err := ioutil.ReadFile(geneses.CsrFilePath(localLeagueDomain, orgName, orgDomain, commonName))
+	if nil != err {
+		t.Fatal(err)
+	}
+	return &generate.OrgChild{
+		LeagueDomain: localLeagueDomain,
+		OrgName:      orgName,
+		OrgDomain:    orgDomain,
+		Name:         childName,
+		PubTlsData:   localKey(t, false),
+		EnrollInfo: &generate.EnrollInfo{
+			CsrPem:        csrPem,
+			NotAfter:      365,
+			EnrollRequest: &generate.EnrollRequest{Hosts: []string{commonName}, Name: name},
+		},
+		SignAlgorithm: signAlgorithm,
+		LocalSign:     true,
+	}, priKey
+}
+
+func localKey(t *testing.T, pri bool) []byte {
+	pemConfig := &geneses.PemConfig{KeyConfig: &generate.ReqKeyConfig{CryptoType: cryptoType, Algorithm: algorithm}}
+	resp := pemConfig.GenerateCrypto()
+	if resp.Code != generate.Code_Success {
+		t.Fatal(resp.ErrMsg)
+	}
+	defer func() { _ = os.RemoveAll(filepath.Dir(resp.PriKeyFilePath)) }()
+	keyFilePath := resp.PubKeyFilePath
+	if pri {
+		keyFilePath = resp.PriKeyFilePath
+	}
+	data, err := ioutil.ReadFile(keyFilePath)
+	if nil != err {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func loadLocalCert(certFilePath string, t *testing.T) *x509.Certificate {
+	cert, err := gnomon.CA().LoadCrtFromFP(certFilePath)
+	if nil != err {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+// loadLocalCertChain 读取文件中的全部PEM证书
+func loadLocalCertChain(certFilePath string, t *testing.T) []*x509.Certificate {
+	data, err := ioutil.ReadFile(certFilePath)
+	if nil != err {
+		t.Fatal(err)
+	}
+	var certs []*x509.Certificate
+	for block, rest := pem.Decode(data); nil != block; block, rest = pem.Decode(rest) {
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if nil != err {
+			t.Fatal(err)
+		}
+		certs = append(certs, cert)
+	}
+	return certs
+}
+
+func cleanLocalLeague() {
+	_ = os.RemoveAll(filepath.Dir(geneses.CryptoConfigPath(localLeagueDomain)))
+}
@@ -15,7 +15,13 @@
 package sdk
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"encoding/hex"
+	"encoding/pem"
 	config "github.com/aberic/fabric-client/conf"
 	"github.com/aberic/fabric-client/geneses"
 	"github.com/aberic/fabric-client/grpc/proto/chain"
@@ -27,10 +33,13 @@ import (
 	"golang.org/x/protobuf/proto"
 	"gopkg.in/yaml.v3"
 	"io/ioutil"
+	"math/big"
+	"os"
 	"path"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 )
 
 const (
@@ -243,6 +252,180 @@ func TestGenerateConfig_GenesisBlock(t *testing.T) {
 	}
 }
 
+func TestGenesis_CheckPolicies(t *testing.T) {
+	genesis := geneses.Genesis{
+		Info: &generate.ReqGenesis{
+			League: &generate.LeagueInBlock{Domain: leagueDomain},
+			ApplicationPolicies: map[string]*generate.Policy{
+				"Admins": {Type: generate.PolicyType_ImplicitMeta, Rule: "ANY Admins"},
+			},
+			ChannelPolicies: map[string]*generate.Policy{
+				"Writers": {Type: generate.PolicyType_Signature, Rule: "OR('Org1MSP.member', 'Org2MSP.member')"},
+			},
+		},
+	}
+	if err := genesis.Init(); nil != err {
+		t.Error(err)
+	}
+	genesis.Info.OrdererPolicies = map[string]*generate.Policy{
+		"Admins": {Type: generate.PolicyType_ImplicitMeta, Rule: "MOST Admins"},
+	}
+	if err := genesis.Init(); nil == err {
+		t.Error("implicit meta rule MOST Admins should be invalid")
+	}
+	// 与默认策略仅大小写或单复数不同的名称视为拼写错误
+	for _, name := range []string{"Admin", "writers", "BlockValidations"} {
+		genesis.Info.OrdererPolicies = map[string]*generate.Policy{
+			name: {Type: generate.PolicyType_ImplicitMeta, Rule: "ANY Writers"},
+		}
+		if err := genesis.Init(); nil == err {
+			t.Errorf("orderer policy name %s should be rejected", name)
+		}
+	}
+	genesis.Info.OrdererPolicies = map[string]*generate.Policy{
+		"BlockValidation": {Type: generate.PolicyType_ImplicitMeta, Rule: "ANY Writers"},
+		"Auditors":        {Type: generate.PolicyType_Signature, Rule: "OR('Org1MSP.member')"},
+	}
+	if err := genesis.Init(); nil != err {
+		t.Errorf("expected and custom orderer policy names should be accepted, got %v", err)
+	}
+	genesis.Info.OrdererPolicies = nil
+	genesis.Info.Orgs = []*generate.OrgInBlock{{Domain: org1Domain, Name: org1Name, Type: generate.OrgType_Peer,
+		Policies: map[string]*generate.Policy{"Readers": {Type: generate.PolicyType_Signature, Rule: "OR('Org1MSP.member'"}}}}
+	if err := genesis.Init(); nil == err {
+		t.Error("signature rule should be invalid")
+	} else {
+		t.Log(err)
+	}
+}
+
+func TestGenesis_EtcdRaftBlock(t *testing.T) {
+	certPath := filepath.Join(os.TempDir(), "consenter-server.crt")
+	writeTlsCert(certPath, strings.Join([]string{order0NodeName, orderName, orderDomain}, "."), t)
+	defer func() { _ = os.Remove(certPath) }()
+	genesis := geneses.Genesis{
+		Info: &generate.ReqGenesis{
+			League: &generate.LeagueInBlock{
+				Domain:        leagueDomain,
+				Addresses:     []string{strings.Join([]string{order0NodeName, ".", orderName, ".", orderDomain, ":7050"}, "")},
+				BatchTimeout:  2,
+				BatchSize:     &generate.BatchSize{MaxMessageCount: 1000, AbsoluteMaxBytes: 10 * 1024 * 1024, PreferredMaxBytes: 2 * 1024 * 1024},
+				MaxChannels:   1000,
+				ConsensusType: generate.ConsensusType_etcdraft,
+				EtcdRaft: &generate.EtcdRaft{
+					Consenters: []*generate.Consenter{{Host: strings.Join([]string{order0NodeName, orderName, orderDomain}, "."),
+						Port: 7050, ClientTlsCertPath: certPath, ServerTlsCertPath: certPath}},
+					Options: &generate.EtcdRaftOptions{TickInterval: "200ms", ElectionTick: 20},
+				},
+			},
+		},
+	}
+	if err := genesis.Init(); nil != err {
+		t.Fatal(err)
+	}
+	data, err := genesis.ObtainGenesisBlockData("default")
+	if nil != err {
+		t.Fatal(err)
+	}
+	str, err := resource.InspectBlock(data)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if !strings.Contains(str, "etcdraft") || !strings.Contains(str, "200ms") {
+		t.Error("genesis block should contain etcdraft consensus metadata")
+	}
+	genesis.Info.League.EtcdRaft.Options.HeartbeatTick = 20
+	if err = genesis.Init(); nil == err {
+		t.Error("election tick not greater than heartbeat tick should be rejected")
+	}
+	genesis.Info.League.EtcdRaft.Options.HeartbeatTick = 0
+	genesis.Info.League.EtcdRaft.Options.ElectionTick = 1
+	if err = genesis.Init(); nil == err {
+		t.Error("election tick not greater than default heartbeat tick should be rejected")
+	}
+	genesis.Info.League.EtcdRaft.Options.ElectionTick = 20
+	plainPath := filepath.Join(os.TempDir(), "consenter-plain.crt")
+	if err = ioutil.WriteFile(plainPath, []byte("consenter tls cert"), 0644); nil != err {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.Remove(plainPath) }()
+	genesis.Info.League.EtcdRaft.Consenters[0].ServerTlsCertPath = plainPath
+	if err = genesis.Init(); nil == err {
+		t.Error("consenter with plain text tls cert should be rejected")
+	}
+	genesis.Info.League.EtcdRaft.Consenters[0].ServerTlsCertPath = filepath.Join(os.TempDir(), "not-exist.crt")
+	if err = genesis.Init(); nil == err {
+		t.Error("consenter with missing tls cert should be rejected")
+	}
+}
+
+// writeTlsCert 生成以host为SAN的自签名TLS证书
+func writeTlsCert(certPath, host string, t *testing.T) {
+	priKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if nil != err {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: host},
+		DNSNames:     []string{host},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(24 * time.Hour),
+	}
+	certData, err := x509.CreateCertificate(rand.Reader, template, template, &priKey.PublicKey, priKey)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certData}), 0644); nil != err {
+		t.Fatal(err)
+	}
+}
+
+func TestGenesis_Consortiums(t *testing.T) {
+	genesis := geneses.Genesis{
+		Info: &generate.ReqGenesis{
+			League: &generate.LeagueInBlock{Domain: leagueDomain},
+			Orgs: []*generate.OrgInBlock{
+				{Domain: orderDomain, Name: orderName, Type: generate.OrgType_Order},
+				{Domain: org1Domain, Name: org1Name, Type: generate.OrgType_Peer},
+				{Domain: org2Domain, Name: org2Name, Type: generate.OrgType_Peer},
+				{Domain: org3Domain, Name: org3Name, Type: generate.OrgType_Peer},
+			},
+			Consortiums: []*generate.Consortium{
+				{Name: "consortium1", Orgs: []string{org1Name, org2Name}},
+				{Name: "consortium2", Orgs: []string{org2Name, org3Name}},
+			},
+		},
+	}
+	if err := genesis.Init(); nil != err {
+		t.Fatal(err)
+	}
+	if err := genesis.CreateChannelCreateTx("consortium1", channelID, &generate.ChannelOrg{Name: org3Name}); nil == err {
+		t.Error("org3 is not a member of consortium1")
+	}
+	if err := genesis.CreateChannelCreateTx("default", channelID); nil == err {
+		t.Error("consortium default is not defined")
+	}
+	genesis.Info.Consortiums = append(genesis.Info.Consortiums, &generate.Consortium{Name: "consortium3", Orgs: []string{orderName}})
+	if err := genesis.Init(); nil == err {
+		t.Error("orderer org can not be a consortium member")
+	} else {
+		t.Log(err)
+	}
+	genesis.Info.Consortiums = []*generate.Consortium{{Name: "consortium1", Orgs: []string{org1Name}}, {Name: "consortium1", Orgs: []string{org2Name}}}
+	if err := genesis.Init(); nil == err {
+		t.Error("consortium can not be defined more than once")
+	}
+	// 未定义联合体时仅存在default联合体
+	genesis.Info.Consortiums = nil
+	if err := genesis.Init(); nil != err {
+		t.Fatal(err)
+	}
+	if err := genesis.CreateChannelCreateTx("consortium1", channelID); nil == err {
+		t.Error("consortium1 is not defined")
+	}
+}
+
 func TestGenerateConfig_InspectGenesisBlock(t *testing.T) {
 	data, err := ioutil.ReadFile(geneses.GenesisBlockFilePath(leagueDomain))
 	//data, err := ioutil.ReadFile("/Users/aberic/Documents/path/go/src/github.com/aberic/fabric-client/geneses/example/test/channel-artifacts/genesis.block")
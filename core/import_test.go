@@ -0,0 +1,210 @@
+/*
+ * Copyright (c) 2019. Aberic - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package sdk
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"github.com/aberic/fabric-client/config"
+	"github.com/aberic/fabric-client/geneses"
+	"github.com/aberic/fabric-client/grpc/proto/generate"
+	"github.com/aberic/gnomon"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const importLeagueDomain = "import.league01.com"
+
+func TestImportCryptoConfig(t *testing.T) {
+	defer cleanLocalLeague()
+	defer func() { _ = os.RemoveAll(filepath.Dir(geneses.CryptoConfigPath(importLeagueDomain))) }()
+	gc := &geneses.GenerateConfig{}
+	createLocalLeague(gc, t)
+	for _, org := range []*generate.ReqCreateOrg{
+		{OrgType: generate.OrgType_Order, LeagueDomain: localLeagueDomain, Name: orderName, Domain: orderDomain},
+		{OrgType: generate.OrgType_Peer, LeagueDomain: localLeagueDomain, Name: org1Name, Domain: org1Domain},
+	} {
+		if err := gc.CreateOrg(org); nil != err {
+			t.Fatal(err)
+		}
+		nodeName := node1
+		if org.OrgType == generate.OrgType_Order {
+			nodeName = order0NodeName
+		}
+		if err := gc.CreateOrgNode(&generate.ReqCreateOrgNode{OrgType: org.OrgType,
+			OrgChild: localOrgChild(org.Name, org.Domain, nodeName, true, t)}); nil != err {
+			t.Fatal(err)
+		}
+		if err := gc.CreateOrgUser(&generate.ReqCreateOrgUser{OrgType: org.OrgType, IsAdmin: true,
+			OrgChild: localOrgChild(org.Name, org.Domain, admin, false, t)}); nil != err {
+			t.Fatal(err)
+		}
+	}
+	var buf bytes.Buffer
+	if err := geneses.ArchiveArtifacts(&generate.ReqDownloadArtifacts{LeagueDomain: localLeagueDomain}, &buf); nil != err {
+		t.Fatal(err)
+	}
+	data := buf.Bytes()
+	// 联盟域名及映射的组织名称、域名不能逃逸出数据目录
+	if _, err := geneses.ImportCryptoConfig("../"+importLeagueDomain, data, nil); nil == err {
+		t.Error("league domain with illegal path element should fail")
+	}
+	if _, err := geneses.ImportCryptoConfig(importLeagueDomain, data, []*generate.ImportOrgMapping{
+		{DirName: orderName + "." + orderDomain, Name: orderName, Domain: "../../" + orderDomain}}); nil == err {
+		t.Error("org domain with illegal path element should fail")
+	}
+	if gnomon.File().PathExists(filepath.Dir(geneses.CryptoConfigPath(importLeagueDomain))) {
+		t.Error("nothing should be written when import fails")
+	}
+	mappings := []*generate.ImportOrgMapping{{DirName: orderName + "." + orderDomain, Name: orderName, Domain: orderDomain, MspID: "OrdererMSP"}}
+	orgs, err := geneses.ImportCryptoConfig(importLeagueDomain, data, mappings)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if infos, _ := ioutil.ReadDir(filepath.Dir(geneses.CryptoConfigPath(importLeagueDomain))); len(infos) != 1 {
+		t.Errorf("only crypto-config should be left in league path, got %d entries", len(infos))
+	}
+	if len(orgs) != 2 || orgs[0].Name != org1Name || orgs[0].MspID != geneses.MspID(org1Name) || orgs[1].MspID != "OrdererMSP" {
+		t.Fatalf("imported orgs are unexpected: %v", orgs)
+	}
+	_, nodePath := geneses.CryptoOrgAndNodePath(importLeagueDomain, org1Domain, org1Name, node1, true, geneses.CcnNode)
+	_, userPath := geneses.CryptoOrgAndNodePath(importLeagueDomain, orderDomain, orderName, admin, false, geneses.CcnUser)
+	for _, filePath := range []string{filepath.Join(nodePath, "msp", "signcerts"), filepath.Join(userPath, "tls", "client.crt")} {
+		if !gnomon.File().PathExists(filePath) {
+			t.Errorf("%s should be imported", filePath)
+		}
+	}
+	if _, err = geneses.ImportCryptoConfig(importLeagueDomain, data, mappings); nil == err {
+		t.Error("import the same org twice should fail")
+	}
+
+	conf := &config.Config{}
+	peerHost := geneses.NodeDomain(org1Name, org1Domain, node1)
+	ordererHost := geneses.NodeDomain(orderName, orderDomain, order0NodeName)
+	if err = conf.InitImportConfig(importLeagueDomain, orgs, &generate.ImportConnection{OrgName: org1Name, Tls: true, Level: "debug",
+		PeerURLs: map[string]string{peerHost: "grpcs://127.0.0.1:7051"}, OrdererURLs: map[string]string{ordererHost: "grpcs://127.0.0.1:7050"}}); nil != err {
+		t.Fatal(err)
+	}
+	if nil == conf.Peers[peerHost] || nil == conf.Orderers[ordererHost] {
+		t.Error("config should contain imported peer and orderer")
+	}
+	if org := conf.Organizations[org1Name]; nil == org || nil == org.Users[admin] || len(org.Peers) != 1 {
+		t.Errorf("config org is unexpected: %v", org)
+	}
+	if org := conf.Organizations[orderName]; nil == org || org.MspID != "OrdererMSP" {
+		t.Errorf("config orderer org is unexpected: %v", org)
+	}
+
+	// 组织CA与节点证书不匹配时拒绝导入
+	_ = os.RemoveAll(filepath.Dir(geneses.CryptoConfigPath(importLeagueDomain)))
+	tlsRoot, err := ioutil.ReadFile(filepath.Join(geneses.CryptoRootTLSCAPath(localLeagueDomain), geneses.CertRootTLSCAName(localLeagueDomain)))
+	if nil != err {
+		t.Fatal(err)
+	}
+	tampered := rewriteArchive(data, func(name string) bool {
+		return strings.Contains(name, "peerOrganizations/") && strings.Contains(name, "/msp/cacerts/")
+	}, tlsRoot, t)
+	if _, err = geneses.ImportCryptoConfig(importLeagueDomain, tampered, mappings); nil == err {
+		t.Error("org with mismatched ca should fail")
+	}
+	if gnomon.File().PathExists(geneses.CryptoOrgMspPath(importLeagueDomain, orderDomain, orderName, false)) {
+		t.Error("no org should be imported when validation fails")
+	}
+
+	// 超出大小上限的归档及解压后过大的归档拒绝导入
+	if _, err = geneses.ImportCryptoConfig(importLeagueDomain, make([]byte, geneses.ImportArchiveMaxSize+1), mappings); nil == err {
+		t.Error("oversized archive should fail")
+	}
+	if _, err = geneses.ImportCryptoConfig(importLeagueDomain, zeroArchive(512<<20, t), mappings); nil == err {
+		t.Error("archive exceeding extracted size limit should fail")
+	}
+}
+
+// zeroArchive 生成仅包含一个size字节零值文件的tar.gz归档
+func zeroArchive(size int64, t *testing.T) []byte {
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	if err := tw.WriteHeader(&tar.Header{Name: "crypto-config/zero", Mode: 0644, Size: size, Typeflag: tar.TypeReg}); nil != err {
+		t.Fatal(err)
+	}
+	if _, err := io.CopyN(tw, zeroReader{}, size); nil != err {
+		t.Fatal(err)
+	}
+	if err := tw.Close(); nil != err {
+		t.Fatal(err)
+	}
+	if err := gw.Close(); nil != err {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	for i := range p {
+		p[i] = 0
+	}
+	return len(p), nil
+}
+
+// rewriteArchive 将归档中匹配的文件内容替换为content
+func rewriteArchive(data []byte, match func(name string) bool, content []byte, t *testing.T) []byte {
+	gr, err := gzip.NewReader(bytes.NewReader(data))
+	if nil != err {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	gw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gw)
+	tr := tar.NewReader(gr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if nil != err {
+			t.Fatal(err)
+		}
+		body, err := ioutil.ReadAll(tr)
+		if nil != err {
+			t.Fatal(err)
+		}
+		if header.Typeflag == tar.TypeReg && match(header.Name) {
+			body = content
+			header.Size = int64(len(content))
+		}
+		if err = tw.WriteHeader(header); nil != err {
+			t.Fatal(err)
+		}
+		if _, err = tw.Write(body); nil != err {
+			t.Fatal(err)
+		}
+	}
+	if err = tw.Close(); nil != err {
+		t.Fatal(err)
+	}
+	if err = gw.Close(); nil != err {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
@@ -271,3 +271,66 @@ func createConfigEnvelopeReader(configUpdateEnvBytes []byte, channelID string) (
 	envelope.Payload = payloadBytes
 	return envelope, nil
 }
+
+// configFromBlock 从通道配置区块中解析出完整的通道配置
+func configFromBlock(block *common.Block) (*common2.Config, error) {
+	var (
+		envelope       *common.Envelope
+		payload        *common.Payload
+		configEnvelope *common2.ConfigEnvelope
+		err            error
+	)
+	if envelope, err = marshalCommonEnvelope(block); nil != err {
+		return nil, err
+	}
+	if payload, err = marshalCommonPayload(envelope); nil != err {
+		return nil, err
+	}
+	if configEnvelope, err = marshalCommonConfigEnvelope(payload); nil != err {
+		return nil, err
+	}
+	if nil == configEnvelope.Config || nil == configEnvelope.Config.ChannelGroup {
+		return nil, errors.New("channel config is nil")
+	}
+	return configEnvelope.Config, nil
+}
+
+// copyConfig 深拷贝通道配置，修改拷贝后与原配置比较计算配置更新
+func copyConfig(original *common2.Config) (*common2.Config, error) {
+	var (
+		configBytes []byte
+		err         error
+	)
+	if configBytes, err = proto.Marshal(original); nil != err {
+		return nil, err
+	}
+	config := &common2.Config{}
+	if err = proto.Unmarshal(configBytes, config); nil != err {
+		return nil, err
+	}
+	return config, nil
+}
+
+// computeUpdateEnvelope 比较原配置与更新后配置，返回可直接提交的通道配置更新交易
+func computeUpdateEnvelope(original, updated *common2.Config, channelID string) ([]byte, error) {
+	var (
+		configUpdate                            *common2.ConfigUpdate
+		envelope                                *common.Envelope
+		configUpdateBytes, configUpdateEnvBytes []byte
+		err                                     error
+	)
+	if configUpdate, err = update.Compute(original, updated); nil != err {
+		return nil, err
+	}
+	configUpdate.ChannelId = channelID
+	if configUpdateBytes, err = proto.Marshal(configUpdate); nil != err {
+		return nil, err
+	}
+	if configUpdateEnvBytes, err = proto.Marshal(&common2.ConfigUpdateEnvelope{ConfigUpdate: configUpdateBytes}); nil != err {
+		return nil, err
+	}
+	if envelope, err = createConfigEnvelopeReader(configUpdateEnvBytes, channelID); nil != err {
+		return nil, err
+	}
+	return proto.Marshal(envelope)
+}
@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	config2 "github.com/aberic/fabric-client/config"
+	pb "github.com/aberic/fabric-client/grpc/proto/chain"
 	"github.com/aberic/fabric-client/service"
 	"github.com/aberic/gnomon"
 	"github.com/hyperledger/fabric-sdk-go/pkg/client/msp"
@@ -304,6 +305,61 @@ func QueryConfigBlock(channelID, orgName, orgUser, peerName string, configBytes
 	return queryConfigBlock(channelID, peerName, client)
 }
 
+func Capabilities(channelID, orgName, orgUser, peerName string, configBytes []byte, sdkOpts ...fabsdk.Option) *Result {
+	result := Result{}
+	sdk, err := sdk(configBytes, sdkOpts...)
+	if err != nil {
+		gnomon.Log().Error("Capabilities", gnomon.Log().Err(err))
+		result.Fail(err.Error())
+		return &result
+	}
+	defer sdk.Close()
+	return capabilities(orgName, orgUser, channelID, peerName, sdk)
+}
+
+func UpgradeCapability(orderURL, orgName, orgUser, channelID, peerName string, group pb.CapabilityGroup, capability,
+	peerVersion, ordererVersion string, signers []*ConfigSigner, configBytes []byte, sdkOpts ...fabsdk.Option) (string, error) {
+	sdk, err := sdk(configBytes, sdkOpts...)
+	if err != nil {
+		return "", err
+	}
+	defer sdk.Close()
+	return upgradeCapability(orderURL, orgName, orgUser, channelID, peerName, group, capability, peerVersion,
+		ordererVersion, signers, sdk)
+}
+
+func ACLs(channelID, orgName, orgUser, peerName string, configBytes []byte, sdkOpts ...fabsdk.Option) *Result {
+	result := Result{}
+	sdk, err := sdk(configBytes, sdkOpts...)
+	if err != nil {
+		gnomon.Log().Error("ACLs", gnomon.Log().Err(err))
+		result.Fail(err.Error())
+		return &result
+	}
+	defer sdk.Close()
+	return acls(orgName, orgUser, channelID, peerName, sdk)
+}
+
+func UpdateACLs(orderURL, orgName, orgUser, channelID, peerName string, aclMap map[string]string, signers []*ConfigSigner,
+	configBytes []byte, sdkOpts ...fabsdk.Option) (string, error) {
+	sdk, err := sdk(configBytes, sdkOpts...)
+	if err != nil {
+		return "", err
+	}
+	defer sdk.Close()
+	return updateACLs(orderURL, orgName, orgUser, channelID, peerName, aclMap, signers, sdk)
+}
+
+func AddCRL(orderURL, orgName, orgUser, channelID, peerName, mspOrgName string, crlPem []byte, signers []*ConfigSigner,
+	configBytes []byte, sdkOpts ...fabsdk.Option) (string, error) {
+	sdk, err := sdk(configBytes, sdkOpts...)
+	if err != nil {
+		return "", err
+	}
+	defer sdk.Close()
+	return addCRL(orderURL, orgName, orgUser, channelID, peerName, mspOrgName, crlPem, signers, sdk)
+}
+
 func Install(orgName, orgUser, peerName, name, goPath, chainCodePath, version string, configBytes []byte, sdkOpts ...fabsdk.Option) *Result {
 	result := Result{}
 	// Resource management client is responsible for managing channels (create/update channel)
@@ -0,0 +1,108 @@
+/*
+ * Copyright (c) 2019. Aberic - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package sdk
+
+import (
+	"github.com/aberic/fabric-client/geneses"
+	"github.com/aberic/fabric-client/grpc/proto/generate"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestVerifyMSP(t *testing.T) {
+	defer cleanLocalLeague()
+	gc := &geneses.GenerateConfig{}
+	createLocalLeague(gc, t)
+	if err := gc.CreateOrg(&generate.ReqCreateOrg{OrgType: generate.OrgType_Peer, LeagueDomain: localLeagueDomain,
+		Name: org1Name, Domain: org1Domain}); nil != err {
+		t.Fatal(err)
+	}
+	child, priKey := localOrgChildWithKey(org1Name, org1Domain, node1, true, t)
+	if err := gc.CreateOrgNode(&generate.ReqCreateOrgNode{OrgType: generate.OrgType_Peer, OrgChild: child}); nil != err {
+		t.Fatal(err)
+	}
+	req := &generate.ReqVerifyMSP{OrgType: generate.OrgType_Peer, LeagueDomain: localLeagueDomain,
+		OrgName: org1Name, OrgDomain: org1Domain, ChildName: node1}
+	_, nodePath := geneses.CryptoOrgAndNodePath(localLeagueDomain, org1Domain, org1Name, node1, true, geneses.CcnNode)
+	keystorePath := filepath.Join(nodePath, "msp", "keystore")
+
+	// 离线签发的节点未写入私钥
+	problems := verifyMSP(gc, req, t)
+	if len(problems) != 1 || !problems[0].Warning || !hasProblem(problems, "keystore is empty") {
+		t.Errorf("empty keystore should only be warned, got %v", problems)
+	}
+	if err := ioutil.WriteFile(filepath.Join(keystorePath, "priv_sk"), priKey, 0600); nil != err {
+		t.Fatal(err)
+	}
+	// 私钥文件名与SKI不一致仅告警
+	problems = verifyMSP(gc, req, t)
+	if len(problems) != 1 || !problems[0].Warning || !hasProblem(problems, "does not match signcert SKI") {
+		t.Errorf("valid msp should only warn keystore file name, got %v", problems)
+	}
+	if problems = verifyMSP(gc, &generate.ReqVerifyMSP{OrgType: generate.OrgType_Peer, LeagueDomain: localLeagueDomain,
+		OrgName: org1Name, OrgDomain: org1Domain}, t); len(problems) != 0 {
+		t.Errorf("valid org msp should have no problem, got %v", problems)
+	}
+
+	// 私钥不匹配及即将过期
+	if err := os.Rename(filepath.Join(keystorePath, "priv_sk"), filepath.Join(keystorePath, "0102_sk")); nil != err {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(keystorePath, "0102_sk"), localKey(t, true), 0600); nil != err {
+		t.Fatal(err)
+	}
+	req.ExpireDays = 400
+	problems = verifyMSP(gc, req, t)
+	if !hasProblem(problems, "no keystore private key matches") || !hasProblem(problems, "will expire") {
+		t.Errorf("mismatched key and expiring cert should be reported, got %v", problems)
+	}
+
+	// 缺失tlscacerts及admincerts不由cacerts签发
+	if err := os.RemoveAll(filepath.Join(nodePath, "msp", "tlscacerts")); nil != err {
+		t.Fatal(err)
+	}
+	tlsRoot, err := ioutil.ReadFile(filepath.Join(geneses.CryptoRootTLSCAPath(localLeagueDomain), geneses.CertRootTLSCAName(localLeagueDomain)))
+	if nil != err {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(nodePath, "msp", "admincerts", "tls-cert.pem"), tlsRoot, 0644); nil != err {
+		t.Fatal(err)
+	}
+	problems = verifyMSP(gc, req, t)
+	if !hasProblem(problems, "tlscacerts is missing") || !hasProblem(problems, "admincert does not chain to cacerts") {
+		t.Errorf("missing tlscacerts and foreign admincert should be reported, got %v", problems)
+	}
+}
+
+func verifyMSP(gc *geneses.GenerateConfig, req *generate.ReqVerifyMSP, t *testing.T) []*generate.MSPProblem {
+	problems, err := gc.VerifyMSP(req)
+	if nil != err {
+		t.Fatal(err)
+	}
+	return problems
+}
+
+func hasProblem(problems []*generate.MSPProblem, message string) bool {
+	for _, problem := range problems {
+		if strings.Contains(problem.Message, message) {
+			return true
+		}
+	}
+	return false
+}
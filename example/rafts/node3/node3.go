@@ -37,7 +37,7 @@ func grpcListener() {
 		panic(err)
 	}
 	//  创建grpc的server
-	rpcServer := grpc.NewServer()
+	rpcServer := grpc.NewServer(grpc.UnaryInterceptor(chains.Forward), grpc.StreamInterceptor(chains.ForwardStream))
 
 	//  注册我们自定义的helloworld服务
 	pb.RegisterLedgerConfigServer(rpcServer, &chains.ConfigServer{})
@@ -0,0 +1,129 @@
+/*
+ * Copyright (c) 2019. ENNOO - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package geneses
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"github.com/aberic/fabric-client/grpc/proto/generate"
+	"github.com/aberic/gnomon"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ArchiveArtifacts 将联盟crypto-config及channel-artifacts，或指定组织、节点及用户目录打包为tar.gz写入writer，
+// 包内路径相对于联盟目录
+func ArchiveArtifacts(req *generate.ReqDownloadArtifacts, writer io.Writer) error {
+	if req.LeagueDomain == "" {
+		return errors.New("league domain is required")
+	}
+	for _, name := range [][2]string{{"league domain", req.LeagueDomain}, {"org domain", req.OrgDomain},
+		{"org name", req.OrgName}, {"child name", req.ChildName}} {
+		if err := checkPathName(name[0], name[1]); nil != err {
+			return err
+		}
+	}
+	leaguePath := filepath.Join(dataPath, req.LeagueDomain)
+	roots, err := archiveRoots(req)
+	if nil != err {
+		return err
+	}
+	gw := gzip.NewWriter(writer)
+	tw := tar.NewWriter(gw)
+	for _, root := range roots {
+		if err = archiveDir(tw, leaguePath, root, req.ExcludePrivateKey); nil != err {
+			return err
+		}
+	}
+	if err = tw.Close(); nil != err {
+		return err
+	}
+	return gw.Close()
+}
+
+// archiveRoots 返回待打包的目录
+func archiveRoots(req *generate.ReqDownloadArtifacts) ([]string, error) {
+	var roots []string
+	switch {
+	case req.OrgName == "":
+		roots = []string{CryptoConfigPath(req.LeagueDomain), ChannelArtifactsPath(req.LeagueDomain)}
+	case req.ChildName == "":
+		roots = []string{filepath.Dir(CryptoOrgMspPath(req.LeagueDomain, req.OrgDomain, req.OrgName, req.OrgType == generate.OrgType_Peer))}
+	default:
+		ccn := CcnNode
+		if req.IsUser {
+			ccn = CcnUser
+		}
+		_, nodePath := CryptoOrgAndNodePath(req.LeagueDomain, req.OrgDomain, req.OrgName, req.ChildName, req.OrgType == generate.OrgType_Peer, ccn)
+		roots = []string{nodePath}
+	}
+	var exists []string
+	for _, root := range roots {
+		root = filepath.Clean(root)
+		if err := checkDataPath(root); nil != err {
+			return nil, err
+		}
+		if gnomon.File().PathExists(root) {
+			exists = append(exists, root)
+		}
+	}
+	if len(exists) == 0 {
+		return nil, errors.New("artifacts is not exist")
+	}
+	return exists, nil
+}
+
+func archiveDir(tw *tar.Writer, basePath, root string, excludePrivateKey bool) error {
+	return filepath.Walk(root, func(filePath string, info os.FileInfo, err error) error {
+		if nil != err {
+			return err
+		}
+		if excludePrivateKey && !info.IsDir() && IsPrivateKeyFile(filePath) {
+			return nil
+		}
+		name, err := filepath.Rel(basePath, filePath)
+		if nil != err {
+			return err
+		}
+		header, err := tar.FileInfoHeader(info, "")
+		if nil != err {
+			return err
+		}
+		header.Name = filepath.ToSlash(name)
+		if err = tw.WriteHeader(header); nil != err {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		file, err := os.Open(filePath)
+		if nil != err {
+			return err
+		}
+		defer func() { _ = file.Close() }()
+		_, err = io.Copy(tw, file)
+		return err
+	})
+}
+
+// IsPrivateKeyFile 判断文件是否为私钥，包括msp/keystore下的文件、CA私钥及tls私钥
+func IsPrivateKeyFile(filePath string) bool {
+	fileName := filepath.Base(filePath)
+	return filepath.Base(filepath.Dir(filePath)) == "keystore" || fileName == GeneratePriKeyFileName ||
+		strings.HasSuffix(fileName, "_sk") || strings.HasSuffix(fileName, ".key")
+}
@@ -15,6 +15,7 @@
 package geneses
 
 import (
+	"fmt"
 	"github.com/aberic/gnomon"
 	"path/filepath"
 	"strings"
@@ -51,6 +52,26 @@ func init() {
 	}, "/")
 }
 
+// checkPathName 校验用于拼接数据目录的名称，如联盟域名、组织名称及节点名称，不能包含".."或路径分隔符
+func checkPathName(field, name string) error {
+	if strings.Contains(name, "..") || strings.Contains(name, "/") || strings.ContainsRune(name, filepath.Separator) {
+		return fmt.Errorf("%s %q contains illegal path element", field, name)
+	}
+	return nil
+}
+
+// checkDataPath 校验路径清理后仍位于数据目录下
+func checkDataPath(path string) error {
+	rel, err := filepath.Rel(filepath.Clean(dataPath), filepath.Clean(path))
+	if nil != err {
+		return err
+	}
+	if rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) || filepath.IsAbs(rel) {
+		return fmt.Errorf("path %s is out of data path", path)
+	}
+	return nil
+}
+
 func MspID(orgName string) string {
 	return strings.Join([]string{orgName, "MSP"}, "")
 }
@@ -149,6 +170,24 @@ func CryptoOrgMspPath(leagueDomain, orgDomain, orgName string, isPeer bool) (msp
 	return filepath.Join(dataPath, leagueDomain, "crypto-config", orgPathName, "msp")
 }
 
+// CryptoOrgCAPath 组织中间CA目录
+func CryptoOrgCAPath(leagueDomain, orgDomain, orgName string, isPeer bool) string {
+	return filepath.Join(filepath.Dir(CryptoOrgMspPath(leagueDomain, orgDomain, orgName, isPeer)), "ca")
+}
+
+// CryptoOrgTLSCAPath 组织中间tls CA目录
+func CryptoOrgTLSCAPath(leagueDomain, orgDomain, orgName string, isPeer bool) string {
+	return filepath.Join(filepath.Dir(CryptoOrgMspPath(leagueDomain, orgDomain, orgName, isPeer)), "tlsca")
+}
+
+func CertOrgCAName(orgName, orgDomain string) string {
+	return strings.Join([]string{"ca.", orgName, ".", orgDomain, "-cert.pem"}, "")
+}
+
+func CertOrgTLSCAName(orgName, orgDomain string) string {
+	return strings.Join([]string{"tlsca.", orgName, ".", orgDomain, "-cert.pem"}, "")
+}
+
 // CryptoUserTmpPath CryptoUserTempPath
 func CryptoUserTmpPath(leagueDomain, orgDomain, orgName string) string {
 	tmpPath := strings.Join([]string{"tmp/", orgName, ".", orgDomain, "/users"}, "")
@@ -0,0 +1,99 @@
+/*
+ * Copyright (c) 2019. ENNOO - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package geneses
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"github.com/aberic/fabric-client/grpc/proto/generate"
+	"github.com/aberic/gnomon"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// LeagueDomains 返回数据目录下已生成crypto-config的联盟根域名
+func LeagueDomains() ([]string, error) {
+	if !gnomon.File().PathExists(dataPath) {
+		return nil, nil
+	}
+	infos, err := ioutil.ReadDir(dataPath)
+	if nil != err {
+		return nil, err
+	}
+	var leagueDomains []string
+	for _, info := range infos {
+		if info.IsDir() && gnomon.File().PathExists(CryptoConfigPath(info.Name())) {
+			leagueDomains = append(leagueDomains, info.Name())
+		}
+	}
+	return leagueDomains, nil
+}
+
+// LeagueCertExpiries 扫描联盟crypto-config下的所有证书，返回证书有效期信息，无法解析的文件记录日志后跳过
+func LeagueCertExpiries(leagueDomain string, now time.Time) ([]*generate.CertExpiry, error) {
+	if err := checkPathName("league domain", leagueDomain); nil != err {
+		return nil, err
+	}
+	cryptoConfigPath := CryptoConfigPath(leagueDomain)
+	if !gnomon.File().PathExists(cryptoConfigPath) {
+		return nil, fmt.Errorf("league %s is not exist", leagueDomain)
+	}
+	var expiries []*generate.CertExpiry
+	err := filepath.Walk(cryptoConfigPath, func(filePath string, info os.FileInfo, err error) error {
+		if nil != err || info.IsDir() || IsPrivateKeyFile(filePath) {
+			return err
+		}
+		certExpiries, err := CertExpiries(filePath, leagueDomain, now)
+		if nil != err {
+			gnomon.Log().Warn("cert expiry", gnomon.Log().Field("league", leagueDomain), gnomon.Log().Err(err))
+			return nil
+		}
+		expiries = append(expiries, certExpiries...)
+		return nil
+	})
+	return expiries, err
+}
+
+// CertExpiries 解析文件中的所有PEM证书，非证书文件返回空
+func CertExpiries(filePath, source string, now time.Time) ([]*generate.CertExpiry, error) {
+	data, err := ioutil.ReadFile(filePath)
+	if nil != err {
+		return nil, err
+	}
+	var expiries []*generate.CertExpiry
+	for block, rest := pem.Decode(data); nil != block; block, rest = pem.Decode(rest) {
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if nil != err {
+			return nil, fmt.Errorf("cert %s is invalid: %s", filePath, err.Error())
+		}
+		expiries = append(expiries, &generate.CertExpiry{
+			Path:          filePath,
+			Source:        source,
+			Subject:       cert.Subject.String(),
+			Issuer:        cert.Issuer.String(),
+			SerialNumber:  fmt.Sprintf("%x", cert.SerialNumber),
+			NotAfter:      cert.NotAfter.Unix(),
+			DaysRemaining: int32(math.Floor(cert.NotAfter.Sub(now).Hours() / 24)),
+		})
+	}
+	return expiries, nil
+}
@@ -120,6 +120,23 @@ func (gc *GenerateConfig) CreateOrg(org *generate.ReqCreateOrg) error {
 	if _, err = gnomon.File().Copy(rootTlsCaCertFilePath, tlsCaCertsFilePath); nil != err {
 		return err
 	}
+	// NodeOU标识证书为直接签发组织成员证书的CA
+	ouCertPath := path.Join("cacerts", certName)
+	if nil != org.IntermediateCA {
+		if err = os.Mkdir(path.Join(orgMspPath, "intermediatecerts"), 0755); nil != err {
+			return err
+		}
+		if err = os.Mkdir(path.Join(orgMspPath, "tlsintermediatecerts"), 0755); nil != err {
+			return err
+		}
+		if err = gc.createIntermediateCA(org, orgMspPath); nil != err {
+			return err
+		}
+		ouCertPath = path.Join("intermediatecerts", CertOrgCAName(org.Name, org.Domain))
+	}
+	if org.NodeOUs {
+		return gc.createMspConfig(orgMspPath, ouCertPath)
+	}
 	return nil
 }
 
@@ -172,10 +189,17 @@ func (gc *GenerateConfig) CreateOrgNode(node *generate.ReqCreateOrgNode) error {
 		isPeer = false
 	}
 	orgPath, nodePath := CryptoOrgAndNodePath(node.OrgChild.LeagueDomain, node.OrgChild.OrgDomain, node.OrgChild.OrgName, node.OrgChild.Name, isPeer, ccn)
+	if err = checkNodeOUsChild(node.OrgChild, orgPath); nil != err {
+		return err
+	}
 	if err = gc.orgChildExec(node.OrgChild.LeagueDomain, orgPath, nodePath, ccn); nil != err {
 		return err
 	}
-	if err = gc.generateCryptoOrgChild(node.OrgChild, nodePath, true); nil != err {
+	if NodeOUsEnabled(path.Join(orgPath, "msp")) {
+		// NodeOU模式下由证书OU区分角色，无需分发admincerts
+		return gc.generateCryptoOrgChild(node.OrgChild, orgPath, nodePath, true, childOU(ccn, isPeer))
+	}
+	if err = gc.generateCryptoOrgChild(node.OrgChild, orgPath, nodePath, true, ""); nil != err {
 		return err
 	}
 	orgMspAdminCertPath := path.Join(orgPath, "msp", "admincerts")
@@ -214,17 +238,29 @@ func (gc *GenerateConfig) CreateOrgUser(user *generate.ReqCreateOrgUser) error {
 		ccn = CcnUser
 	}
 	orgPath, nodePath := CryptoOrgAndNodePath(user.OrgChild.LeagueDomain, user.OrgChild.OrgDomain, user.OrgChild.OrgName, user.OrgChild.Name, isPeer, ccn)
-	if err = gc.orgChildExec(user.OrgChild.LeagueDomain, orgPath, nodePath, ccn); nil != err {
+	if err = checkNodeOUsChild(user.OrgChild, orgPath); nil != err {
 		return err
 	}
-	if err = gc.generateCryptoOrgChild(user.OrgChild, nodePath, false); nil != err {
+	if err = gc.orgChildExec(user.OrgChild.LeagueDomain, orgPath, nodePath, ccn); nil != err {
 		return err
 	}
-	adminCertPath := path.Join(nodePath, "msp", "admincerts")
 	signCertPath := path.Join(nodePath, "msp", "signcerts")
 	signCertFileName := CertUserCAName(user.OrgChild.OrgName, user.OrgChild.OrgDomain, user.OrgChild.Name)
-	adminCertFilePath := filepath.Join(adminCertPath, signCertFileName)
 	signCertFilePath := filepath.Join(signCertPath, signCertFileName)
+	if NodeOUsEnabled(path.Join(orgPath, "msp")) {
+		// NodeOU模式下由证书OU区分角色，无需向节点及用户分发admincerts，
+		// 但生成创世区块时组织msp的admincerts不能为空，故仅保留一份于组织msp
+		if err = gc.generateCryptoOrgChild(user.OrgChild, orgPath, nodePath, false, childOU(ccn, isPeer)); nil != err || !user.IsAdmin {
+			return err
+		}
+		_, err = gnomon.File().Copy(signCertFilePath, filepath.Join(orgPath, "msp", "admincerts", signCertFileName))
+		return err
+	}
+	if err = gc.generateCryptoOrgChild(user.OrgChild, orgPath, nodePath, false, ""); nil != err {
+		return err
+	}
+	adminCertPath := path.Join(nodePath, "msp", "admincerts")
+	adminCertFilePath := filepath.Join(adminCertPath, signCertFileName)
 	if _, err = gnomon.File().Copy(signCertFilePath, adminCertFilePath); nil != err {
 		return err
 	}
@@ -321,11 +357,23 @@ func (gc *GenerateConfig) generateCryptoLeagueCrt(league *generate.ReqCreateLeag
 	return nil
 }
 
-func (gc *GenerateConfig) generateCryptoOrgChild(child *generate.OrgChild, nodePath string, isNode bool) error {
+// checkNodeOUsChild 在创建节点或用户目录前校验签发方式
+//
+// enroll签发的证书OU由fabric-ca按注册身份类型决定，无法保证携带NodeOU，因此启用NodeOU的组织须离线签发
+func checkNodeOUsChild(child *generate.OrgChild, orgPath string) error {
+	if !child.LocalSign && NodeOUsEnabled(path.Join(orgPath, "msp")) {
+		return fmt.Errorf("org %s enables NodeOUs, %s must be local signed", child.OrgName, child.Name)
+	}
+	return nil
+}
+
+// generateCryptoOrgChild 生成节点或用户的签名证书及tls证书，ou不为空时离线签发的签名证书携带该NodeOU
+func (gc *GenerateConfig) generateCryptoOrgChild(child *generate.OrgChild, orgPath, nodePath string, isNode bool, ou string) error {
 	var (
 		signCertPath, signCertFileName, tlsCertFileName string
 		err                                             error
 	)
+	caPath, caCertName, tlsCaPath, tlsCaCertName := gc.getIssuerCA(child.LeagueDomain, child.OrgName, child.OrgDomain, orgPath)
 	// ca cert
 	signCertPath = path.Join(nodePath, "msp", "signcerts")
 	if isNode {
@@ -333,7 +381,12 @@ func (gc *GenerateConfig) generateCryptoOrgChild(child *generate.OrgChild, nodeP
 	} else {
 		signCertFileName = CertUserCAName(child.OrgName, child.OrgDomain, child.Name)
 	}
-	if err = gc.enroll(child, signCertPath, signCertFileName); nil != err {
+	if child.LocalSign {
+		err = gc.localSign(child, caPath, caCertName, signCertPath, signCertFileName, ou)
+	} else {
+		err = gc.enroll(child, signCertPath, signCertFileName)
+	}
+	if nil != err {
 		return err
 	}
 	// tls ca cert
@@ -342,15 +395,14 @@ func (gc *GenerateConfig) generateCryptoOrgChild(child *generate.OrgChild, nodeP
 	} else {
 		tlsCertFileName = "client.crt"
 	}
-	if err = gc.generateCryptoOrgChildTlsCaCrt(child, nodePath, tlsCertFileName); nil != err {
+	if err = gc.generateCryptoOrgChildTlsCaCrt(child, tlsCaPath, tlsCaCertName, nodePath, tlsCertFileName); nil != err {
 		return err
 	}
 	return nil
 }
 
-func (gc *GenerateConfig) generateCryptoOrgChildTlsCaCrt(child *generate.OrgChild, nodePath, certName string) (err error) {
+func (gc *GenerateConfig) generateCryptoOrgChildTlsCaCrt(child *generate.OrgChild, tlsCaPath, tlsCertName, nodePath, certName string) (err error) {
 	var parentTlsCert *x509.Certificate
-	_, _, tlsCaPath, tlsCertName := gc.getRootCA(child.LeagueDomain)
 	if parentTlsCert, err = gnomon.CA().LoadCrtFromFP(filepath.Join(tlsCaPath, tlsCertName)); nil != err {
 		return err
 	}
@@ -358,29 +410,41 @@ func (gc *GenerateConfig) generateCryptoOrgChildTlsCaCrt(child *generate.OrgChil
 	if nil != err {
 		return err
 	}
-	tlsCertPath := path.Join(nodePath, "tls")
-	if _, err = gnomon.CA().GenerateCertificate(&gnomon.Cert{
-		ParentCert: parentTlsCert,
-		CertSelf: gnomon.CertSelf{
-			CertificateFilePath:   filepath.Join(tlsCertPath, certName),
-			Subject:               gc.getSubject(child.EnrollInfo.EnrollRequest.Name),
-			ParentPrivateKey:      priTlsParentKey,
-			PublicKey:             pubTlsKey,
-			NotAfterDays:          time.Now().Add(5000 * 24 * time.Hour),
-			NotBeforeDays:         time.Now(),
-			BasicConstraintsValid: true,
-			IsCA:                  false,
-			ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
-			KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDataEncipherment,
-			SignatureAlgorithm:    gc.SignAlgorithm(child.SignAlgorithm),
-		},
-	}); nil != err {
+	var csr *x509.CertificateRequest
+	if nil != child.EnrollInfo && len(child.EnrollInfo.CsrPem) > 0 {
+		if csr, err = gc.parseCsr(child.EnrollInfo.CsrPem); nil != err {
+			return err
+		}
+	}
+	subject, dnsNames := gc.childSubject(child, csr)
+	// tls证书SAN需包含节点域名，否则客户端按sslTargetNameOverride校验主机名失败，未指定hosts时使用CommonName
+	if len(dnsNames) == 0 && subject.CommonName != "" {
+		dnsNames = []string{subject.CommonName}
+	}
+	_, err = gc.createCertificate(&x509.Certificate{
+		Subject:               subject,
+		DNSNames:              dnsNames,
+		NotAfter:              time.Now().Add(5000 * 24 * time.Hour),
+		NotBefore:             time.Now(),
+		BasicConstraintsValid: true,
+		IsCA:                  false,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDataEncipherment,
+		SignatureAlgorithm:    gc.SignAlgorithm(child.SignAlgorithm),
+	}, parentTlsCert, pubTlsKey, priTlsParentKey, filepath.Join(nodePath, "tls", certName))
+	if nil != err || bytes.Equal(parentTlsCert.RawIssuer, parentTlsCert.RawSubject) {
 		return err
 	}
-	return nil
+	// 由组织tls中间CA签发时附加中间证书，仅信任联盟根tls CA的对端据此完成证书链校验
+	_, err = gnomon.File().Append(filepath.Join(nodePath, "tls", certName),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: parentTlsCert.Raw}), false)
+	return err
 }
 
 func (gc *GenerateConfig) enroll(child *generate.OrgChild, path, certFileName string) error {
+	if nil == child.EnrollInfo || nil == child.EnrollInfo.EnrollRequest {
+		return errors.New("enroll request is required to enroll certificate")
+	}
 	notAfter := time.Now().Add(time.Duration(child.EnrollInfo.NotAfter) * 24 * time.Hour)
 	notBefore := time.Now().Add(time.Duration(child.EnrollInfo.NotBefore) * 24 * time.Hour)
 	gcr := generateCertificateRequest{CR: string(child.EnrollInfo.CsrPem), EnrollRequest: *child.EnrollInfo.EnrollRequest}
@@ -494,13 +558,8 @@ func (gc *GenerateConfig) getPriKey(priKeyData []byte, path string) (priKey cryp
 }
 
 func (gc *GenerateConfig) getCertKey(priParentKeyFilePath string, pubKeyData []byte) (priParentKey crypto.Signer, pubKey interface{}, err error) {
-	if priParentKey, err = gnomon.CryptoECC().LoadPriPemFP(priParentKeyFilePath); nil != err {
-		if priParentKey, err = gnomon.CryptoRSA().LoadPriFP(priParentKeyFilePath, gnomon.CryptoRSA().PKSC8()); nil != err {
-			if priParentKey, err = gnomon.CryptoRSA().LoadPriFP(priParentKeyFilePath, gnomon.CryptoRSA().PKSC1()); nil != err {
-				err = errors.New("private key is not support")
-				return
-			}
-		}
+	if priParentKey, err = gc.getPriKeyFromFile(priParentKeyFilePath); nil != err {
+		return
 	}
 	if pubKey, err = gnomon.CryptoECC().LoadPubPem(pubKeyData); nil != err {
 		if pubKey, err = gnomon.CryptoRSA().LoadPub(pubKeyData); nil != err {
@@ -511,6 +570,19 @@ func (gc *GenerateConfig) getCertKey(priParentKeyFilePath string, pubKeyData []b
 	return
 }
 
+// getPriKeyFromFile 加载ECC或PKCS8、PKCS1格式的RSA私钥文件
+func (gc *GenerateConfig) getPriKeyFromFile(priKeyFilePath string) (priKey crypto.Signer, err error) {
+	if priKey, err = gnomon.CryptoECC().LoadPriPemFP(priKeyFilePath); nil != err {
+		if priKey, err = gnomon.CryptoRSA().LoadPriFP(priKeyFilePath, gnomon.CryptoRSA().PKSC8()); nil != err {
+			if priKey, err = gnomon.CryptoRSA().LoadPriFP(priKeyFilePath, gnomon.CryptoRSA().PKSC1()); nil != err {
+				err = errors.New("private key is not support")
+				return
+			}
+		}
+	}
+	return
+}
+
 func (gc *GenerateConfig) getRootCA(leagueDomain string) (caPath, caFileName, tlsCaPath, tlsCaFileName string) {
 	caPath = CryptoRootCAPath(leagueDomain)
 	caFileName = CertRootCAName(leagueDomain)
@@ -586,6 +658,23 @@ func (gc *GenerateConfig) orgChildCopy(leagueDomain, orgPath, nodePath, childMsp
 		return err
 	}
 
+	orgMspPath := path.Join(orgPath, "msp")
+	for _, certsDir := range []string{"intermediatecerts", "tlsintermediatecerts"} {
+		if !gnomon.File().PathExists(path.Join(orgMspPath, certsDir)) {
+			continue
+		}
+		if err = os.Mkdir(path.Join(nodePath, "msp", certsDir), 0755); nil != err {
+			return err
+		}
+		if err = gc.copyCerts(path.Join(orgMspPath, certsDir), path.Join(nodePath, "msp", certsDir)); nil != err {
+			return err
+		}
+	}
+	if NodeOUsEnabled(orgMspPath) {
+		_, err = gnomon.File().Copy(filepath.Join(orgMspPath, MspConfigFileName), filepath.Join(nodePath, "msp", MspConfigFileName))
+		return err
+	}
+
 	switch ccn {
 	default:
 		return nil
@@ -611,14 +700,7 @@ func (gc *GenerateConfig) EccSKI(key *ecdsa.PrivateKey) []byte {
 	if key == nil {
 		return nil
 	}
-
-	// Marshall the public key
-	raw := elliptic.Marshal(key.Curve, key.PublicKey.X, key.PublicKey.Y)
-
-	// Hash it
-	hash := sha256.New()
-	hash.Write(raw)
-	return hash.Sum(nil)
+	return gc.publicKeySKI(&key.PublicKey)
 }
 
 // rsaPublicKey reflects the ASN.1 structure of a PKCS#1 public key.
@@ -632,13 +714,24 @@ func (gc *GenerateConfig) RsaSKI(key *rsa.PrivateKey) []byte {
 	if key == nil {
 		return nil
 	}
+	return gc.publicKeySKI(&key.PublicKey)
+}
 
-	// Marshall the public key
-	raw, _ := asn1.Marshal(rsaPublicKeyASN{
-		N: key.N,
-		E: key.E,
-	})
-
+// publicKeySKI 计算ECC或RSA公钥的subject key identifier
+func (gc *GenerateConfig) publicKeySKI(pubKey interface{}) []byte {
+	var raw []byte
+	switch key := pubKey.(type) {
+	case *ecdsa.PublicKey:
+		// Marshall the public key
+		raw = elliptic.Marshal(key.Curve, key.X, key.Y)
+	case *rsa.PublicKey:
+		raw, _ = asn1.Marshal(rsaPublicKeyASN{
+			N: key.N,
+			E: key.E,
+		})
+	default:
+		return nil
+	}
 	// Hash it
 	hash := sha256.New()
 	hash.Write(raw)
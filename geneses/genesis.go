@@ -15,27 +15,136 @@
 package geneses
 
 import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
 	"github.com/aberic/fabric-client/grpc/proto/generate"
 	"github.com/aberic/gnomon"
 	"github.com/hyperledger/fabric-sdk-go/pkg/fab/resource"
 	"github.com/hyperledger/fabric-sdk-go/pkg/fab/resource/genesisconfig"
+	"github.com/hyperledger/fabric-sdk-go/third_party/github.com/hyperledger/fabric/protos/orderer/etcdraft"
+	"io/ioutil"
+	"path/filepath"
 	"strings"
 	"time"
 )
 
+// DefaultConsortium 请求未定义联合体时生成的包含全部peer组织的联合体名称
+const DefaultConsortium = "default"
+
 type Genesis struct {
 	Info               *generate.ReqGenesis
 	orderOrganizations []*genesisconfig.Organization
 	peerOrganizations  []*genesisconfig.Organization
 	allOrganizations   []*genesisconfig.Organization
+	// 联合体名称及其成员组织
+	consortiums map[string][]*genesisconfig.Organization
 }
 
-func (g *Genesis) Init() {
+func (g *Genesis) Init() error {
+	if err := g.checkPolicies(); nil != err {
+		return err
+	}
+	if err := g.checkConsensus(); nil != err {
+		return err
+	}
 	g.orderOrganizations, g.peerOrganizations, g.allOrganizations = g.organizations(g.Info.Orgs)
+	g.consortiums = map[string][]*genesisconfig.Organization{}
+	if len(g.Info.Consortiums) == 0 {
+		g.consortiums[DefaultConsortium] = g.peerOrganizations
+		return nil
+	}
+	for _, consortium := range g.Info.Consortiums {
+		if consortium.Name == "" {
+			return errors.New("consortium name can not be empty")
+		}
+		if _, exist := g.consortiums[consortium.Name]; exist {
+			return fmt.Errorf("consortium %s is defined more than once", consortium.Name)
+		}
+		var organizations []*genesisconfig.Organization
+		for _, orgName := range consortium.Orgs {
+			organization := g.peerOrganization(orgName)
+			if nil == organization {
+				return fmt.Errorf("consortium %s org %s is not a peer org", consortium.Name, orgName)
+			}
+			organizations = append(organizations, organization)
+		}
+		g.consortiums[consortium.Name] = organizations
+	}
+	return nil
+}
+
+// checkPolicies 校验请求中自定义的组织、Application、Orderer及Channel策略
+func (g *Genesis) checkPolicies() error {
+	for _, org := range g.Info.Orgs {
+		if err := checkPolicies(org.Name, orgPolicyNames, org.Policies); nil != err {
+			return err
+		}
+	}
+	if err := checkPolicies("Application", applicationPolicyNames, g.Info.ApplicationPolicies); nil != err {
+		return err
+	}
+	if err := checkPolicies("Orderer", ordererPolicyNames, g.Info.OrdererPolicies); nil != err {
+		return err
+	}
+	return checkPolicies("Channel", channelPolicyNames, g.Info.ChannelPolicies)
+}
+
+// checkConsensus 校验共识类型参数，etcdraft需至少一个共识节点且其TLS证书为有效的PEM证书，选举超时须大于心跳间隔
+func (g *Genesis) checkConsensus() error {
+	if nil == g.Info.League || g.Info.League.ConsensusType != generate.ConsensusType_etcdraft {
+		return nil
+	}
+	if nil == g.Info.League.EtcdRaft || len(g.Info.League.EtcdRaft.Consenters) == 0 {
+		return errors.New("etcdraft consenters is empty")
+	}
+	for _, consenter := range g.Info.League.EtcdRaft.Consenters {
+		if consenter.Host == "" || consenter.Port == 0 {
+			return errors.New("etcdraft consenter host and port can not be empty")
+		}
+		clientTlsCertPath, serverTlsCertPath := g.consenterTlsCertPath(consenter)
+		for _, certPath := range []string{clientTlsCertPath, serverTlsCertPath} {
+			if !gnomon.File().PathExists(certPath) {
+				return fmt.Errorf("etcdraft consenter %s:%d tls cert %s is not exist", consenter.Host, consenter.Port, certPath)
+			}
+			if err := checkTlsCert(certPath); nil != err {
+				return fmt.Errorf("etcdraft consenter %s:%d %s", consenter.Host, consenter.Port, err.Error())
+			}
+		}
+	}
+	options := g.etcdRaftOptions()
+	if _, err := time.ParseDuration(options.TickInterval); nil != err {
+		return fmt.Errorf("etcdraft tick interval %s is invalid", options.TickInterval)
+	}
+	if options.ElectionTick <= options.HeartbeatTick {
+		return fmt.Errorf("etcdraft election tick %d must be greater than heartbeat tick %d", options.ElectionTick, options.HeartbeatTick)
+	}
+	return nil
+}
+
+// checkTlsCert 校验TLS证书文件为PEM编码的x509证书
+func checkTlsCert(certPath string) error {
+	data, err := ioutil.ReadFile(certPath)
+	if nil != err {
+		return err
+	}
+	block, _ := pem.Decode(data)
+	if nil == block || block.Type != "CERTIFICATE" {
+		return fmt.Errorf("tls cert %s is not a PEM certificate", certPath)
+	}
+	if _, err = x509.ParseCertificate(block.Bytes); nil != err {
+		return fmt.Errorf("tls cert %s is invalid: %s", certPath, err.Error())
+	}
+	return nil
 }
 
 func (g *Genesis) ObtainGenesisBlockData(consortium string) ([]byte, error) {
-	data, err := resource.CreateGenesisBlock(g.genesisBlockConfigProfile(consortium), consortium)
+	profile := g.genesisBlockConfigProfile()
+	if err := g.checkOrgMsps(g.allOrganizations); nil != err {
+		return nil, err
+	}
+	data, err := resource.CreateGenesisBlock(profile, consortium)
 	if nil != err {
 		return nil, err
 	}
@@ -43,7 +152,7 @@ func (g *Genesis) ObtainGenesisBlockData(consortium string) ([]byte, error) {
 }
 
 func (g *Genesis) CreateGenesisBlock(consortium string) error {
-	data, err := resource.CreateGenesisBlock(g.genesisBlockConfigProfile(consortium), consortium)
+	data, err := g.ObtainGenesisBlockData(consortium)
 	if nil != err {
 		return err
 	}
@@ -53,8 +162,16 @@ func (g *Genesis) CreateGenesisBlock(consortium string) error {
 	return nil
 }
 
-func (g *Genesis) CreateChannelCreateTx(consortium, channelID string) error {
-	data, err := resource.CreateChannelCreateTx(g.genesisChannelTxConfigProfile(consortium), nil, channelID)
+// CreateChannelCreateTx 生成通道创建交易，orgs为空时通道包含联合体全部组织
+func (g *Genesis) CreateChannelCreateTx(consortium, channelID string, orgs ...*generate.ChannelOrg) error {
+	profile, err := g.genesisChannelTxConfigProfile(consortium, orgs)
+	if nil != err {
+		return err
+	}
+	if err = g.checkOrgMsps(profile.Application.Organizations); nil != err {
+		return err
+	}
+	data, err := resource.CreateChannelCreateTx(profile, nil, channelID)
 	if nil != err {
 		return err
 	}
@@ -64,8 +181,20 @@ func (g *Genesis) CreateChannelCreateTx(consortium, channelID string) error {
 	return nil
 }
 
-func (g *Genesis) orgPolicies(mspID string) map[string]*genesisconfig.Policy {
-	return map[string]*genesisconfig.Policy{
+// checkOrgMsps 校验组织msp，sdk加载msp时cacerts或admincerts为空将导致panic
+func (g *Genesis) checkOrgMsps(organizations []*genesisconfig.Organization) error {
+	for _, org := range organizations {
+		for _, certsDir := range []string{"cacerts", "admincerts"} {
+			if files, err := ioutil.ReadDir(filepath.Join(org.MSPDir, certsDir)); nil != err || len(files) == 0 {
+				return fmt.Errorf("org %s msp %s is empty", org.Name, certsDir)
+			}
+		}
+	}
+	return nil
+}
+
+func (g *Genesis) orgPolicies(mspID string, custom map[string]*generate.Policy) map[string]*genesisconfig.Policy {
+	return mergePolicies(map[string]*genesisconfig.Policy{
 		"Readers": {
 			Type: "Signature",
 			Rule: strings.Join([]string{"OR('", mspID, ".member')"}, ""),
@@ -82,38 +211,70 @@ func (g *Genesis) orgPolicies(mspID string) map[string]*genesisconfig.Policy {
 			Type: "Signature",
 			Rule: strings.Join([]string{"OR('", mspID, ".member')"}, ""),
 		},
+	}, custom)
+}
+
+// nodeOUOrgPolicies 启用NodeOU的组织以角色主体定义策略，peer组织区分admin/peer/client，orderer组织沿用member
+func (g *Genesis) nodeOUOrgPolicies(mspID string, isPeer bool, custom map[string]*generate.Policy) map[string]*genesisconfig.Policy {
+	if !isPeer {
+		return g.orgPolicies(mspID, custom)
 	}
+	return mergePolicies(map[string]*genesisconfig.Policy{
+		"Readers": {
+			Type: "Signature",
+			Rule: strings.Join([]string{"OR('", mspID, ".admin', '", mspID, ".peer', '", mspID, ".client')"}, ""),
+		},
+		"Writers": {
+			Type: "Signature",
+			Rule: strings.Join([]string{"OR('", mspID, ".admin', '", mspID, ".client')"}, ""),
+		},
+		"Admins": {
+			Type: "Signature",
+			Rule: strings.Join([]string{"OR('", mspID, ".admin')"}, ""),
+		},
+		"Endorsement": {
+			Type: "Signature",
+			Rule: strings.Join([]string{"OR('", mspID, ".peer')"}, ""),
+		},
+	}, custom)
 }
 
 func (g *Genesis) organizations(orgs []*generate.OrgInBlock) (orders, peers, all []*genesisconfig.Organization) {
 	for _, org := range orgs {
 		var (
-			mspDir string
+			isPeer bool
 			mspID  = MspID(org.Name)
 		)
+		switch org.Type {
+		default:
+			return
+		case generate.OrgType_Peer:
+			isPeer = true
+		case generate.OrgType_Order:
+			isPeer = false
+		}
+		mspDir := CryptoOrgMspPath(g.Info.League.Domain, org.Domain, org.Name, isPeer)
 		organization := &genesisconfig.Organization{
 			Name:           org.Name,
 			SkipAsForeign:  false,
 			ID:             mspID,
+			MSPDir:         mspDir,
 			MSPType:        "bccsp",
-			Policies:       g.orgPolicies(mspID),
 			AdminPrincipal: "Role.ADMIN",
 		}
-		switch org.Type {
-		default:
-			return
-		case generate.OrgType_Peer:
+		if NodeOUsEnabled(mspDir) {
+			organization.Policies = g.nodeOUOrgPolicies(mspID, isPeer, org.Policies)
+		} else {
+			organization.Policies = g.orgPolicies(mspID, org.Policies)
+		}
+		if isPeer {
 			var anchorPeers []*genesisconfig.AnchorPeer
 			for _, peer := range org.AnchorPeers {
 				anchorPeers = append(anchorPeers, &genesisconfig.AnchorPeer{Host: peer.Host, Port: int(peer.Port)})
 			}
 			organization.AnchorPeers = anchorPeers
-			mspDir = CryptoOrgMspPath(g.Info.League.Domain, org.Domain, org.Name, true)
-			organization.MSPDir = mspDir
 			peers = append(peers, organization)
-		case generate.OrgType_Order:
-			mspDir = CryptoOrgMspPath(g.Info.League.Domain, org.Domain, org.Name, false)
-			organization.MSPDir = mspDir
+		} else {
 			orders = append(orders, organization)
 		}
 		all = append(all, organization)
@@ -129,12 +290,12 @@ func (g *Genesis) applicationCapabilities() map[string]bool {
 	}
 }
 
-func (g *Genesis) applications() *genesisconfig.Application {
+func (g *Genesis) applications(organizations []*genesisconfig.Organization) *genesisconfig.Application {
 	//rule := strings.Join([]string{"OR('", adminOrgMspID, ".admin')"}, "")
 	return &genesisconfig.Application{
-		Organizations: g.peerOrganizations,
+		Organizations: organizations,
 		Capabilities:  g.applicationCapabilities(),
-		Policies: map[string]*genesisconfig.Policy{
+		Policies: mergePolicies(map[string]*genesisconfig.Policy{
 			"LifecycleEndorsement": {
 				Rule: "MAJORITY Endorsement",
 				Type: "ImplicitMeta",
@@ -159,7 +320,7 @@ func (g *Genesis) applications() *genesisconfig.Application {
 			//	Type: "Signature",
 			//	Rule: rule,
 			//},
-		},
+		}, g.Info.ApplicationPolicies),
 		ACLs: map[string]string{
 			"_lifecycle/CommitChaincodeDefinition": "/Channel/Application/Writers",
 			"_lifecycle/QueryChaincodeDefinition":  "/Channel/Application/Readers",
@@ -184,15 +345,89 @@ func (g *Genesis) applications() *genesisconfig.Application {
 	}
 }
 
+// channelCapabilities 存在启用NodeOU的组织时开启Channel V1_4_3能力，否则config.yaml中的admin及orderer OU不会生效
+func (g *Genesis) channelCapabilities() map[string]bool {
+	for _, organization := range g.allOrganizations {
+		if NodeOUsEnabled(organization.MSPDir) {
+			return map[string]bool{"V1_4_3": true}
+		}
+	}
+	return nil
+}
+
 func (g *Genesis) ordererCapabilities() map[string]bool {
 	return map[string]bool{
 		"V1_1": true,
 	}
 }
 
+// consenterTlsCertPath 返回共识节点TLS证书路径，未指定时使用crypto-config中该排序节点的tls/server.crt
+func (g *Genesis) consenterTlsCertPath(consenter *generate.Consenter) (clientTlsCertPath, serverTlsCertPath string) {
+	_, nodePath := CryptoOrgAndNodePath(g.Info.League.Domain, consenter.OrgDomain, consenter.OrgName, consenter.NodeName, false, CcnNode)
+	nodeTlsCertPath := filepath.Join(nodePath, "tls", "server.crt")
+	if clientTlsCertPath = consenter.ClientTlsCertPath; clientTlsCertPath == "" {
+		clientTlsCertPath = nodeTlsCertPath
+	}
+	if serverTlsCertPath = consenter.ServerTlsCertPath; serverTlsCertPath == "" {
+		serverTlsCertPath = nodeTlsCertPath
+	}
+	return
+}
+
+func (g *Genesis) etcdRaft() *etcdraft.ConfigMetadata {
+	if g.Info.League.ConsensusType != generate.ConsensusType_etcdraft || nil == g.Info.League.EtcdRaft {
+		return nil
+	}
+	var consenters []*etcdraft.Consenter
+	for _, consenter := range g.Info.League.EtcdRaft.Consenters {
+		clientTlsCertPath, serverTlsCertPath := g.consenterTlsCertPath(consenter)
+		consenters = append(consenters, &etcdraft.Consenter{
+			Host: consenter.Host,
+			Port: consenter.Port,
+			// 证书填写路径，生成创世区块时由sdk读取证书内容
+			ClientTlsCert: []byte(clientTlsCertPath),
+			ServerTlsCert: []byte(serverTlsCertPath),
+		})
+	}
+	return &etcdraft.ConfigMetadata{Consenters: consenters, Options: g.etcdRaftOptions()}
+}
+
+// etcdRaftOptions 以默认参数为基础，使用请求中大于零的参数覆盖
+func (g *Genesis) etcdRaftOptions() *etcdraft.Options {
+	options := &etcdraft.Options{
+		TickInterval:         "500ms",
+		ElectionTick:         10,
+		HeartbeatTick:        1,
+		MaxInflightBlocks:    5,
+		SnapshotIntervalSize: 20 * 1024 * 1024,
+	}
+	if custom := g.Info.League.EtcdRaft.Options; nil != custom {
+		if custom.TickInterval != "" {
+			options.TickInterval = custom.TickInterval
+		}
+		if custom.ElectionTick > 0 {
+			options.ElectionTick = custom.ElectionTick
+		}
+		if custom.HeartbeatTick > 0 {
+			options.HeartbeatTick = custom.HeartbeatTick
+		}
+		if custom.MaxInflightBlocks > 0 {
+			options.MaxInflightBlocks = custom.MaxInflightBlocks
+		}
+		if custom.SnapshotIntervalSize > 0 {
+			options.SnapshotIntervalSize = custom.SnapshotIntervalSize
+		}
+	}
+	return options
+}
+
 func (g *Genesis) orderer() *genesisconfig.Orderer {
+	var kafka genesisconfig.Kafka
+	if nil != g.Info.League.Kafka {
+		kafka.Brokers = g.Info.League.Kafka.Brokers // []string{"kafka1.league01:9092", "kafka2.league01:9092"}
+	}
 	return &genesisconfig.Orderer{
-		OrdererType:  "kafka",
+		OrdererType:  g.Info.League.ConsensusType.String(),
 		Addresses:    g.Info.League.Addresses, // []string{"orderer.example.org:7050"}
 		BatchTimeout: time.Duration(time.Duration(g.Info.League.BatchTimeout) * time.Second),
 		BatchSize: genesisconfig.BatchSize{
@@ -200,15 +435,14 @@ func (g *Genesis) orderer() *genesisconfig.Orderer {
 			AbsoluteMaxBytes:  g.Info.League.BatchSize.AbsoluteMaxBytes,  //10 * 1024 * 1024
 			PreferredMaxBytes: g.Info.League.BatchSize.PreferredMaxBytes, //2 * 1024 * 1024
 		},
-		Kafka: genesisconfig.Kafka{
-			Brokers: g.Info.League.Kafka.Brokers, // []string{"kafka1.league01:9092", "kafka2.league01:9092"}
-		},
+		Kafka:         kafka,
+		EtcdRaft:      g.etcdRaft(),
 		Organizations: g.orderOrganizations,
 		MaxChannels:   g.Info.League.MaxChannels, // 1000
 		// Policies defines the set of policies at this level of the config tree
 		// For Orderer policies, their canonical path is
 		// /Channel/Orderer/<PolicyName>
-		Policies: map[string]*genesisconfig.Policy{
+		Policies: mergePolicies(map[string]*genesisconfig.Policy{
 			"Readers": {
 				Type: "ImplicitMeta",
 				Rule: "ANY Readers",
@@ -225,7 +459,7 @@ func (g *Genesis) orderer() *genesisconfig.Orderer {
 				Type: "ImplicitMeta",
 				Rule: "ANY Writers",
 			},
-		},
+		}, g.Info.OrdererPolicies),
 		Capabilities: g.ordererCapabilities(),
 	}
 }
@@ -248,25 +482,83 @@ func (g *Genesis) channelDefaults() map[string]*genesisconfig.Policy {
 			Rule: "ANY Writers",
 		},
 	}
-	return policies
+	return mergePolicies(policies, g.Info.ChannelPolicies)
 }
 
-func (g *Genesis) genesisBlockConfigProfile(consortium string) *genesisconfig.Profile {
-	profile := &genesisconfig.Profile{
-		Orderer: g.orderer(),
-		Consortiums: map[string]*genesisconfig.Consortium{
-			consortium: {Organizations: g.peerOrganizations},
-		},
-		Policies: g.channelDefaults(),
+// consortiumOrganizations 返回Init中定义的联合体成员组织，联合体未定义时返回错误
+func (g *Genesis) consortiumOrganizations(consortium string) ([]*genesisconfig.Organization, error) {
+	organizations, exist := g.consortiums[consortium]
+	if !exist {
+		return nil, fmt.Errorf("consortium %s is not defined", consortium)
 	}
-	return profile
+	return organizations, nil
 }
 
-func (g *Genesis) genesisChannelTxConfigProfile(consortium string) *genesisconfig.Profile {
+func (g *Genesis) peerOrganization(orgName string) *genesisconfig.Organization {
+	for _, organization := range g.peerOrganizations {
+		if organization.Name == orgName {
+			return organization
+		}
+	}
+	return nil
+}
+
+// channelOrganizations 从联合体成员中选出加入通道的组织，并按需替换锚节点
+func (g *Genesis) channelOrganizations(consortium string, orgs []*generate.ChannelOrg) ([]*genesisconfig.Organization, error) {
+	members, err := g.consortiumOrganizations(consortium)
+	if nil != err {
+		return nil, err
+	}
+	if len(orgs) == 0 {
+		return members, nil
+	}
+	var organizations []*genesisconfig.Organization
+	for _, org := range orgs {
+		var member *genesisconfig.Organization
+		for _, organization := range members {
+			if organization.Name == org.Name {
+				member = organization
+				break
+			}
+		}
+		if nil == member {
+			return nil, fmt.Errorf("org %s is not a member of consortium %s", org.Name, consortium)
+		}
+		if len(org.AnchorPeers) > 0 {
+			organization := *member
+			organization.AnchorPeers = nil
+			for _, peer := range org.AnchorPeers {
+				organization.AnchorPeers = append(organization.AnchorPeers, &genesisconfig.AnchorPeer{Host: peer.Host, Port: int(peer.Port)})
+			}
+			member = &organization
+		}
+		organizations = append(organizations, member)
+	}
+	return organizations, nil
+}
+
+func (g *Genesis) genesisBlockConfigProfile() *genesisconfig.Profile {
+	consortiums := map[string]*genesisconfig.Consortium{}
+	for name, organizations := range g.consortiums {
+		consortiums[name] = &genesisconfig.Consortium{Organizations: organizations}
+	}
+	return &genesisconfig.Profile{
+		Orderer:      g.orderer(),
+		Consortiums:  consortiums,
+		Capabilities: g.channelCapabilities(),
+		Policies:     g.channelDefaults(),
+	}
+}
+
+func (g *Genesis) genesisChannelTxConfigProfile(consortium string, orgs []*generate.ChannelOrg) (*genesisconfig.Profile, error) {
+	organizations, err := g.channelOrganizations(consortium, orgs)
+	if nil != err {
+		return nil, err
+	}
 	profile := &genesisconfig.Profile{
 		Consortium:  consortium,
-		Application: g.applications(),
+		Application: g.applications(organizations),
 		Policies:    g.channelDefaults(),
 	}
-	return profile
+	return profile, nil
 }
@@ -0,0 +1,516 @@
+/*
+ * Copyright (c) 2019. ENNOO - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package geneses
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"github.com/aberic/fabric-client/grpc/proto/generate"
+	"github.com/aberic/gnomon"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+const (
+	// ImportArchiveMaxSize 导入的crypto-config归档大小上限
+	ImportArchiveMaxSize = 64 << 20
+	// importExtractMaxSize 归档解压后的文件总大小上限
+	importExtractMaxSize = 256 << 20
+	// importExtractMaxEntries 归档内的条目数上限
+	importExtractMaxEntries = 10000
+)
+
+// importOrg 待导入的组织
+type importOrg struct {
+	srcPath string
+	isPeer  bool
+	org     *generate.ImportedOrg
+	orgPath string            // 组织在联盟中的目录
+	nodes   map[string]string // 节点名称与归档中节点目录
+	users   map[string]string // 用户名称与归档中用户目录
+}
+
+// ImportCryptoConfig 导入cryptogen或fabric-ca生成的crypto-config归档(tar.gz)，
+// 校验各组织节点及用户证书均由组织CA签发后映射至联盟目录结构，返回导入的组织
+func ImportCryptoConfig(leagueDomain string, data []byte, mappings []*generate.ImportOrgMapping) ([]*generate.ImportedOrg, error) {
+	if leagueDomain == "" {
+		return nil, errors.New("league domain is required")
+	}
+	if err := checkPathName("league domain", leagueDomain); nil != err {
+		return nil, err
+	}
+	if len(data) > ImportArchiveMaxSize {
+		return nil, fmt.Errorf("crypto-config archive exceeds %d bytes", ImportArchiveMaxSize)
+	}
+	tmpPath, err := ioutil.TempDir("", "crypto-config")
+	if nil != err {
+		return nil, err
+	}
+	defer func() { _ = os.RemoveAll(tmpPath) }()
+	if err = extractArchive(data, tmpPath); nil != err {
+		return nil, err
+	}
+	rootPath, err := cryptoConfigRoot(tmpPath)
+	if nil != err {
+		return nil, err
+	}
+	// 全部组织校验通过后再写入联盟目录，避免导入部分组织
+	var (
+		imps     []*importOrg
+		orgPaths = map[string]bool{}
+	)
+	for _, isPeer := range []bool{true, false} {
+		orgsPath := filepath.Join(rootPath, orgsDirName(isPeer))
+		if !gnomon.File().PathExists(orgsPath) {
+			continue
+		}
+		infos, err := ioutil.ReadDir(orgsPath)
+		if nil != err {
+			return nil, err
+		}
+		for _, info := range infos {
+			if !info.IsDir() {
+				continue
+			}
+			imp, err := parseImportOrg(leagueDomain, filepath.Join(orgsPath, info.Name()), isPeer, mappings)
+			if nil != err {
+				return nil, err
+			}
+			if orgPaths[imp.orgPath] {
+				return nil, fmt.Errorf("org %s.%s is mapped more than once", imp.org.Name, imp.org.Domain)
+			}
+			orgPaths[imp.orgPath] = true
+			imps = append(imps, imp)
+		}
+	}
+	if len(imps) == 0 {
+		return nil, errors.New("no organization found in crypto-config archive")
+	}
+	if err = importOrgs(leagueDomain, imps); nil != err {
+		return nil, err
+	}
+	orgs := make([]*generate.ImportedOrg, len(imps))
+	for index, imp := range imps {
+		orgs[index] = imp.org
+	}
+	return orgs, nil
+}
+
+// importOrgs 先将全部组织写入联盟目录下的暂存目录，再逐个移动至组织目录，任一步骤失败时移除已写入的组织
+func importOrgs(leagueDomain string, imps []*importOrg) (err error) {
+	leaguePath := filepath.Join(dataPath, leagueDomain)
+	if err = os.MkdirAll(leaguePath, 0755); nil != err {
+		return err
+	}
+	stagingPath, err := ioutil.TempDir(leaguePath, ".import")
+	if nil != err {
+		return err
+	}
+	defer func() { _ = os.RemoveAll(stagingPath) }()
+	stagingOrgPaths := make([]string, len(imps))
+	for index, imp := range imps {
+		stagingOrgPaths[index] = filepath.Join(stagingPath, strconv.Itoa(index))
+		if err = imp.copy(stagingOrgPaths[index]); nil != err {
+			return err
+		}
+	}
+	var moved []string
+	defer func() {
+		if nil != err {
+			for _, orgPath := range moved {
+				_ = os.RemoveAll(orgPath)
+			}
+		}
+	}()
+	for index, imp := range imps {
+		if err = os.MkdirAll(filepath.Dir(imp.orgPath), 0755); nil != err {
+			return err
+		}
+		if err = os.Rename(stagingOrgPaths[index], imp.orgPath); nil != err {
+			return err
+		}
+		moved = append(moved, imp.orgPath)
+	}
+	return nil
+}
+
+// extractArchive 将tar.gz数据解压至dstPath，条目数超过importExtractMaxEntries或文件总大小超过importExtractMaxSize时返回错误
+func extractArchive(data []byte, dstPath string) error {
+	gr, err := gzip.NewReader(bytes.NewReader(data))
+	if nil != err {
+		return err
+	}
+	tr := tar.NewReader(gr)
+	var (
+		entries int
+		size    int64
+	)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if nil != err {
+			return err
+		}
+		if entries++; entries > importExtractMaxEntries {
+			return fmt.Errorf("crypto-config archive exceeds %d entries", importExtractMaxEntries)
+		}
+		if size += header.Size; size > importExtractMaxSize {
+			return fmt.Errorf("crypto-config archive exceeds %d bytes after extraction", importExtractMaxSize)
+		}
+		name := filepath.Clean(filepath.FromSlash(header.Name))
+		if filepath.IsAbs(name) || name == ".." || strings.HasPrefix(name, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("archive entry %s is invalid", header.Name)
+		}
+		filePath := filepath.Join(dstPath, name)
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err = os.MkdirAll(filePath, 0755); nil != err {
+				return err
+			}
+		case tar.TypeReg:
+			if err = writeArchiveFile(tr, filePath, os.FileMode(header.Mode).Perm()); nil != err {
+				return err
+			}
+		}
+	}
+}
+
+func writeArchiveFile(reader io.Reader, filePath string, mode os.FileMode) error {
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); nil != err {
+		return err
+	}
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, mode)
+	if nil != err {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+	_, err = io.Copy(file, reader)
+	return err
+}
+
+// cryptoConfigRoot 查找包含peerOrganizations或ordererOrganizations的目录，允许归档中存在至多两层上级目录
+func cryptoConfigRoot(path string) (string, error) {
+	candidates := []string{path}
+	for depth := 0; depth < 3; depth++ {
+		var children []string
+		for _, candidate := range candidates {
+			if gnomon.File().PathExists(filepath.Join(candidate, orgsDirName(true))) ||
+				gnomon.File().PathExists(filepath.Join(candidate, orgsDirName(false))) {
+				return candidate, nil
+			}
+			infos, err := ioutil.ReadDir(candidate)
+			if nil != err {
+				return "", err
+			}
+			for _, info := range infos {
+				if info.IsDir() {
+					children = append(children, filepath.Join(candidate, info.Name()))
+				}
+			}
+		}
+		candidates = children
+	}
+	return "", errors.New("peerOrganizations or ordererOrganizations is not exist in crypto-config archive")
+}
+
+func orgsDirName(isPeer bool) string {
+	if isPeer {
+		return "peerOrganizations"
+	}
+	return "ordererOrganizations"
+}
+
+// parseImportOrg 解析并校验归档中的组织目录
+func parseImportOrg(leagueDomain, srcPath string, isPeer bool, mappings []*generate.ImportOrgMapping) (*importOrg, error) {
+	dirName := filepath.Base(srcPath)
+	imp := &importOrg{srcPath: srcPath, isPeer: isPeer, org: &generate.ImportedOrg{OrgType: generate.OrgType_Order},
+		nodes: map[string]string{}, users: map[string]string{}}
+	if isPeer {
+		imp.org.OrgType = generate.OrgType_Peer
+	}
+	for _, mapping := range mappings {
+		if mapping.DirName == dirName {
+			imp.org.Name, imp.org.Domain, imp.org.MspID = mapping.Name, mapping.Domain, mapping.MspID
+		}
+	}
+	if imp.org.Name == "" || imp.org.Domain == "" {
+		index := strings.Index(dirName, ".")
+		if index <= 0 || index == len(dirName)-1 {
+			return nil, fmt.Errorf("org dir %s can not be split to name and domain, mapping is required", dirName)
+		}
+		imp.org.Name, imp.org.Domain = dirName[:index], dirName[index+1:]
+	}
+	if imp.org.MspID == "" {
+		imp.org.MspID = MspID(imp.org.Name)
+	}
+	for _, name := range [][2]string{{"org name", imp.org.Name}, {"org domain", imp.org.Domain}} {
+		if err := checkPathName(name[0], name[1]); nil != err {
+			return nil, err
+		}
+	}
+	imp.orgPath = filepath.Dir(CryptoOrgMspPath(leagueDomain, imp.org.Domain, imp.org.Name, isPeer))
+	if err := checkDataPath(imp.orgPath); nil != err {
+		return nil, err
+	}
+	if gnomon.File().PathExists(imp.orgPath) {
+		return nil, fmt.Errorf("org %s.%s already exist in league %s", imp.org.Name, imp.org.Domain, leagueDomain)
+	}
+	mspPath := filepath.Join(srcPath, "msp")
+	roots, err := loadDirCerts(filepath.Join(mspPath, "cacerts"))
+	if nil != err {
+		return nil, fmt.Errorf("org %s: %s", dirName, err.Error())
+	}
+	if len(roots) == 0 {
+		return nil, fmt.Errorf("org %s: msp cacerts is empty", dirName)
+	}
+	caOpts, err := verifyOptions(roots, filepath.Join(mspPath, "intermediatecerts"))
+	if nil != err {
+		return nil, err
+	}
+	tlsRoots, err := loadDirCerts(filepath.Join(mspPath, "tlscacerts"))
+	if nil != err {
+		return nil, fmt.Errorf("org %s: %s", dirName, err.Error())
+	}
+	tlsOpts, err := verifyOptions(tlsRoots, filepath.Join(mspPath, "tlsintermediatecerts"))
+	if nil != err {
+		return nil, err
+	}
+	nodesName := "orderers"
+	if isPeer {
+		nodesName = "peers"
+	}
+	for _, child := range []struct {
+		dirName, suffix string
+		children        map[string]string
+		names           *[]string
+	}{
+		{dirName: nodesName, suffix: "." + dirName, children: imp.nodes, names: &imp.org.Nodes},
+		{dirName: "users", suffix: "@" + dirName, children: imp.users, names: &imp.org.Users},
+	} {
+		childrenPath := filepath.Join(srcPath, child.dirName)
+		if !gnomon.File().PathExists(childrenPath) {
+			continue
+		}
+		infos, err := ioutil.ReadDir(childrenPath)
+		if nil != err {
+			return nil, err
+		}
+		for _, info := range infos {
+			if !info.IsDir() {
+				continue
+			}
+			if !strings.HasSuffix(info.Name(), child.suffix) || len(info.Name()) == len(child.suffix) {
+				return nil, fmt.Errorf("%s %s does not belong to org %s", child.dirName, info.Name(), dirName)
+			}
+			name := strings.TrimSuffix(info.Name(), child.suffix)
+			if err = checkPathName(child.dirName, name); nil != err {
+				return nil, err
+			}
+			childPath := filepath.Join(childrenPath, info.Name())
+			if err = verifyImportChild(childPath, caOpts, tlsOpts); nil != err {
+				return nil, fmt.Errorf("%s %s: %s", child.dirName, info.Name(), err.Error())
+			}
+			child.children[name] = childPath
+			*child.names = append(*child.names, name)
+		}
+	}
+	return imp, nil
+}
+
+// verifyOptions 由根证书及中间证书目录生成证书校验选项，根证书为空时返回nil
+func verifyOptions(roots []*x509.Certificate, intermediatePath string) (*x509.VerifyOptions, error) {
+	if len(roots) == 0 {
+		return nil, nil
+	}
+	opts := &x509.VerifyOptions{
+		Roots:         x509.NewCertPool(),
+		Intermediates: x509.NewCertPool(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}
+	for _, root := range roots {
+		opts.Roots.AddCert(root)
+	}
+	intermediates, err := loadDirCerts(intermediatePath)
+	if nil != err {
+		return nil, err
+	}
+	for _, intermediate := range intermediates {
+		opts.Intermediates.AddCert(intermediate)
+	}
+	return opts, nil
+}
+
+// verifyImportChild 校验节点或用户的msp签名证书及tls证书，私钥可不随归档导入
+func verifyImportChild(childPath string, caOpts, tlsOpts *x509.VerifyOptions) error {
+	signCerts, err := loadDirCerts(filepath.Join(childPath, "msp", "signcerts"))
+	if nil != err {
+		return err
+	}
+	if len(signCerts) == 0 {
+		return errors.New("msp signcerts is empty")
+	}
+	for _, cert := range signCerts {
+		if _, err = cert.Verify(*caOpts); nil != err {
+			return fmt.Errorf("signcert %s is not issued by org ca: %s", cert.Subject.CommonName, err.Error())
+		}
+	}
+	if nil == tlsOpts {
+		return nil
+	}
+	for _, fileName := range []string{"server.crt", "client.crt"} {
+		filePath := filepath.Join(childPath, "tls", fileName)
+		if !gnomon.File().PathExists(filePath) {
+			continue
+		}
+		cert, err := gnomon.CA().LoadCrtFromFP(filePath)
+		if nil != err {
+			return err
+		}
+		if _, err = cert.Verify(*tlsOpts); nil != err {
+			return fmt.Errorf("tls cert %s is not issued by org tls ca: %s", fileName, err.Error())
+		}
+	}
+	return nil
+}
+
+// loadDirCerts 读取目录下所有PEM证书，目录不存在时返回空
+func loadDirCerts(dirPath string) ([]*x509.Certificate, error) {
+	if !gnomon.File().PathExists(dirPath) {
+		return nil, nil
+	}
+	fileNames, err := gnomon.File().LoopFileNames(dirPath)
+	if nil != err {
+		return nil, err
+	}
+	var certs []*x509.Certificate
+	for _, fileName := range fileNames {
+		data, err := ioutil.ReadFile(filepath.Join(dirPath, fileName))
+		if nil != err {
+			return nil, err
+		}
+		for block, rest := pem.Decode(data); nil != block; block, rest = pem.Decode(rest) {
+			if block.Type != "CERTIFICATE" {
+				continue
+			}
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if nil != err {
+				return nil, fmt.Errorf("cert %s is invalid: %s", fileName, err.Error())
+			}
+			certs = append(certs, cert)
+		}
+	}
+	return certs, nil
+}
+
+// copy 将组织按联盟目录结构写入orgPath，节点及用户目录按组织名称及根域名重命名，
+// 组织ca及tlsca证书和私钥按联盟命名规则重命名，以便后续由组织CA签发节点及用户
+func (imp *importOrg) copy(orgPath string) error {
+	infos, err := ioutil.ReadDir(imp.srcPath)
+	if nil != err {
+		return err
+	}
+	for _, info := range infos {
+		switch info.Name() {
+		case "peers", "orderers", "users":
+			continue
+		}
+		if err = copyTree(filepath.Join(imp.srcPath, info.Name()), filepath.Join(orgPath, info.Name())); nil != err {
+			return err
+		}
+	}
+	if err = renameOrgCA(filepath.Join(orgPath, "ca"), CertOrgCAName(imp.org.Name, imp.org.Domain)); nil != err {
+		return err
+	}
+	if err = renameOrgCA(filepath.Join(orgPath, "tlsca"), CertOrgTLSCAName(imp.org.Name, imp.org.Domain)); nil != err {
+		return err
+	}
+	for ccn, children := range map[ClientCANode]map[string]string{CcnNode: imp.nodes, CcnUser: imp.users} {
+		for name, childPath := range children {
+			// 节点及用户目录相对于组织目录的路径与联盟目录结构一致
+			leagueOrgPath, nodePath := CryptoOrgAndNodePath("", imp.org.Domain, imp.org.Name, name, imp.isPeer, ccn)
+			rel, err := filepath.Rel(leagueOrgPath, nodePath)
+			if nil != err {
+				return err
+			}
+			if err = copyTree(childPath, filepath.Join(orgPath, rel)); nil != err {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// renameOrgCA 将cryptogen生成的*-cert.pem及*_sk重命名为联盟中组织CA的证书及私钥名称
+func renameOrgCA(caPath, caCertName string) error {
+	if !gnomon.File().PathExists(caPath) {
+		return nil
+	}
+	fileNames, err := gnomon.File().LoopFileNames(caPath)
+	if nil != err {
+		return err
+	}
+	for _, fileName := range fileNames {
+		var newName string
+		switch {
+		case strings.HasSuffix(fileName, "-cert.pem"):
+			newName = caCertName
+		case strings.HasSuffix(fileName, "_sk"):
+			newName = GeneratePriKeyFileName
+		default:
+			continue
+		}
+		if fileName == newName {
+			continue
+		}
+		if err = os.Rename(filepath.Join(caPath, fileName), filepath.Join(caPath, newName)); nil != err {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyTree 递归复制目录，保留文件权限
+func copyTree(srcPath, dstPath string) error {
+	return filepath.Walk(srcPath, func(filePath string, info os.FileInfo, err error) error {
+		if nil != err {
+			return err
+		}
+		rel, err := filepath.Rel(srcPath, filePath)
+		if nil != err {
+			return err
+		}
+		target := filepath.Join(dstPath, rel)
+		if info.IsDir() {
+			return os.MkdirAll(target, 0755)
+		}
+		file, err := os.Open(filePath)
+		if nil != err {
+			return err
+		}
+		defer func() { _ = file.Close() }()
+		return writeArchiveFile(file, target, info.Mode().Perm())
+	})
+}
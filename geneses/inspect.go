@@ -0,0 +1,49 @@
+/*
+ * Copyright (c) 2019. ENNOO - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package geneses
+
+import (
+	"errors"
+	"github.com/hyperledger/fabric-sdk-go/pkg/fab/resource"
+	"io/ioutil"
+)
+
+// InspectBlock 将创世区块解析为JSON，data为空时读取联盟创世区块文件
+func InspectBlock(leagueDomain string, data []byte) (string, error) {
+	if len(data) == 0 {
+		if leagueDomain == "" {
+			return "", errors.New("league domain or block data is required")
+		}
+		var err error
+		if data, err = ioutil.ReadFile(GenesisBlockFilePath(leagueDomain)); nil != err {
+			return "", err
+		}
+	}
+	return resource.InspectBlock(data)
+}
+
+// InspectChannelTx 将通道创建交易解析为JSON，data为空时读取联盟下channelID的通道交易文件
+func InspectChannelTx(leagueDomain, channelID string, data []byte) (string, error) {
+	if len(data) == 0 {
+		if leagueDomain == "" || channelID == "" {
+			return "", errors.New("league domain and channel id or tx data is required")
+		}
+		var err error
+		if data, err = ioutil.ReadFile(ChannelTXFilePath(leagueDomain, channelID)); nil != err {
+			return "", err
+		}
+	}
+	return resource.InspectChannelCreateTx(data)
+}
@@ -0,0 +1,115 @@
+/*
+ * Copyright (c) 2019. ENNOO - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package geneses
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"github.com/aberic/fabric-client/grpc/proto/generate"
+	"github.com/aberic/gnomon"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// createIntermediateCA 由联盟根CA及根tls CA签发组织中间CA，并写入组织msp的intermediatecerts及tlsintermediatecerts
+func (gc *GenerateConfig) createIntermediateCA(org *generate.ReqCreateOrg, orgMspPath string) error {
+	ica := org.IntermediateCA
+	if len(ica.PriData) == 0 || len(ica.PriTlsData) == 0 {
+		return errors.New("intermediate ca private key is required")
+	}
+	if ica.MaxPathLen < 0 {
+		return errors.New("intermediate ca max path len can not be negative")
+	}
+	isPeer := org.OrgType == generate.OrgType_Peer
+	caPath, certName, tlsCaPath, tlsCertName := gc.getRootCA(org.LeagueDomain)
+	orgCaPath, orgCertName := CryptoOrgCAPath(org.LeagueDomain, org.Domain, org.Name, isPeer), CertOrgCAName(org.Name, org.Domain)
+	if err := gc.generateIntermediateCrt(org, ica.PriData, caPath, certName, orgCaPath, orgCertName, "ca."); nil != err {
+		return err
+	}
+	orgTlsCaPath, orgTlsCertName := CryptoOrgTLSCAPath(org.LeagueDomain, org.Domain, org.Name, isPeer), CertOrgTLSCAName(org.Name, org.Domain)
+	if err := gc.generateIntermediateCrt(org, ica.PriTlsData, tlsCaPath, tlsCertName, orgTlsCaPath, orgTlsCertName, "tlsca."); nil != err {
+		return err
+	}
+	if _, err := gnomon.File().Copy(filepath.Join(orgCaPath, orgCertName), filepath.Join(orgMspPath, "intermediatecerts", orgCertName)); nil != err {
+		return err
+	}
+	_, err := gnomon.File().Copy(filepath.Join(orgTlsCaPath, orgTlsCertName), filepath.Join(orgMspPath, "tlsintermediatecerts", orgTlsCertName))
+	return err
+}
+
+// generateIntermediateCrt 由parentPath下的CA签发中间CA证书，中间CA私钥保存在caPath下
+func (gc *GenerateConfig) generateIntermediateCrt(org *generate.ReqCreateOrg, priKeyData []byte, parentPath, parentCertName,
+	caPath, certName, commonNamePrefix string) error {
+	parentCert, parentKey, err := gc.loadCA(parentPath, parentCertName)
+	if nil != err {
+		return err
+	}
+	priKey, err := gc.getPriKey(priKeyData, caPath)
+	if nil != err {
+		return err
+	}
+	ica := org.IntermediateCA
+	subject := pkix.Name{
+		Organization: []string{org.Name},
+		CommonName:   strings.Join([]string{commonNamePrefix, org.Name, ".", org.Domain}, ""),
+	}
+	if nil != ica.Csr && ica.Csr.CommonName != "" {
+		subject = gc.getSubject(ica.Csr)
+	}
+	// 中间CA有效期不得超过根CA
+	notAfter := time.Now().Add(5000 * 24 * time.Hour)
+	if notAfter.After(parentCert.NotAfter) {
+		notAfter = parentCert.NotAfter
+	}
+	_, err = gc.createCertificate(&x509.Certificate{
+		Subject:               subject,
+		NotBefore:             time.Now(),
+		NotAfter:              notAfter,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLen:            int(ica.MaxPathLen),
+		MaxPathLenZero:        ica.MaxPathLen == 0,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		SignatureAlgorithm:    gc.SignAlgorithm(ica.SignAlgorithm),
+	}, parentCert, priKey.Public(), parentKey, filepath.Join(caPath, certName))
+	return err
+}
+
+// getIssuerCA 返回签发组织下节点及用户证书的CA，组织存在中间CA时使用中间CA，否则使用联盟根CA
+func (gc *GenerateConfig) getIssuerCA(leagueDomain, orgName, orgDomain, orgPath string) (caPath, caFileName, tlsCaPath, tlsCaFileName string) {
+	caPath, caFileName = path.Join(orgPath, "ca"), CertOrgCAName(orgName, orgDomain)
+	if !gnomon.File().PathExists(filepath.Join(caPath, caFileName)) {
+		return gc.getRootCA(leagueDomain)
+	}
+	tlsCaPath, tlsCaFileName = path.Join(orgPath, "tlsca"), CertOrgTLSCAName(orgName, orgDomain)
+	return
+}
+
+// copyCerts 将srcPath下所有证书复制到dstPath
+func (gc *GenerateConfig) copyCerts(srcPath, dstPath string) error {
+	fileNames, err := gnomon.File().LoopFileNames(srcPath)
+	if nil != err {
+		return err
+	}
+	for _, fileName := range fileNames {
+		if _, err = gnomon.File().Copy(filepath.Join(srcPath, fileName), filepath.Join(dstPath, fileName)); nil != err {
+			return err
+		}
+	}
+	return nil
+}
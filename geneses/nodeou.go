@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2019. ENNOO - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package geneses
+
+import (
+	"github.com/aberic/gnomon"
+	"gopkg.in/yaml.v3"
+	"path/filepath"
+)
+
+const (
+	// MspConfigFileName msp中NodeOU配置文件名称
+	MspConfigFileName = "config.yaml"
+
+	OUClient  = "client"
+	OUPeer    = "peer"
+	OUAdmin   = "admin"
+	OUOrderer = "orderer"
+)
+
+type mspConfig struct {
+	NodeOUs *nodeOUs `yaml:"NodeOUs"`
+}
+
+type nodeOUs struct {
+	Enable              bool          `yaml:"Enable"`
+	ClientOUIdentifier  *ouIdentifier `yaml:"ClientOUIdentifier"`
+	PeerOUIdentifier    *ouIdentifier `yaml:"PeerOUIdentifier"`
+	AdminOUIdentifier   *ouIdentifier `yaml:"AdminOUIdentifier"`
+	OrdererOUIdentifier *ouIdentifier `yaml:"OrdererOUIdentifier"`
+}
+
+type ouIdentifier struct {
+	Certificate                  string `yaml:"Certificate"`
+	OrganizationalUnitIdentifier string `yaml:"OrganizationalUnitIdentifier"`
+}
+
+// NodeOUsEnabled msp目录下存在config.yaml即视为启用NodeOU
+func NodeOUsEnabled(mspPath string) bool {
+	return gnomon.File().PathExists(filepath.Join(mspPath, MspConfigFileName))
+}
+
+// createMspConfig 在msp目录下生成NodeOU配置，caCertRelPath为相对msp目录的CA证书路径，如cacerts/ca.league01.com-cert.pem
+func (gc *GenerateConfig) createMspConfig(mspPath, caCertRelPath string) error {
+	data, err := yaml.Marshal(&mspConfig{NodeOUs: &nodeOUs{
+		Enable:              true,
+		ClientOUIdentifier:  &ouIdentifier{Certificate: caCertRelPath, OrganizationalUnitIdentifier: OUClient},
+		PeerOUIdentifier:    &ouIdentifier{Certificate: caCertRelPath, OrganizationalUnitIdentifier: OUPeer},
+		AdminOUIdentifier:   &ouIdentifier{Certificate: caCertRelPath, OrganizationalUnitIdentifier: OUAdmin},
+		OrdererOUIdentifier: &ouIdentifier{Certificate: caCertRelPath, OrganizationalUnitIdentifier: OUOrderer},
+	}})
+	if nil != err {
+		return err
+	}
+	_, err = gnomon.File().Append(filepath.Join(mspPath, MspConfigFileName), data, true)
+	return err
+}
+
+// childOU 返回节点或用户证书应携带的NodeOU
+func childOU(ccn ClientCANode, isPeer bool) string {
+	switch ccn {
+	case CcnAdmin:
+		return OUAdmin
+	case CcnUser:
+		return OUClient
+	default:
+		if isPeer {
+			return OUPeer
+		}
+		return OUOrderer
+	}
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright (c) 2019. ENNOO - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package geneses
+
+import (
+	"fmt"
+	"github.com/aberic/fabric-client/grpc/proto/generate"
+	"github.com/aberic/gnomon"
+	"github.com/hyperledger/fabric-sdk-go/pkg/fab/resource/genesisconfig"
+	"github.com/hyperledger/fabric/common/cauthdsl"
+	"github.com/hyperledger/fabric/common/policies"
+	"strings"
+)
+
+var (
+	// orgPolicyNames 组织配置组默认引用的策略
+	orgPolicyNames = []string{"Readers", "Writers", "Admins", "Endorsement"}
+	// applicationPolicyNames Application配置组默认引用的策略
+	applicationPolicyNames = []string{"Readers", "Writers", "Admins", "LifecycleEndorsement", "Endorsement"}
+	// ordererPolicyNames Orderer配置组默认引用的策略
+	ordererPolicyNames = []string{"Readers", "Writers", "Admins", "BlockValidation"}
+	// channelPolicyNames Channel配置组默认引用的策略
+	channelPolicyNames = []string{"Readers", "Writers", "Admins"}
+)
+
+// mergePolicies 以默认策略为基础，使用自定义策略覆盖同名策略
+func mergePolicies(defaults map[string]*genesisconfig.Policy, custom map[string]*generate.Policy) map[string]*genesisconfig.Policy {
+	merged := make(map[string]*genesisconfig.Policy, len(defaults)+len(custom))
+	for name, policy := range defaults {
+		merged[name] = policy
+	}
+	for name, policy := range custom {
+		merged[name] = &genesisconfig.Policy{Type: policy.Type.String(), Rule: policy.Rule}
+	}
+	return merged
+}
+
+// checkPolicies 校验自定义策略名称及语法，names为该层级配置组默认引用的策略
+func checkPolicies(group string, names []string, custom map[string]*generate.Policy) error {
+	for name, policy := range custom {
+		if err := checkPolicyName(group, name, names); nil != err {
+			return err
+		}
+		if err := checkPolicy(policy); nil != err {
+			return fmt.Errorf("%s policy %s is invalid: %s", group, name, err.Error())
+		}
+	}
+	return nil
+}
+
+// checkPolicyName 拒绝与默认策略仅大小写或单复数不同的名称，如Admin、writers，其它自定义名称仅可由ACL等显式引用，记录日志提示
+func checkPolicyName(group, name string, names []string) error {
+	for _, expected := range names {
+		if name == expected {
+			return nil
+		}
+	}
+	for _, expected := range names {
+		if strings.EqualFold(strings.TrimSuffix(name, "s"), strings.TrimSuffix(expected, "s")) {
+			return fmt.Errorf("%s policy %s is not expected, do you mean %s", group, name, expected)
+		}
+	}
+	gnomon.Log().Warn("policy", gnomon.Log().Field("group", group), gnomon.Log().Field("custom", name),
+		gnomon.Log().Field("expected", names))
+	return nil
+}
+
+func checkPolicy(policy *generate.Policy) error {
+	if nil == policy || policy.Rule == "" {
+		return fmt.Errorf("rule is empty")
+	}
+	switch policy.Type {
+	case generate.PolicyType_Signature:
+		if _, err := cauthdsl.FromString(policy.Rule); nil != err {
+			return err
+		}
+	case generate.PolicyType_ImplicitMeta:
+		if _, err := policies.ImplicitMetaFromString(policy.Rule); nil != err {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown policy type %d", policy.Type)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+/*
+ * Copyright (c) 2019. ENNOO - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package geneses
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"github.com/aberic/fabric-client/grpc/proto/generate"
+	"github.com/hyperledger/fabric-sdk-go/pkg/fab/resource"
+	"github.com/hyperledger/fabric-sdk-go/pkg/fab/resource/genesisconfig"
+	"github.com/hyperledger/fabric/common/tools/protolator"
+	"github.com/hyperledger/fabric/protos/common"
+	"github.com/hyperledger/fabric/protos/orderer"
+	"github.com/hyperledger/fabric/protos/peer"
+	"golang.org/x/protobuf/proto"
+)
+
+// PrintOrg 生成组织的ConfigGroup，包含MSP、策略及peer组织的锚节点，等同于configtxgen -printOrg，
+// 返回protobuf字节及JSON，供其它成员将本组织加入通道
+func PrintOrg(leagueDomain string, org *generate.OrgInBlock) ([]byte, string, error) {
+	if nil == org || org.Name == "" {
+		return nil, "", errors.New("org is required")
+	}
+	if err := checkPolicies(org.Name, orgPolicyNames, org.Policies); nil != err {
+		return nil, "", err
+	}
+	g := &Genesis{Info: &generate.ReqGenesis{
+		League: &generate.LeagueInBlock{
+			Domain:        leagueDomain,
+			ConsensusType: generate.ConsensusType_solo,
+			BatchTimeout:  2,
+			BatchSize:     &generate.BatchSize{MaxMessageCount: 500, AbsoluteMaxBytes: 10 * 1024 * 1024, PreferredMaxBytes: 2 * 1024 * 1024},
+		},
+		Orgs: []*generate.OrgInBlock{org},
+	}}
+	g.orderOrganizations, g.peerOrganizations, g.allOrganizations = g.organizations(g.Info.Orgs)
+	if len(g.allOrganizations) == 0 {
+		return nil, "", fmt.Errorf("org type %s is not supported", org.Type.String())
+	}
+	if err := g.checkOrgMsps(g.allOrganizations); nil != err {
+		return nil, "", err
+	}
+	// 借助仅含该组织的配置生成区块，由sdk编码组织ConfigGroup后再取出
+	profile := &genesisconfig.Profile{Orderer: g.orderer(), Policies: g.channelDefaults()}
+	groupName := "Orderer"
+	if len(g.peerOrganizations) > 0 {
+		profile.Application = g.applications(g.peerOrganizations)
+		groupName = "Application"
+	}
+	blockData, err := resource.CreateGenesisBlock(profile, "printorg")
+	if nil != err {
+		return nil, "", err
+	}
+	orgGroup, err := blockOrgGroup(blockData, groupName, org.Name)
+	if nil != err {
+		return nil, "", err
+	}
+	data, err := proto.Marshal(orgGroup)
+	if nil != err {
+		return nil, "", err
+	}
+	var msg proto.Message = &orderer.DynamicOrdererOrgGroup{ConfigGroup: orgGroup}
+	if groupName == "Application" {
+		msg = &peer.DynamicApplicationOrgGroup{ConfigGroup: orgGroup}
+	}
+	var buf bytes.Buffer
+	if err = protolator.DeepMarshalJSON(&buf, msg); nil != err {
+		return nil, "", err
+	}
+	return data, buf.String(), nil
+}
+
+// blockOrgGroup 从配置区块中取出指定组织的ConfigGroup
+func blockOrgGroup(blockData []byte, groupName, orgName string) (*common.ConfigGroup, error) {
+	block := &common.Block{}
+	if err := proto.Unmarshal(blockData, block); nil != err {
+		return nil, err
+	}
+	if nil == block.Data || len(block.Data.Data) == 0 {
+		return nil, errors.New("block data is empty")
+	}
+	envelope := &common.Envelope{}
+	if err := proto.Unmarshal(block.Data.Data[0], envelope); nil != err {
+		return nil, err
+	}
+	payload := &common.Payload{}
+	if err := proto.Unmarshal(envelope.Payload, payload); nil != err {
+		return nil, err
+	}
+	configEnvelope := &common.ConfigEnvelope{}
+	if err := proto.Unmarshal(payload.Data, configEnvelope); nil != err {
+		return nil, err
+	}
+	group := configEnvelope.Config.ChannelGroup.Groups[groupName]
+	if nil == group || nil == group.Groups[orgName] {
+		return nil, fmt.Errorf("org %s is not exist in config group %s", orgName, groupName)
+	}
+	return group.Groups[orgName], nil
+}
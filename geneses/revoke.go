@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2019. ENNOO - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package geneses
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"github.com/aberic/fabric-client/grpc/proto/generate"
+	"github.com/aberic/gnomon"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	// CrlFileName 组织msp中CRL文件名称
+	CrlFileName = "crl.pem"
+)
+
+// CrlFilePath 组织msp中CRL文件路径
+func CrlFilePath(leagueDomain, orgDomain, orgName string, isPeer bool) string {
+	return filepath.Join(CryptoOrgMspPath(leagueDomain, orgDomain, orgName, isPeer), "crls", CrlFileName)
+}
+
+// RevokeCert 吊销组织下节点或用户证书，由签发该证书的CA生成CRL并写入组织msp的crls目录，返回PEM格式CRL
+func (gc *GenerateConfig) RevokeCert(req *generate.ReqRevokeCert) ([]byte, error) {
+	serialNumber, ok := new(big.Int).SetString(strings.Replace(req.SerialNumber, ":", "", -1), 16)
+	if !ok {
+		return nil, fmt.Errorf("serial number %s is invalid", req.SerialNumber)
+	}
+	isPeer := req.OrgType == generate.OrgType_Peer
+	orgMspPath := CryptoOrgMspPath(req.LeagueDomain, req.OrgDomain, req.OrgName, isPeer)
+	if !gnomon.File().PathExists(orgMspPath) {
+		return nil, errors.New("org done't exist")
+	}
+	orgPath := filepath.Dir(orgMspPath)
+	cert, err := gc.findOrgCert(orgPath, serialNumber)
+	if nil != err {
+		return nil, err
+	}
+	caPath, caCertName, _, _ := gc.getIssuerCA(req.LeagueDomain, req.OrgName, req.OrgDomain, orgPath)
+	caCert, caKey, err := gc.loadCA(caPath, caCertName)
+	if nil != err {
+		return nil, err
+	}
+	if err = cert.CheckSignatureFrom(caCert); nil != err {
+		return nil, fmt.Errorf("certificate %s is not issued by %s", req.SerialNumber, caCert.Subject.CommonName)
+	}
+	crlFilePath := CrlFilePath(req.LeagueDomain, req.OrgDomain, req.OrgName, isPeer)
+	template := &x509.RevocationList{Number: big.NewInt(1)}
+	if gnomon.File().PathExists(crlFilePath) {
+		crl, err := gc.loadCrl(crlFilePath)
+		if nil != err {
+			return nil, err
+		}
+		for _, entry := range crl.RevokedCertificateEntries {
+			if entry.SerialNumber.Cmp(serialNumber) == 0 {
+				return nil, fmt.Errorf("certificate %s is already revoked", req.SerialNumber)
+			}
+		}
+		template.RevokedCertificateEntries = crl.RevokedCertificateEntries
+		if nil != crl.Number {
+			template.Number = new(big.Int).Add(crl.Number, big.NewInt(1))
+		}
+	}
+	nextUpdate := req.NextUpdate
+	if nextUpdate <= 0 {
+		nextUpdate = 365
+	}
+	now := time.Now()
+	template.ThisUpdate = now
+	template.NextUpdate = now.Add(time.Duration(nextUpdate) * 24 * time.Hour)
+	template.RevokedCertificateEntries = append(template.RevokedCertificateEntries, x509.RevocationListEntry{
+		SerialNumber:   serialNumber,
+		RevocationTime: now,
+		ReasonCode:     int(req.Reason),
+	})
+	crlData, err := x509.CreateRevocationList(rand.Reader, template, caCert, caKey)
+	if nil != err {
+		return nil, err
+	}
+	crlPem := pem.EncodeToMemory(&pem.Block{Type: "X509 CRL", Bytes: crlData})
+	if err = os.MkdirAll(filepath.Dir(crlFilePath), 0755); nil != err {
+		return nil, err
+	}
+	if err = ioutil.WriteFile(crlFilePath, crlPem, 0644); nil != err {
+		return nil, err
+	}
+	return crlPem, nil
+}
+
+// findOrgCert 在组织下所有节点及用户的signcerts中查找指定序列号的证书
+func (gc *GenerateConfig) findOrgCert(orgPath string, serialNumber *big.Int) (*x509.Certificate, error) {
+	var cert *x509.Certificate
+	err := filepath.Walk(orgPath, func(filePath string, info os.FileInfo, err error) error {
+		if nil != err || nil != cert || info.IsDir() || filepath.Base(filepath.Dir(filePath)) != "signcerts" {
+			return err
+		}
+		c, err := gnomon.CA().LoadCrtFromFP(filePath)
+		if nil != err {
+			return nil
+		}
+		if c.SerialNumber.Cmp(serialNumber) == 0 {
+			cert = c
+		}
+		return nil
+	})
+	if nil != err {
+		return nil, err
+	}
+	if nil == cert {
+		return nil, fmt.Errorf("certificate %x is not exist in org", serialNumber)
+	}
+	return cert, nil
+}
+
+func (gc *GenerateConfig) loadCrl(crlFilePath string) (*x509.RevocationList, error) {
+	data, err := ioutil.ReadFile(crlFilePath)
+	if nil != err {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if nil == block {
+		return nil, errors.New("crl pem is invalid")
+	}
+	return x509.ParseRevocationList(block.Bytes)
+}
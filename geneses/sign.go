@@ -0,0 +1,145 @@
+/*
+ * Copyright (c) 2019. ENNOO - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package geneses
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"github.com/aberic/fabric-client/grpc/proto/generate"
+	"github.com/aberic/gnomon"
+	"math/big"
+	"path/filepath"
+	"time"
+)
+
+// localSign 使用caPath下的联盟根CA或组织中间CA私钥离线签发证书，替代向fabric-ca发起enroll请求，ou不为空时追加至证书OrganizationalUnit
+func (gc *GenerateConfig) localSign(child *generate.OrgChild, caPath, caCertName, path, certFileName, ou string) error {
+	if nil == child.EnrollInfo || len(child.EnrollInfo.CsrPem) == 0 {
+		return errors.New("csr pem is required to sign certificate locally")
+	}
+	csr, err := gc.parseCsr(child.EnrollInfo.CsrPem)
+	if nil != err {
+		return err
+	}
+	parentCert, parentKey, err := gc.loadCA(caPath, caCertName)
+	if nil != err {
+		return err
+	}
+	template := gc.childTemplate(child, csr.PublicKey)
+	template.Subject, template.DNSNames = gc.childSubject(child, csr)
+	if ou != "" {
+		template.Subject.OrganizationalUnit = append(template.Subject.OrganizationalUnit, ou)
+	}
+	template.KeyUsage = x509.KeyUsageDigitalSignature
+	_, err = gc.createCertificate(template, parentCert, csr.PublicKey, parentKey, filepath.Join(path, certFileName))
+	return err
+}
+
+// childSubject 节点及用户证书的主题及SAN，优先取自enrollRequest，未设置时取自csr，csr可为nil
+func (gc *GenerateConfig) childSubject(child *generate.OrgChild, csr *x509.CertificateRequest) (subject pkix.Name, dnsNames []string) {
+	if nil != csr {
+		subject, dnsNames = csr.Subject, csr.DNSNames
+	}
+	if nil == child.EnrollInfo || nil == child.EnrollInfo.EnrollRequest {
+		return
+	}
+	if req := child.EnrollInfo.EnrollRequest; nil != req.Name && req.Name.CommonName != "" {
+		subject = gc.getSubject(req.Name)
+	}
+	if hosts := child.EnrollInfo.EnrollRequest.Hosts; len(hosts) > 0 {
+		dnsNames = hosts
+	}
+	return
+}
+
+// childTemplate 节点及用户证书模板，有效期取自enrollInfo，未设置时与tls证书一致
+func (gc *GenerateConfig) childTemplate(child *generate.OrgChild, pubKey interface{}) *x509.Certificate {
+	notBefore := time.Now()
+	notAfter := time.Now().Add(5000 * 24 * time.Hour)
+	if nil != child.EnrollInfo {
+		if child.EnrollInfo.NotBefore != 0 {
+			notBefore = time.Now().Add(time.Duration(child.EnrollInfo.NotBefore) * 24 * time.Hour)
+		}
+		if child.EnrollInfo.NotAfter != 0 {
+			notAfter = time.Now().Add(time.Duration(child.EnrollInfo.NotAfter) * 24 * time.Hour)
+		}
+	}
+	return &x509.Certificate{
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		BasicConstraintsValid: true,
+		IsCA:                  false,
+		SignatureAlgorithm:    gc.SignAlgorithm(child.SignAlgorithm),
+		SubjectKeyId:          gc.publicKeySKI(pubKey),
+	}
+}
+
+func (gc *GenerateConfig) parseCsr(csrPem []byte) (*x509.CertificateRequest, error) {
+	block, _ := pem.Decode(csrPem)
+	if nil == block {
+		return nil, errors.New("csr pem is invalid")
+	}
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if nil != err {
+		return nil, err
+	}
+	if err = csr.CheckSignature(); nil != err {
+		return nil, err
+	}
+	return csr, nil
+}
+
+// loadCA 加载CA证书及同目录下的私钥
+func (gc *GenerateConfig) loadCA(caPath, caCertName string) (*x509.Certificate, crypto.Signer, error) {
+	cert, err := gnomon.CA().LoadCrtFromFP(filepath.Join(caPath, caCertName))
+	if nil != err {
+		return nil, nil, err
+	}
+	priKey, err := gc.getPriKeyFromFile(filepath.Join(caPath, GeneratePriKeyFileName))
+	if nil != err {
+		return nil, nil, err
+	}
+	return cert, priKey, nil
+}
+
+// createCertificate 由父证书签发证书并以PEM格式写入certFilePath
+func (gc *GenerateConfig) createCertificate(template, parent *x509.Certificate, pubKey interface{},
+	parentKey crypto.Signer, certFilePath string) (*x509.Certificate, error) {
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if nil != err {
+		return nil, err
+	}
+	template.SerialNumber = serialNumber
+	if nil == template.SubjectKeyId {
+		template.SubjectKeyId = gc.publicKeySKI(pubKey)
+	}
+	if nil != parent {
+		template.AuthorityKeyId = parent.SubjectKeyId
+	} else {
+		parent = template
+	}
+	certData, err := x509.CreateCertificate(rand.Reader, template, parent, pubKey, parentKey)
+	if nil != err {
+		return nil, err
+	}
+	if _, err = gnomon.File().Append(certFilePath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certData}), true); nil != err {
+		return nil, err
+	}
+	return x509.ParseCertificate(certData)
+}
@@ -0,0 +1,257 @@
+/*
+ * Copyright (c) 2019. ENNOO - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package geneses
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"github.com/aberic/fabric-client/grpc/proto/generate"
+	"github.com/aberic/gnomon"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	// defaultExpireDays 证书在该天数内过期时告警
+	defaultExpireDays = 30
+)
+
+// mspCert msp目录中的证书
+type mspCert struct {
+	path string
+	cert *x509.Certificate
+}
+
+// mspVerifier 收集msp校验过程中发现的问题
+type mspVerifier struct {
+	gc           *GenerateConfig
+	leaguePath   string
+	now          time.Time
+	expireBefore time.Time
+	problems     []*generate.MSPProblem
+}
+
+// VerifyMSP 校验组织或节点、用户的msp目录，检查证书签发关系、私钥、tls证书及有效期，返回发现的问题
+func (gc *GenerateConfig) VerifyMSP(req *generate.ReqVerifyMSP) ([]*generate.MSPProblem, error) {
+	isPeer := req.OrgType == generate.OrgType_Peer
+	mspPath := CryptoOrgMspPath(req.LeagueDomain, req.OrgDomain, req.OrgName, isPeer)
+	if !gnomon.File().PathExists(mspPath) {
+		return nil, errors.New("org done't exist")
+	}
+	var childPath string
+	if req.ChildName != "" {
+		ccn := CcnNode
+		if req.IsUser {
+			ccn = CcnUser
+		}
+		_, childPath = CryptoOrgAndNodePath(req.LeagueDomain, req.OrgDomain, req.OrgName, req.ChildName, isPeer, ccn)
+		if !gnomon.File().PathExists(childPath) {
+			return nil, errors.New("node or user done't exist")
+		}
+		mspPath = filepath.Join(childPath, "msp")
+	}
+	expireDays := req.ExpireDays
+	if expireDays <= 0 {
+		expireDays = defaultExpireDays
+	}
+	now := time.Now()
+	mv := &mspVerifier{gc: gc, leaguePath: filepath.Join(dataPath, req.LeagueDomain), now: now,
+		expireBefore: now.Add(time.Duration(expireDays) * 24 * time.Hour)}
+	caOpts := mv.verifyOptions(mspPath, "cacerts", "intermediatecerts")
+	tlsOpts := mv.verifyOptions(mspPath, "tlscacerts", "tlsintermediatecerts")
+	for _, admin := range mv.certs(filepath.Join(mspPath, "admincerts")) {
+		mv.verify(admin, caOpts, "admincert does not chain to cacerts")
+	}
+	if req.ChildName == "" {
+		return mv.problems, nil
+	}
+	signCerts := mv.certs(filepath.Join(mspPath, "signcerts"))
+	if len(signCerts) == 0 {
+		mv.add(filepath.Join(mspPath, "signcerts"), false, "signcerts is empty")
+	}
+	for _, signCert := range signCerts {
+		mv.verify(signCert, caOpts, "signcert is not issued by any cacert")
+	}
+	mv.verifyKeystore(filepath.Join(mspPath, "keystore"), signCerts)
+	tlsFileName := "server.crt"
+	if req.IsUser {
+		tlsFileName = "client.crt"
+	}
+	tlsFilePath := filepath.Join(childPath, "tls", tlsFileName)
+	if !gnomon.File().PathExists(tlsFilePath) {
+		mv.add(tlsFilePath, false, "tls cert is missing")
+		return mv.problems, nil
+	}
+	for _, tlsCert := range mv.certs(tlsFilePath) {
+		mv.verify(tlsCert, tlsOpts, "tls cert is not issued by any tlscacert")
+		if !req.IsUser {
+			mv.verifyHost(tlsCert, NodeDomain(req.OrgName, req.OrgDomain, req.ChildName))
+		}
+	}
+	return mv.problems, nil
+}
+
+func (mv *mspVerifier) add(filePath string, warning bool, format string, args ...interface{}) {
+	rel, err := filepath.Rel(mv.leaguePath, filePath)
+	if nil != err {
+		rel = filePath
+	}
+	mv.problems = append(mv.problems, &generate.MSPProblem{Path: rel, Message: fmt.Sprintf(format, args...), Warning: warning})
+}
+
+// certs 读取目录或文件中的证书，同时检查证书有效期
+func (mv *mspVerifier) certs(path string) []*mspCert {
+	if !gnomon.File().PathExists(path) {
+		return nil
+	}
+	filePaths := []string{path}
+	if info, err := os.Stat(path); nil == err && info.IsDir() {
+		fileNames, _ := gnomon.File().LoopFileNames(path)
+		filePaths = filePaths[:0]
+		for _, fileName := range fileNames {
+			filePaths = append(filePaths, filepath.Join(path, fileName))
+		}
+	}
+	var certs []*mspCert
+	for _, filePath := range filePaths {
+		cert, err := gnomon.CA().LoadCrtFromFP(filePath)
+		if nil != err {
+			mv.add(filePath, false, "cert is invalid: %s", err.Error())
+			continue
+		}
+		switch {
+		case mv.now.After(cert.NotAfter):
+			mv.add(filePath, false, "cert %s expired at %s", cert.Subject.CommonName, cert.NotAfter.Format(time.RFC3339))
+		case mv.expireBefore.After(cert.NotAfter):
+			mv.add(filePath, true, "cert %s will expire at %s", cert.Subject.CommonName, cert.NotAfter.Format(time.RFC3339))
+		case mv.now.Before(cert.NotBefore):
+			mv.add(filePath, false, "cert %s is not valid before %s", cert.Subject.CommonName, cert.NotBefore.Format(time.RFC3339))
+		}
+		certs = append(certs, &mspCert{path: filePath, cert: cert})
+	}
+	return certs
+}
+
+// verifyOptions 由msp中的根证书及中间证书目录生成校验选项，根证书缺失时记录问题并返回nil
+func (mv *mspVerifier) verifyOptions(mspPath, rootDir, intermediateDir string) *x509.VerifyOptions {
+	roots := mv.certs(filepath.Join(mspPath, rootDir))
+	if len(roots) == 0 {
+		mv.add(filepath.Join(mspPath, rootDir), false, "%s is missing or empty", rootDir)
+		return nil
+	}
+	opts := &x509.VerifyOptions{
+		Roots:         x509.NewCertPool(),
+		Intermediates: x509.NewCertPool(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}
+	for _, root := range roots {
+		opts.Roots.AddCert(root.cert)
+	}
+	for _, intermediate := range mv.certs(filepath.Join(mspPath, intermediateDir)) {
+		opts.Intermediates.AddCert(intermediate.cert)
+	}
+	return opts
+}
+
+func (mv *mspVerifier) verify(mc *mspCert, opts *x509.VerifyOptions, message string) {
+	if nil == opts {
+		return
+	}
+	// 有效期问题已由certs记录，此处仅校验签发关系
+	verifyOpts := *opts
+	verifyOpts.CurrentTime = mc.cert.NotBefore.Add(time.Second)
+	if _, err := mc.cert.Verify(verifyOpts); nil != err {
+		mv.add(mc.path, false, "%s: %s", message, err.Error())
+	}
+}
+
+// verifyHost 检查tls证书SAN是否包含节点域名
+func (mv *mspVerifier) verifyHost(mc *mspCert, host string) {
+	for _, dnsName := range mc.cert.DNSNames {
+		if dnsName == host {
+			return
+		}
+	}
+	mv.add(mc.path, false, "tls cert SANs %v do not contain node domain %s", mc.cert.DNSNames, host)
+}
+
+// verifyKeystore 检查keystore中是否存在与签名证书公钥匹配的私钥，以及私钥文件名是否与sdk查找私钥所用的SKI一致
+func (mv *mspVerifier) verifyKeystore(keystorePath string, signCerts []*mspCert) {
+	fileNames, _ := gnomon.File().LoopFileNames(keystorePath)
+	if len(fileNames) == 0 {
+		// 离线签发时私钥由调用方持有，仅用于验签的msp可不含私钥，故仅告警
+		mv.add(keystorePath, true, "keystore is empty, msp can not sign")
+		return
+	}
+	keys := map[string]crypto.PublicKey{}
+	for _, fileName := range fileNames {
+		filePath := filepath.Join(keystorePath, fileName)
+		priKey, err := loadKeystoreKey(filePath)
+		if nil != err {
+			mv.add(filePath, false, "private key is invalid: %s", err.Error())
+			continue
+		}
+		keys[filePath] = priKey.Public()
+	}
+	for _, signCert := range signCerts {
+		var keyFilePath string
+		for filePath, pubKey := range keys {
+			if key, ok := pubKey.(interface{ Equal(crypto.PublicKey) bool }); ok && key.Equal(signCert.cert.PublicKey) {
+				keyFilePath = filePath
+			}
+		}
+		if keyFilePath == "" {
+			mv.add(signCert.path, false, "no keystore private key matches signcert public key")
+			continue
+		}
+		fileName := filepath.Base(keyFilePath)
+		ski := hex.EncodeToString(mv.gc.publicKeySKI(keys[keyFilePath]))
+		// sdk按"<SKI>_sk"查找私钥，未命中时遍历keystore，故仅告警
+		if fileName != ski+"_sk" {
+			mv.add(keyFilePath, true, "keystore file name does not match signcert SKI %s", ski)
+		}
+	}
+}
+
+// loadKeystoreKey 读取PKCS8、EC或PKCS1格式的PEM私钥，cryptogen及fabric-ca均以PKCS8格式写入keystore
+func loadKeystoreKey(filePath string) (crypto.Signer, error) {
+	data, err := ioutil.ReadFile(filePath)
+	if nil != err {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if nil == block {
+		return nil, errors.New("private key pem is invalid")
+	}
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); nil == err {
+		if signer, ok := key.(crypto.Signer); ok {
+			return signer, nil
+		}
+		return nil, errors.New("private key is not support")
+	}
+	if key, err := x509.ParseECPrivateKey(block.Bytes); nil == err {
+		return key, nil
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); nil == err {
+		return key, nil
+	}
+	return nil, errors.New("private key is not support")
+}
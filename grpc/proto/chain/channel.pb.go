@@ -20,6 +20,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// CapabilityGroup 通道配置中可启用能力的层级
+type CapabilityGroup int32
+
+const (
+	CapabilityGroup_GroupChannel     CapabilityGroup = 0
+	CapabilityGroup_GroupOrderer     CapabilityGroup = 1
+	CapabilityGroup_GroupApplication CapabilityGroup = 2
+)
+
+var CapabilityGroup_name = map[int32]string{
+	0: "GroupChannel",
+	1: "GroupOrderer",
+	2: "GroupApplication",
+}
+
+var CapabilityGroup_value = map[string]int32{
+	"GroupChannel":     0,
+	"GroupOrderer":     1,
+	"GroupApplication": 2,
+}
+
+func (x CapabilityGroup) String() string {
+	return proto.EnumName(CapabilityGroup_name, int32(x))
+}
+
+func (CapabilityGroup) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4ba4cdd5356e4d30, []int{0}
+}
+
 type ChannelCreate struct {
 	ConfigID             string   `protobuf:"bytes,1,opt,name=configID,proto3" json:"configID,omitempty"`
 	LeagueName           string   `protobuf:"bytes,2,opt,name=leagueName,proto3" json:"leagueName,omitempty"`
@@ -209,30 +238,500 @@ func (m *ChannelList) GetPeerName() string {
 	return ""
 }
 
+// ChannelSigner 通道配置更新签名用户
+type ChannelSigner struct {
+	OrgName              string   `protobuf:"bytes,1,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	OrgUser              string   `protobuf:"bytes,2,opt,name=orgUser,proto3" json:"orgUser,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelSigner) Reset()         { *m = ChannelSigner{} }
+func (m *ChannelSigner) String() string { return proto.CompactTextString(m) }
+func (*ChannelSigner) ProtoMessage()    {}
+func (*ChannelSigner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ba4cdd5356e4d30, []int{3}
+}
+
+func (m *ChannelSigner) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelSigner.Unmarshal(m, b)
+}
+func (m *ChannelSigner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelSigner.Marshal(b, m, deterministic)
+}
+func (m *ChannelSigner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelSigner.Merge(m, src)
+}
+func (m *ChannelSigner) XXX_Size() int {
+	return xxx_messageInfo_ChannelSigner.Size(m)
+}
+func (m *ChannelSigner) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelSigner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelSigner proto.InternalMessageInfo
+
+func (m *ChannelSigner) GetOrgName() string {
+	if m != nil {
+		return m.OrgName
+	}
+	return ""
+}
+
+func (m *ChannelSigner) GetOrgUser() string {
+	if m != nil {
+		return m.OrgUser
+	}
+	return ""
+}
+
+type ChannelCapabilities struct {
+	ConfigID             string   `protobuf:"bytes,1,opt,name=configID,proto3" json:"configID,omitempty"`
+	OrgName              string   `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	OrgUser              string   `protobuf:"bytes,3,opt,name=orgUser,proto3" json:"orgUser,omitempty"`
+	ChannelID            string   `protobuf:"bytes,4,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	PeerName             string   `protobuf:"bytes,5,opt,name=peerName,proto3" json:"peerName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelCapabilities) Reset()         { *m = ChannelCapabilities{} }
+func (m *ChannelCapabilities) String() string { return proto.CompactTextString(m) }
+func (*ChannelCapabilities) ProtoMessage()    {}
+func (*ChannelCapabilities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ba4cdd5356e4d30, []int{4}
+}
+
+func (m *ChannelCapabilities) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelCapabilities.Unmarshal(m, b)
+}
+func (m *ChannelCapabilities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelCapabilities.Marshal(b, m, deterministic)
+}
+func (m *ChannelCapabilities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelCapabilities.Merge(m, src)
+}
+func (m *ChannelCapabilities) XXX_Size() int {
+	return xxx_messageInfo_ChannelCapabilities.Size(m)
+}
+func (m *ChannelCapabilities) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelCapabilities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelCapabilities proto.InternalMessageInfo
+
+func (m *ChannelCapabilities) GetConfigID() string {
+	if m != nil {
+		return m.ConfigID
+	}
+	return ""
+}
+
+func (m *ChannelCapabilities) GetOrgName() string {
+	if m != nil {
+		return m.OrgName
+	}
+	return ""
+}
+
+func (m *ChannelCapabilities) GetOrgUser() string {
+	if m != nil {
+		return m.OrgUser
+	}
+	return ""
+}
+
+func (m *ChannelCapabilities) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *ChannelCapabilities) GetPeerName() string {
+	if m != nil {
+		return m.PeerName
+	}
+	return ""
+}
+
+type ChannelCapabilityUpgrade struct {
+	ConfigID             string           `protobuf:"bytes,1,opt,name=configID,proto3" json:"configID,omitempty"`
+	OrgName              string           `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	OrgUser              string           `protobuf:"bytes,3,opt,name=orgUser,proto3" json:"orgUser,omitempty"`
+	ChannelID            string           `protobuf:"bytes,4,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	PeerName             string           `protobuf:"bytes,5,opt,name=peerName,proto3" json:"peerName,omitempty"`
+	Group                CapabilityGroup  `protobuf:"varint,6,opt,name=group,proto3,enum=chain.CapabilityGroup" json:"group,omitempty"`
+	Capability           string           `protobuf:"bytes,7,opt,name=capability,proto3" json:"capability,omitempty"`
+	PeerVersion          string           `protobuf:"bytes,8,opt,name=peerVersion,proto3" json:"peerVersion,omitempty"`
+	OrdererVersion       string           `protobuf:"bytes,9,opt,name=ordererVersion,proto3" json:"ordererVersion,omitempty"`
+	Signers              []*ChannelSigner `protobuf:"bytes,10,rep,name=signers,proto3" json:"signers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ChannelCapabilityUpgrade) Reset()         { *m = ChannelCapabilityUpgrade{} }
+func (m *ChannelCapabilityUpgrade) String() string { return proto.CompactTextString(m) }
+func (*ChannelCapabilityUpgrade) ProtoMessage()    {}
+func (*ChannelCapabilityUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ba4cdd5356e4d30, []int{5}
+}
+
+func (m *ChannelCapabilityUpgrade) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelCapabilityUpgrade.Unmarshal(m, b)
+}
+func (m *ChannelCapabilityUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelCapabilityUpgrade.Marshal(b, m, deterministic)
+}
+func (m *ChannelCapabilityUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelCapabilityUpgrade.Merge(m, src)
+}
+func (m *ChannelCapabilityUpgrade) XXX_Size() int {
+	return xxx_messageInfo_ChannelCapabilityUpgrade.Size(m)
+}
+func (m *ChannelCapabilityUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelCapabilityUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelCapabilityUpgrade proto.InternalMessageInfo
+
+func (m *ChannelCapabilityUpgrade) GetConfigID() string {
+	if m != nil {
+		return m.ConfigID
+	}
+	return ""
+}
+
+func (m *ChannelCapabilityUpgrade) GetOrgName() string {
+	if m != nil {
+		return m.OrgName
+	}
+	return ""
+}
+
+func (m *ChannelCapabilityUpgrade) GetOrgUser() string {
+	if m != nil {
+		return m.OrgUser
+	}
+	return ""
+}
+
+func (m *ChannelCapabilityUpgrade) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *ChannelCapabilityUpgrade) GetPeerName() string {
+	if m != nil {
+		return m.PeerName
+	}
+	return ""
+}
+
+func (m *ChannelCapabilityUpgrade) GetGroup() CapabilityGroup {
+	if m != nil {
+		return m.Group
+	}
+	return CapabilityGroup_GroupChannel
+}
+
+func (m *ChannelCapabilityUpgrade) GetCapability() string {
+	if m != nil {
+		return m.Capability
+	}
+	return ""
+}
+
+func (m *ChannelCapabilityUpgrade) GetPeerVersion() string {
+	if m != nil {
+		return m.PeerVersion
+	}
+	return ""
+}
+
+func (m *ChannelCapabilityUpgrade) GetOrdererVersion() string {
+	if m != nil {
+		return m.OrdererVersion
+	}
+	return ""
+}
+
+func (m *ChannelCapabilityUpgrade) GetSigners() []*ChannelSigner {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+// Capabilities 通道各层级当前启用的能力
+type Capabilities struct {
+	Channel              []string `protobuf:"bytes,1,rep,name=channel,proto3" json:"channel,omitempty"`
+	Orderer              []string `protobuf:"bytes,2,rep,name=orderer,proto3" json:"orderer,omitempty"`
+	Application          []string `protobuf:"bytes,3,rep,name=application,proto3" json:"application,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Capabilities) Reset()         { *m = Capabilities{} }
+func (m *Capabilities) String() string { return proto.CompactTextString(m) }
+func (*Capabilities) ProtoMessage()    {}
+func (*Capabilities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ba4cdd5356e4d30, []int{6}
+}
+
+func (m *Capabilities) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Capabilities.Unmarshal(m, b)
+}
+func (m *Capabilities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Capabilities.Marshal(b, m, deterministic)
+}
+func (m *Capabilities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Capabilities.Merge(m, src)
+}
+func (m *Capabilities) XXX_Size() int {
+	return xxx_messageInfo_Capabilities.Size(m)
+}
+func (m *Capabilities) XXX_DiscardUnknown() {
+	xxx_messageInfo_Capabilities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Capabilities proto.InternalMessageInfo
+
+func (m *Capabilities) GetChannel() []string {
+	if m != nil {
+		return m.Channel
+	}
+	return nil
+}
+
+func (m *Capabilities) GetOrderer() []string {
+	if m != nil {
+		return m.Orderer
+	}
+	return nil
+}
+
+func (m *Capabilities) GetApplication() []string {
+	if m != nil {
+		return m.Application
+	}
+	return nil
+}
+
+type ChannelACLs struct {
+	ConfigID             string   `protobuf:"bytes,1,opt,name=configID,proto3" json:"configID,omitempty"`
+	OrgName              string   `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	OrgUser              string   `protobuf:"bytes,3,opt,name=orgUser,proto3" json:"orgUser,omitempty"`
+	ChannelID            string   `protobuf:"bytes,4,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	PeerName             string   `protobuf:"bytes,5,opt,name=peerName,proto3" json:"peerName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChannelACLs) Reset()         { *m = ChannelACLs{} }
+func (m *ChannelACLs) String() string { return proto.CompactTextString(m) }
+func (*ChannelACLs) ProtoMessage()    {}
+func (*ChannelACLs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ba4cdd5356e4d30, []int{7}
+}
+
+func (m *ChannelACLs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelACLs.Unmarshal(m, b)
+}
+func (m *ChannelACLs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelACLs.Marshal(b, m, deterministic)
+}
+func (m *ChannelACLs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelACLs.Merge(m, src)
+}
+func (m *ChannelACLs) XXX_Size() int {
+	return xxx_messageInfo_ChannelACLs.Size(m)
+}
+func (m *ChannelACLs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelACLs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelACLs proto.InternalMessageInfo
+
+func (m *ChannelACLs) GetConfigID() string {
+	if m != nil {
+		return m.ConfigID
+	}
+	return ""
+}
+
+func (m *ChannelACLs) GetOrgName() string {
+	if m != nil {
+		return m.OrgName
+	}
+	return ""
+}
+
+func (m *ChannelACLs) GetOrgUser() string {
+	if m != nil {
+		return m.OrgUser
+	}
+	return ""
+}
+
+func (m *ChannelACLs) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *ChannelACLs) GetPeerName() string {
+	if m != nil {
+		return m.PeerName
+	}
+	return ""
+}
+
+type ChannelACLUpdate struct {
+	ConfigID             string            `protobuf:"bytes,1,opt,name=configID,proto3" json:"configID,omitempty"`
+	OrgName              string            `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	OrgUser              string            `protobuf:"bytes,3,opt,name=orgUser,proto3" json:"orgUser,omitempty"`
+	ChannelID            string            `protobuf:"bytes,4,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	PeerName             string            `protobuf:"bytes,5,opt,name=peerName,proto3" json:"peerName,omitempty"`
+	Acls                 map[string]string `protobuf:"bytes,6,rep,name=acls,proto3" json:"acls,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Signers              []*ChannelSigner  `protobuf:"bytes,7,rep,name=signers,proto3" json:"signers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ChannelACLUpdate) Reset()         { *m = ChannelACLUpdate{} }
+func (m *ChannelACLUpdate) String() string { return proto.CompactTextString(m) }
+func (*ChannelACLUpdate) ProtoMessage()    {}
+func (*ChannelACLUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ba4cdd5356e4d30, []int{8}
+}
+
+func (m *ChannelACLUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelACLUpdate.Unmarshal(m, b)
+}
+func (m *ChannelACLUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelACLUpdate.Marshal(b, m, deterministic)
+}
+func (m *ChannelACLUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelACLUpdate.Merge(m, src)
+}
+func (m *ChannelACLUpdate) XXX_Size() int {
+	return xxx_messageInfo_ChannelACLUpdate.Size(m)
+}
+func (m *ChannelACLUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelACLUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelACLUpdate proto.InternalMessageInfo
+
+func (m *ChannelACLUpdate) GetConfigID() string {
+	if m != nil {
+		return m.ConfigID
+	}
+	return ""
+}
+
+func (m *ChannelACLUpdate) GetOrgName() string {
+	if m != nil {
+		return m.OrgName
+	}
+	return ""
+}
+
+func (m *ChannelACLUpdate) GetOrgUser() string {
+	if m != nil {
+		return m.OrgUser
+	}
+	return ""
+}
+
+func (m *ChannelACLUpdate) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *ChannelACLUpdate) GetPeerName() string {
+	if m != nil {
+		return m.PeerName
+	}
+	return ""
+}
+
+func (m *ChannelACLUpdate) GetAcls() map[string]string {
+	if m != nil {
+		return m.Acls
+	}
+	return nil
+}
+
+func (m *ChannelACLUpdate) GetSigners() []*ChannelSigner {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("chain.CapabilityGroup", CapabilityGroup_name, CapabilityGroup_value)
 	proto.RegisterType((*ChannelCreate)(nil), "chain.ChannelCreate")
 	proto.RegisterType((*ChannelJoin)(nil), "chain.ChannelJoin")
 	proto.RegisterType((*ChannelList)(nil), "chain.ChannelList")
+	proto.RegisterType((*ChannelSigner)(nil), "chain.ChannelSigner")
+	proto.RegisterType((*ChannelCapabilities)(nil), "chain.ChannelCapabilities")
+	proto.RegisterType((*ChannelCapabilityUpgrade)(nil), "chain.ChannelCapabilityUpgrade")
+	proto.RegisterType((*Capabilities)(nil), "chain.Capabilities")
+	proto.RegisterType((*ChannelACLs)(nil), "chain.ChannelACLs")
+	proto.RegisterType((*ChannelACLUpdate)(nil), "chain.ChannelACLUpdate")
+	proto.RegisterMapType((map[string]string)(nil), "chain.ChannelACLUpdate.AclsEntry")
 }
 
 func init() { proto.RegisterFile("grpc/proto/chain/channel.proto", fileDescriptor_4ba4cdd5356e4d30) }
 
 var fileDescriptor_4ba4cdd5356e4d30 = []byte{
-	// 242 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x2f, 0x2a, 0x48,
-	0xd6, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0xd7, 0x4f, 0xce, 0x48, 0xcc, 0xcc, 0x03, 0x91, 0x79, 0x79,
-	0xa9, 0x39, 0x7a, 0x60, 0x31, 0x21, 0x56, 0xb0, 0xa0, 0x52, 0x26, 0x17, 0xaf, 0x33, 0x44, 0xdc,
-	0xb9, 0x28, 0x35, 0xb1, 0x24, 0x55, 0x48, 0x8a, 0x8b, 0x23, 0x39, 0x3f, 0x2f, 0x2d, 0x33, 0xdd,
-	0xd3, 0x45, 0x82, 0x51, 0x81, 0x51, 0x83, 0x33, 0x08, 0xce, 0x17, 0x92, 0xe3, 0xe2, 0xca, 0x49,
-	0x4d, 0x4c, 0x2f, 0x4d, 0xf5, 0x4b, 0xcc, 0x4d, 0x95, 0x60, 0x02, 0xcb, 0x22, 0x89, 0x08, 0xc9,
-	0x70, 0x71, 0x42, 0x2d, 0xf1, 0x74, 0x91, 0x60, 0x06, 0x4b, 0x23, 0x04, 0x94, 0xa6, 0x33, 0x72,
-	0x71, 0x43, 0xed, 0xf2, 0xca, 0xcf, 0xcc, 0xc3, 0x6b, 0x93, 0x04, 0x17, 0x7b, 0x7e, 0x51, 0x3a,
-	0x92, 0x35, 0x30, 0x2e, 0x54, 0x26, 0xb4, 0x38, 0xb5, 0x08, 0x6a, 0x03, 0x8c, 0x8b, 0x6a, 0x3b,
-	0x0b, 0x9a, 0xed, 0x20, 0xdb, 0x0a, 0x52, 0x53, 0x8b, 0xc0, 0x46, 0xb2, 0x42, 0x6c, 0x83, 0xf1,
-	0x95, 0x2a, 0xe1, 0x0e, 0xf3, 0xc9, 0x2c, 0x2e, 0xa1, 0xba, 0xc3, 0x90, 0xad, 0x66, 0x41, 0xb5,
-	0xda, 0xc9, 0x93, 0x4b, 0x3d, 0x33, 0x5f, 0x2f, 0x35, 0x2f, 0x2f, 0x3f, 0x5f, 0x2f, 0x2d, 0x31,
-	0xa9, 0x28, 0x33, 0x59, 0x37, 0x39, 0x27, 0x33, 0x35, 0xaf, 0x44, 0x0f, 0x14, 0x7f, 0x90, 0xb8,
-	0xd2, 0x03, 0x47, 0x95, 0x13, 0x0f, 0xd4, 0x8d, 0x01, 0x20, 0xb1, 0x28, 0x01, 0xf4, 0xf8, 0x4d,
-	0x62, 0x03, 0x73, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x1a, 0x97, 0x93, 0x90, 0xfa, 0x01,
-	0x00, 0x00,
+	// 536 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xdd, 0x8a, 0xd3, 0x40,
+	0x18, 0x35, 0x49, 0xdb, 0x6c, 0xbf, 0xd6, 0x35, 0x8c, 0x45, 0x86, 0x45, 0x96, 0x98, 0x0b, 0x29,
+	0xa2, 0x59, 0x58, 0x11, 0xc5, 0xbb, 0x6e, 0x57, 0x64, 0xd7, 0x45, 0xa5, 0x52, 0x2f, 0xbc, 0x9b,
+	0x4e, 0x67, 0xe3, 0x60, 0x9c, 0x84, 0x49, 0x2a, 0xf4, 0x49, 0xbc, 0xf4, 0x45, 0x7c, 0x24, 0x1f,
+	0x42, 0xe6, 0x27, 0x3f, 0x0d, 0xf8, 0x73, 0x21, 0xd2, 0x9b, 0x92, 0x73, 0xbe, 0x8f, 0x39, 0x67,
+	0xbe, 0x39, 0xd3, 0x81, 0xe3, 0x44, 0xe6, 0xf4, 0x24, 0x97, 0x59, 0x99, 0x9d, 0xd0, 0x8f, 0x84,
+	0x0b, 0xf5, 0x2b, 0x04, 0x4b, 0x63, 0xcd, 0xa1, 0xbe, 0x26, 0x23, 0x0e, 0x37, 0xe7, 0x86, 0x9f,
+	0x4b, 0x46, 0x4a, 0x86, 0x8e, 0xe0, 0x80, 0x66, 0xe2, 0x9a, 0x27, 0x17, 0xe7, 0xd8, 0x09, 0x9d,
+	0xe9, 0x70, 0x51, 0x63, 0x74, 0x0c, 0x90, 0x32, 0x92, 0x6c, 0xd8, 0x6b, 0xf2, 0x99, 0x61, 0x57,
+	0x57, 0x5b, 0x0c, 0xba, 0x0b, 0x43, 0x2b, 0x72, 0x71, 0x8e, 0x3d, 0x5d, 0x6e, 0x88, 0xe8, 0xab,
+	0x03, 0x23, 0xab, 0x75, 0x99, 0x71, 0xf1, 0x5b, 0x25, 0x0c, 0x7e, 0x26, 0x93, 0x96, 0x4c, 0x05,
+	0x6d, 0x65, 0x59, 0x30, 0x69, 0x15, 0x2a, 0xb8, 0xab, 0xde, 0xeb, 0xa8, 0x2b, 0xb5, 0x9c, 0x31,
+	0xa9, 0x97, 0xec, 0x1b, 0xb5, 0x0a, 0x47, 0xdb, 0xda, 0xd8, 0x15, 0x2f, 0xca, 0x7f, 0x6e, 0xac,
+	0x2d, 0xdd, 0xeb, 0x48, 0xcf, 0xeb, 0xf9, 0xbf, 0xe3, 0x89, 0x60, 0xb2, 0x2d, 0xe0, 0xfc, 0x52,
+	0xc0, 0xdd, 0x11, 0x88, 0xbe, 0x39, 0x70, 0xbb, 0x3a, 0x45, 0x92, 0x93, 0x15, 0x4f, 0x79, 0xc9,
+	0x59, 0xb1, 0x47, 0x13, 0xfe, 0xe1, 0x02, 0xee, 0x3a, 0xdc, 0x2e, 0xf3, 0x44, 0x92, 0x35, 0xdb,
+	0x1f, 0x9b, 0xe8, 0x21, 0xf4, 0x13, 0x99, 0x6d, 0x72, 0x3c, 0x08, 0x9d, 0xe9, 0xe1, 0xe9, 0x9d,
+	0x58, 0x5f, 0x92, 0xb8, 0xb1, 0xfc, 0x52, 0x55, 0x17, 0xa6, 0x49, 0x5d, 0x07, 0x5a, 0x57, 0xb0,
+	0x6f, 0xae, 0x43, 0xc3, 0xa0, 0x10, 0x46, 0x6a, 0xe5, 0xf7, 0x4c, 0x16, 0x3c, 0x13, 0xf8, 0x40,
+	0x37, 0xb4, 0x29, 0x74, 0x1f, 0x0e, 0x33, 0xb9, 0x66, 0xb2, 0x69, 0x1a, 0xea, 0xa6, 0x0e, 0x8b,
+	0x62, 0xf0, 0x0b, 0x1d, 0x8f, 0x02, 0x43, 0xe8, 0x4d, 0x47, 0xa7, 0x93, 0xca, 0x59, 0x3b, 0x3b,
+	0x8b, 0xaa, 0x29, 0x5a, 0xc3, 0x78, 0x27, 0x08, 0x18, 0x7c, 0x3b, 0x00, 0xec, 0x84, 0x9e, 0x9a,
+	0x95, 0x85, 0x66, 0x8a, 0x5a, 0x0b, 0xbb, 0xa6, 0x62, 0xa1, 0x72, 0x4f, 0xf2, 0x3c, 0xe5, 0x94,
+	0x94, 0xca, 0x98, 0xa7, 0xab, 0x6d, 0xaa, 0x7d, 0xa1, 0x67, 0xf3, 0xab, 0x7d, 0x8a, 0xdb, 0x77,
+	0x17, 0x82, 0xc6, 0xd9, 0x32, 0x5f, 0xff, 0xe9, 0x9f, 0xed, 0xff, 0xc6, 0xec, 0x09, 0xf4, 0x08,
+	0x4d, 0x0b, 0x3c, 0xd0, 0x67, 0x79, 0x6f, 0xf7, 0x2c, 0x6b, 0xc3, 0xf1, 0x8c, 0xa6, 0xc5, 0x0b,
+	0x51, 0xca, 0xed, 0x42, 0xb7, 0xb7, 0x53, 0xe0, 0xff, 0x45, 0x0a, 0x8e, 0x9e, 0xc2, 0xb0, 0x5e,
+	0x02, 0x05, 0xe0, 0x7d, 0x62, 0x5b, 0xbb, 0x71, 0xf5, 0x89, 0x26, 0xd0, 0xff, 0x42, 0xd2, 0x4d,
+	0xb5, 0x63, 0x03, 0x9e, 0xbb, 0xcf, 0x9c, 0x07, 0xaf, 0xe0, 0x56, 0x27, 0xf2, 0x28, 0x80, 0xb1,
+	0xfe, 0xb0, 0x52, 0xc1, 0x8d, 0x9a, 0x79, 0x63, 0xf2, 0x12, 0x38, 0x68, 0x02, 0x81, 0x66, 0x66,
+	0x4d, 0x46, 0x02, 0xf7, 0xec, 0x12, 0xa6, 0x54, 0xc4, 0x64, 0xc5, 0x24, 0xa7, 0xf1, 0x35, 0x59,
+	0x49, 0x4e, 0x1f, 0xd1, 0x94, 0x33, 0x51, 0xc6, 0xea, 0x89, 0x32, 0xcf, 0x91, 0xd9, 0xc8, 0xd9,
+	0xd8, 0x2e, 0xff, 0x56, 0x71, 0x1f, 0x82, 0xee, 0x13, 0xb6, 0x1a, 0x68, 0xf0, 0xf8, 0xe7, 0x00,
+	0xf6, 0xcf, 0x8f, 0x9b, 0xdd, 0x06, 0x00, 0x00,
 }
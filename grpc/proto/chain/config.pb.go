@@ -2591,7 +2591,9 @@ func (m *ReqConfigSet) GetCertificateAuthorities() map[string]*CertificateAuthor
 }
 
 type ReqConfigList struct {
-	List                 int32    `protobuf:"varint,1,opt,name=list,proto3" json:"list,omitempty"`
+	List int32 `protobuf:"varint,1,opt,name=list,proto3" json:"list,omitempty"`
+	// 为true时直接读取本节点配置，否则等待本节点应用集群已提交的配置后读取
+	Stale                bool     `protobuf:"varint,2,opt,name=stale,proto3" json:"stale,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2629,8 +2631,17 @@ func (m *ReqConfigList) GetList() int32 {
 	return 0
 }
 
+func (m *ReqConfigList) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
 type ReqConfig struct {
-	ConfigID             string   `protobuf:"bytes,1,opt,name=configID,proto3" json:"configID,omitempty"`
+	ConfigID string `protobuf:"bytes,1,opt,name=configID,proto3" json:"configID,omitempty"`
+	// 为true时直接读取本节点配置，否则等待本节点应用集群已提交的配置后读取
+	Stale                bool     `protobuf:"varint,2,opt,name=stale,proto3" json:"stale,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2668,6 +2679,13 @@ func (m *ReqConfig) GetConfigID() string {
 	return ""
 }
 
+func (m *ReqConfig) GetStale() bool {
+	if m != nil {
+		return m.Stale
+	}
+	return false
+}
+
 type ReqConfigRecover struct {
 	ConfigIDs            []string `protobuf:"bytes,1,rep,name=configIDs,proto3" json:"configIDs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -2707,6 +2725,124 @@ func (m *ReqConfigRecover) GetConfigIDs() []string {
 	return nil
 }
 
+type ReqRaftStatus struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqRaftStatus) Reset()         { *m = ReqRaftStatus{} }
+func (m *ReqRaftStatus) String() string { return proto.CompactTextString(m) }
+func (*ReqRaftStatus) ProtoMessage()    {}
+func (*ReqRaftStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_114090f9f0fce975, []int{48}
+}
+
+func (m *ReqRaftStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqRaftStatus.Unmarshal(m, b)
+}
+func (m *ReqRaftStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqRaftStatus.Marshal(b, m, deterministic)
+}
+func (m *ReqRaftStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqRaftStatus.Merge(m, src)
+}
+func (m *ReqRaftStatus) XXX_Size() int {
+	return xxx_messageInfo_ReqRaftStatus.Size(m)
+}
+func (m *ReqRaftStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqRaftStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqRaftStatus proto.InternalMessageInfo
+
+type RaftPeerStatus struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url                  string   `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Reachable            bool     `protobuf:"varint,3,opt,name=reachable,proto3" json:"reachable,omitempty"`
+	LastAck              int64    `protobuf:"varint,4,opt,name=lastAck,proto3" json:"lastAck,omitempty"`
+	MatchIndex           int32    `protobuf:"varint,5,opt,name=matchIndex,proto3" json:"matchIndex,omitempty"`
+	NextIndex            int32    `protobuf:"varint,6,opt,name=nextIndex,proto3" json:"nextIndex,omitempty"`
+	ErrMsg               string   `protobuf:"bytes,7,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RaftPeerStatus) Reset()         { *m = RaftPeerStatus{} }
+func (m *RaftPeerStatus) String() string { return proto.CompactTextString(m) }
+func (*RaftPeerStatus) ProtoMessage()    {}
+func (*RaftPeerStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_114090f9f0fce975, []int{49}
+}
+
+func (m *RaftPeerStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RaftPeerStatus.Unmarshal(m, b)
+}
+func (m *RaftPeerStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RaftPeerStatus.Marshal(b, m, deterministic)
+}
+func (m *RaftPeerStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RaftPeerStatus.Merge(m, src)
+}
+func (m *RaftPeerStatus) XXX_Size() int {
+	return xxx_messageInfo_RaftPeerStatus.Size(m)
+}
+func (m *RaftPeerStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RaftPeerStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RaftPeerStatus proto.InternalMessageInfo
+
+func (m *RaftPeerStatus) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RaftPeerStatus) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *RaftPeerStatus) GetReachable() bool {
+	if m != nil {
+		return m.Reachable
+	}
+	return false
+}
+
+func (m *RaftPeerStatus) GetLastAck() int64 {
+	if m != nil {
+		return m.LastAck
+	}
+	return 0
+}
+
+func (m *RaftPeerStatus) GetMatchIndex() int32 {
+	if m != nil {
+		return m.MatchIndex
+	}
+	return 0
+}
+
+func (m *RaftPeerStatus) GetNextIndex() int32 {
+	if m != nil {
+		return m.NextIndex
+	}
+	return 0
+}
+
+func (m *RaftPeerStatus) GetErrMsg() string {
+	if m != nil {
+		return m.ErrMsg
+	}
+	return ""
+}
+
 type ReqInit struct {
 	Client                 *ReqClientSelf                 `protobuf:"bytes,1,opt,name=client,proto3" json:"client,omitempty"`
 	ChannelPeer            []*ReqChannelPeer              `protobuf:"bytes,2,rep,name=channelPeer,proto3" json:"channelPeer,omitempty"`
@@ -2727,7 +2863,7 @@ func (m *ReqInit) Reset()         { *m = ReqInit{} }
 func (m *ReqInit) String() string { return proto.CompactTextString(m) }
 func (*ReqInit) ProtoMessage()    {}
 func (*ReqInit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_114090f9f0fce975, []int{48}
+	return fileDescriptor_114090f9f0fce975, []int{50}
 }
 
 func (m *ReqInit) XXX_Unmarshal(b []byte) error {
@@ -2835,7 +2971,7 @@ func (m *ReqClient) Reset()         { *m = ReqClient{} }
 func (m *ReqClient) String() string { return proto.CompactTextString(m) }
 func (*ReqClient) ProtoMessage()    {}
 func (*ReqClient) Descriptor() ([]byte, []int) {
-	return fileDescriptor_114090f9f0fce975, []int{49}
+	return fileDescriptor_114090f9f0fce975, []int{51}
 }
 
 func (m *ReqClient) XXX_Unmarshal(b []byte) error {
@@ -2921,7 +3057,7 @@ func (m *ReqClientSelf) Reset()         { *m = ReqClientSelf{} }
 func (m *ReqClientSelf) String() string { return proto.CompactTextString(m) }
 func (*ReqClientSelf) ProtoMessage()    {}
 func (*ReqClientSelf) Descriptor() ([]byte, []int) {
-	return fileDescriptor_114090f9f0fce975, []int{50}
+	return fileDescriptor_114090f9f0fce975, []int{52}
 }
 
 func (m *ReqClientSelf) XXX_Unmarshal(b []byte) error {
@@ -3001,7 +3137,7 @@ func (m *ReqClientCustom) Reset()         { *m = ReqClientCustom{} }
 func (m *ReqClientCustom) String() string { return proto.CompactTextString(m) }
 func (*ReqClientCustom) ProtoMessage()    {}
 func (*ReqClientCustom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_114090f9f0fce975, []int{51}
+	return fileDescriptor_114090f9f0fce975, []int{53}
 }
 
 func (m *ReqClientCustom) XXX_Unmarshal(b []byte) error {
@@ -3088,7 +3224,7 @@ func (m *ReqChannelPeer) Reset()         { *m = ReqChannelPeer{} }
 func (m *ReqChannelPeer) String() string { return proto.CompactTextString(m) }
 func (*ReqChannelPeer) ProtoMessage()    {}
 func (*ReqChannelPeer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_114090f9f0fce975, []int{52}
+	return fileDescriptor_114090f9f0fce975, []int{54}
 }
 
 func (m *ReqChannelPeer) XXX_Unmarshal(b []byte) error {
@@ -3176,7 +3312,7 @@ func (m *ReqChannelPolicyQuery) Reset()         { *m = ReqChannelPolicyQuery{} }
 func (m *ReqChannelPolicyQuery) String() string { return proto.CompactTextString(m) }
 func (*ReqChannelPolicyQuery) ProtoMessage()    {}
 func (*ReqChannelPolicyQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_114090f9f0fce975, []int{53}
+	return fileDescriptor_114090f9f0fce975, []int{55}
 }
 
 func (m *ReqChannelPolicyQuery) XXX_Unmarshal(b []byte) error {
@@ -3270,7 +3406,7 @@ func (m *ReqChannelPolicyDiscovery) Reset()         { *m = ReqChannelPolicyDisco
 func (m *ReqChannelPolicyDiscovery) String() string { return proto.CompactTextString(m) }
 func (*ReqChannelPolicyDiscovery) ProtoMessage()    {}
 func (*ReqChannelPolicyDiscovery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_114090f9f0fce975, []int{54}
+	return fileDescriptor_114090f9f0fce975, []int{56}
 }
 
 func (m *ReqChannelPolicyDiscovery) XXX_Unmarshal(b []byte) error {
@@ -3357,7 +3493,7 @@ func (m *ReqChannelPolicyEvent) Reset()         { *m = ReqChannelPolicyEvent{} }
 func (m *ReqChannelPolicyEvent) String() string { return proto.CompactTextString(m) }
 func (*ReqChannelPolicyEvent) ProtoMessage()    {}
 func (*ReqChannelPolicyEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_114090f9f0fce975, []int{55}
+	return fileDescriptor_114090f9f0fce975, []int{57}
 }
 
 func (m *ReqChannelPolicyEvent) XXX_Unmarshal(b []byte) error {
@@ -3441,7 +3577,7 @@ func (m *ReqOrganizationsOrder) Reset()         { *m = ReqOrganizationsOrder{} }
 func (m *ReqOrganizationsOrder) String() string { return proto.CompactTextString(m) }
 func (*ReqOrganizationsOrder) ProtoMessage()    {}
 func (*ReqOrganizationsOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_114090f9f0fce975, []int{56}
+	return fileDescriptor_114090f9f0fce975, []int{58}
 }
 
 func (m *ReqOrganizationsOrder) XXX_Unmarshal(b []byte) error {
@@ -3502,7 +3638,7 @@ func (m *ReqOrganizationsOrderSelf) Reset()         { *m = ReqOrganizationsOrder
 func (m *ReqOrganizationsOrderSelf) String() string { return proto.CompactTextString(m) }
 func (*ReqOrganizationsOrderSelf) ProtoMessage()    {}
 func (*ReqOrganizationsOrderSelf) Descriptor() ([]byte, []int) {
-	return fileDescriptor_114090f9f0fce975, []int{57}
+	return fileDescriptor_114090f9f0fce975, []int{59}
 }
 
 func (m *ReqOrganizationsOrderSelf) XXX_Unmarshal(b []byte) error {
@@ -3554,7 +3690,7 @@ func (m *ReqOrganizationsOrg) Reset()         { *m = ReqOrganizationsOrg{} }
 func (m *ReqOrganizationsOrg) String() string { return proto.CompactTextString(m) }
 func (*ReqOrganizationsOrg) ProtoMessage()    {}
 func (*ReqOrganizationsOrg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_114090f9f0fce975, []int{58}
+	return fileDescriptor_114090f9f0fce975, []int{60}
 }
 
 func (m *ReqOrganizationsOrg) XXX_Unmarshal(b []byte) error {
@@ -3638,7 +3774,7 @@ func (m *ReqOrganizationsOrgSelf) Reset()         { *m = ReqOrganizationsOrgSelf
 func (m *ReqOrganizationsOrgSelf) String() string { return proto.CompactTextString(m) }
 func (*ReqOrganizationsOrgSelf) ProtoMessage()    {}
 func (*ReqOrganizationsOrgSelf) Descriptor() ([]byte, []int) {
-	return fileDescriptor_114090f9f0fce975, []int{59}
+	return fileDescriptor_114090f9f0fce975, []int{61}
 }
 
 func (m *ReqOrganizationsOrgSelf) XXX_Unmarshal(b []byte) error {
@@ -3707,7 +3843,7 @@ func (m *ReqOrder) Reset()         { *m = ReqOrder{} }
 func (m *ReqOrder) String() string { return proto.CompactTextString(m) }
 func (*ReqOrder) ProtoMessage()    {}
 func (*ReqOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_114090f9f0fce975, []int{60}
+	return fileDescriptor_114090f9f0fce975, []int{62}
 }
 
 func (m *ReqOrder) XXX_Unmarshal(b []byte) error {
@@ -3817,7 +3953,7 @@ func (m *ReqOrderSelf) Reset()         { *m = ReqOrderSelf{} }
 func (m *ReqOrderSelf) String() string { return proto.CompactTextString(m) }
 func (*ReqOrderSelf) ProtoMessage()    {}
 func (*ReqOrderSelf) Descriptor() ([]byte, []int) {
-	return fileDescriptor_114090f9f0fce975, []int{61}
+	return fileDescriptor_114090f9f0fce975, []int{63}
 }
 
 func (m *ReqOrderSelf) XXX_Unmarshal(b []byte) error {
@@ -3922,7 +4058,7 @@ func (m *ReqPeer) Reset()         { *m = ReqPeer{} }
 func (m *ReqPeer) String() string { return proto.CompactTextString(m) }
 func (*ReqPeer) ProtoMessage()    {}
 func (*ReqPeer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_114090f9f0fce975, []int{62}
+	return fileDescriptor_114090f9f0fce975, []int{64}
 }
 
 func (m *ReqPeer) XXX_Unmarshal(b []byte) error {
@@ -4040,7 +4176,7 @@ func (m *ReqPeerSelf) Reset()         { *m = ReqPeerSelf{} }
 func (m *ReqPeerSelf) String() string { return proto.CompactTextString(m) }
 func (*ReqPeerSelf) ProtoMessage()    {}
 func (*ReqPeerSelf) Descriptor() ([]byte, []int) {
-	return fileDescriptor_114090f9f0fce975, []int{63}
+	return fileDescriptor_114090f9f0fce975, []int{65}
 }
 
 func (m *ReqPeerSelf) XXX_Unmarshal(b []byte) error {
@@ -4150,7 +4286,7 @@ func (m *ReqCertificateAuthority) Reset()         { *m = ReqCertificateAuthority
 func (m *ReqCertificateAuthority) String() string { return proto.CompactTextString(m) }
 func (*ReqCertificateAuthority) ProtoMessage()    {}
 func (*ReqCertificateAuthority) Descriptor() ([]byte, []int) {
-	return fileDescriptor_114090f9f0fce975, []int{64}
+	return fileDescriptor_114090f9f0fce975, []int{66}
 }
 
 func (m *ReqCertificateAuthority) XXX_Unmarshal(b []byte) error {
@@ -4251,7 +4387,7 @@ func (m *ReqCertificateAuthoritySelf) Reset()         { *m = ReqCertificateAutho
 func (m *ReqCertificateAuthoritySelf) String() string { return proto.CompactTextString(m) }
 func (*ReqCertificateAuthoritySelf) ProtoMessage()    {}
 func (*ReqCertificateAuthoritySelf) Descriptor() ([]byte, []int) {
-	return fileDescriptor_114090f9f0fce975, []int{65}
+	return fileDescriptor_114090f9f0fce975, []int{67}
 }
 
 func (m *ReqCertificateAuthoritySelf) XXX_Unmarshal(b []byte) error {
@@ -4381,6 +4517,8 @@ func init() {
 	proto.RegisterType((*ReqConfigList)(nil), "chain.ReqConfigList")
 	proto.RegisterType((*ReqConfig)(nil), "chain.ReqConfig")
 	proto.RegisterType((*ReqConfigRecover)(nil), "chain.ReqConfigRecover")
+	proto.RegisterType((*ReqRaftStatus)(nil), "chain.ReqRaftStatus")
+	proto.RegisterType((*RaftPeerStatus)(nil), "chain.RaftPeerStatus")
 	proto.RegisterType((*ReqInit)(nil), "chain.ReqInit")
 	proto.RegisterType((*ReqClient)(nil), "chain.ReqClient")
 	proto.RegisterType((*ReqClientSelf)(nil), "chain.ReqClientSelf")
@@ -4406,198 +4544,204 @@ func init() {
 func init() { proto.RegisterFile("grpc/proto/chain/config.proto", fileDescriptor_114090f9f0fce975) }
 
 var fileDescriptor_114090f9f0fce975 = []byte{
-	// 3076 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5b, 0xcd, 0x6f, 0x24, 0x47,
-	0x15, 0x57, 0xcf, 0xf7, 0xbc, 0xb1, 0xf7, 0xa3, 0xd6, 0xbb, 0x99, 0xf5, 0x6e, 0x8c, 0xd3, 0xec,
-	0x66, 0x1d, 0x92, 0x78, 0x93, 0xdd, 0x25, 0xac, 0xb2, 0x5a, 0xc8, 0x7a, 0xd6, 0x1b, 0x9c, 0x38,
-	0xb1, 0xd3, 0xde, 0x04, 0xc4, 0x25, 0x6a, 0xf7, 0x94, 0xc7, 0xad, 0xed, 0xe9, 0x9e, 0x54, 0xf7,
-	0x98, 0x0c, 0x12, 0xa7, 0x80, 0xc4, 0x31, 0xe2, 0xc8, 0x05, 0xa1, 0x88, 0x23, 0xe2, 0x10, 0x09,
-	0x71, 0xe2, 0x88, 0x90, 0x10, 0x20, 0xc4, 0x1f, 0x80, 0xc4, 0x91, 0x7f, 0x80, 0x0b, 0x48, 0xa8,
-	0xbe, 0xba, 0xab, 0xba, 0xab, 0x7b, 0x6c, 0x79, 0x85, 0xf8, 0xba, 0xb9, 0x5e, 0xbd, 0xf7, 0xe6,
-	0xf5, 0x7b, 0xaf, 0x5e, 0xfd, 0xea, 0x3d, 0x19, 0x9e, 0x1d, 0x91, 0x89, 0x77, 0x73, 0x42, 0xa2,
-	0x24, 0xba, 0xe9, 0x1d, 0xba, 0x7e, 0x78, 0xd3, 0x8b, 0xc2, 0x03, 0x7f, 0xb4, 0xce, 0x48, 0xa8,
-	0xc9, 0x68, 0xf6, 0x2f, 0x5a, 0xd0, 0x1a, 0x30, 0x3a, 0xea, 0x43, 0xfb, 0x08, 0x93, 0xd8, 0x8f,
-	0xc2, 0xbe, 0xb5, 0x6a, 0xad, 0x75, 0x1d, 0xb9, 0x44, 0xd7, 0xa1, 0xe5, 0x05, 0x3e, 0x0e, 0x93,
-	0x7e, 0x6d, 0xd5, 0x5a, 0xeb, 0xdd, 0x5a, 0x5c, 0x67, 0xc2, 0xeb, 0x03, 0x46, 0x74, 0xc4, 0x26,
-	0xfa, 0x0a, 0x74, 0xbc, 0x43, 0x37, 0x0c, 0x71, 0x10, 0xf7, 0xeb, 0xab, 0xf5, 0xb5, 0xde, 0xad,
-	0x2b, 0x92, 0x91, 0xff, 0xf2, 0x40, 0xec, 0x6e, 0x86, 0x09, 0x99, 0x39, 0x29, 0x33, 0x7a, 0x04,
-	0x8b, 0x11, 0x19, 0xb9, 0xa1, 0xff, 0x1d, 0x37, 0xf1, 0xa3, 0x30, 0xee, 0x37, 0x98, 0xf4, 0xaa,
-	0x2e, 0xbd, 0xa3, 0xb2, 0x70, 0x15, 0xba, 0x18, 0x35, 0x20, 0x22, 0x43, 0x4c, 0x30, 0x89, 0xfb,
-	0x4d, 0x93, 0x01, 0x3b, 0x62, 0x57, 0x18, 0x20, 0x99, 0xd1, 0x3a, 0x34, 0x27, 0x98, 0x4a, 0xb5,
-	0x98, 0x54, 0x5f, 0x97, 0xda, 0xc5, 0xa9, 0x08, 0x67, 0x43, 0x2e, 0x5c, 0xf2, 0x30, 0x49, 0xfc,
-	0x03, 0xdf, 0x73, 0x13, 0xfc, 0x60, 0x9a, 0x1c, 0x46, 0xc4, 0x4f, 0x7c, 0x1c, 0xf7, 0xdb, 0x4c,
-	0xc1, 0x0b, 0xb9, 0xef, 0x36, 0xf2, 0x72, 0x8d, 0x25, 0x8a, 0x96, 0xdf, 0x86, 0x45, 0xcd, 0x5d,
-	0xe8, 0x1c, 0xd4, 0x9f, 0xe0, 0x99, 0x08, 0x0d, 0xfd, 0x13, 0x5d, 0x83, 0xe6, 0x91, 0x1b, 0x4c,
-	0xb1, 0x88, 0xca, 0x19, 0xf9, 0xa3, 0x5c, 0xcc, 0xe1, 0x9b, 0xaf, 0xd7, 0xee, 0x5a, 0xcb, 0xef,
-	0x03, 0x2a, 0x7a, 0xcf, 0xa0, 0xf1, 0x05, 0x5d, 0xe3, 0x05, 0xa1, 0x51, 0x95, 0x55, 0xd5, 0xbe,
-	0x0d, 0x8b, 0x9a, 0x47, 0x8f, 0x6f, 0xa3, 0x10, 0x53, 0x95, 0x6d, 0x02, 0x64, 0x8e, 0x36, 0x68,
-	0x7a, 0x4e, 0xd7, 0xd4, 0x13, 0x9a, 0xa8, 0x8c, 0xaa, 0xe6, 0x00, 0xae, 0x54, 0xb8, 0xdb, 0xa0,
-	0xf7, 0x55, 0x5d, 0x6f, 0x9a, 0x31, 0x45, 0x25, 0x33, 0xe5, 0x77, 0xec, 0x7f, 0xd4, 0xa1, 0xc5,
-	0xf3, 0x1f, 0xd9, 0xb0, 0xa0, 0xe6, 0xa1, 0x50, 0xae, 0xd1, 0xd0, 0x3a, 0xb4, 0x83, 0x68, 0x34,
-	0xf2, 0xc3, 0x91, 0xf8, 0x9d, 0x25, 0xed, 0x0c, 0x6d, 0xf3, 0x3d, 0x47, 0x32, 0xa1, 0xeb, 0xd0,
-	0xa0, 0xa9, 0xd6, 0xaf, 0x33, 0xe6, 0xf3, 0x1a, 0x33, 0xfb, 0x64, 0xb6, 0x8d, 0xee, 0xc3, 0x02,
-	0x3e, 0xc2, 0x61, 0xb2, 0x87, 0xc9, 0x91, 0xef, 0xe1, 0x7e, 0x83, 0xb1, 0x5f, 0xd6, 0xd8, 0x37,
-	0x15, 0x06, 0x47, 0x63, 0x47, 0x6b, 0xd0, 0x64, 0x67, 0xa0, 0xdf, 0x64, 0x72, 0x48, 0x93, 0x63,
-	0x31, 0x72, 0x38, 0x03, 0x7a, 0x11, 0x5a, 0xa3, 0x20, 0xda, 0x77, 0x83, 0x7e, 0x4b, 0x4b, 0x0d,
-	0xce, 0xfa, 0x26, 0xdb, 0x72, 0x04, 0x0b, 0xb5, 0xca, 0x23, 0xb3, 0x49, 0x12, 0xf1, 0xfc, 0xef,
-	0xb7, 0x0d, 0x56, 0x0d, 0x14, 0x06, 0x47, 0x63, 0x47, 0x8f, 0xe0, 0xac, 0x47, 0xf0, 0x10, 0x87,
-	0x89, 0xef, 0x06, 0x7b, 0x49, 0x44, 0x70, 0xbf, 0xc3, 0x34, 0x5c, 0xcd, 0x69, 0xd0, 0x78, 0x9c,
-	0xbc, 0x10, 0xfd, 0xba, 0x8d, 0xc1, 0x60, 0x6f, 0xb7, 0xdf, 0x35, 0x7c, 0x1d, 0xdb, 0x71, 0x38,
-	0x03, 0x7a, 0x15, 0x3a, 0x49, 0x10, 0xd3, 0x90, 0xc7, 0x7d, 0x60, 0xcc, 0x17, 0x35, 0xe6, 0xc7,
-	0xdb, 0x7b, 0x6c, 0xd3, 0x49, 0xd9, 0xec, 0xeb, 0xb0, 0xa8, 0x85, 0x0e, 0x2d, 0x41, 0x33, 0xc0,
-	0x47, 0x38, 0x10, 0xe1, 0xe7, 0x0b, 0xfb, 0x0d, 0x80, 0x2c, 0x68, 0xe8, 0x16, 0xb4, 0x13, 0x7f,
-	0x8c, 0xa3, 0x69, 0xc2, 0xb8, 0x94, 0x4a, 0x93, 0xf2, 0x3c, 0xe6, 0xfb, 0x8e, 0x64, 0xb4, 0x3f,
-	0xb5, 0xe0, 0x7c, 0x61, 0x1b, 0xad, 0x00, 0x78, 0x51, 0x18, 0x62, 0x4f, 0xc9, 0x38, 0x85, 0x82,
-	0x96, 0xa1, 0x43, 0x70, 0x3c, 0x89, 0xc2, 0x98, 0x27, 0x76, 0xd7, 0x49, 0xd7, 0xe8, 0x6b, 0xd0,
-	0x1d, 0xfa, 0xb1, 0x17, 0x1d, 0x61, 0x32, 0x13, 0x09, 0xf6, 0x5c, 0x99, 0x1d, 0x0f, 0x25, 0xa3,
-	0x93, 0xc9, 0xd8, 0x0f, 0x61, 0xb9, 0x9c, 0x11, 0x3d, 0x0f, 0x67, 0x46, 0x04, 0xcf, 0xb6, 0xfd,
-	0x38, 0xd9, 0xfc, 0x78, 0xe2, 0x13, 0x79, 0xda, 0x72, 0x54, 0x7b, 0x17, 0x50, 0x31, 0x41, 0xd1,
-	0xeb, 0x79, 0x17, 0xad, 0x96, 0x26, 0x73, 0xc1, 0x55, 0x3b, 0x70, 0xb9, 0x94, 0x0b, 0xdd, 0x82,
-	0x25, 0x82, 0x47, 0x7e, 0x9c, 0x10, 0x5e, 0xc7, 0xa4, 0x77, 0xb8, 0x71, 0xc6, 0x3d, 0x7b, 0x03,
-	0x7a, 0xca, 0x59, 0x40, 0xb7, 0xf3, 0xb6, 0x5d, 0x2e, 0x1e, 0x98, 0x82, 0x51, 0xe9, 0x67, 0xaa,
-	0xdb, 0xa7, 0x89, 0x9f, 0x9d, 0xc0, 0x82, 0x7a, 0xec, 0xd0, 0x9d, 0xbc, 0x59, 0xcb, 0x86, 0xc3,
-	0x99, 0xb7, 0x8b, 0xde, 0x79, 0x9e, 0xeb, 0x1d, 0xca, 0xba, 0xd7, 0x37, 0xc8, 0x0c, 0xe8, 0xbe,
-	0xc3, 0xd9, 0xec, 0x0f, 0xe1, 0x82, 0x41, 0x1f, 0x4d, 0xfb, 0x8f, 0xa6, 0x38, 0x0d, 0x32, 0x5f,
-	0x50, 0x2c, 0x81, 0x3f, 0xc6, 0xde, 0x34, 0x91, 0xd6, 0xcb, 0x25, 0xdd, 0x21, 0x38, 0x1e, 0x8f,
-	0xc6, 0x09, 0x4b, 0xbd, 0xae, 0x23, 0x97, 0xf6, 0xdf, 0xd2, 0x44, 0x57, 0x7e, 0x9d, 0x66, 0x53,
-	0xe6, 0x96, 0xad, 0x61, 0x20, 0x03, 0x96, 0xa3, 0xa2, 0x2f, 0xc1, 0x39, 0xb5, 0xb4, 0x31, 0x4e,
-	0xfe, 0xd3, 0x05, 0x3a, 0xba, 0x06, 0x8b, 0x02, 0x7b, 0x88, 0x02, 0xc5, 0x2d, 0xd1, 0x89, 0xe8,
-	0x25, 0x38, 0x2f, 0x08, 0xef, 0xe0, 0xf1, 0x3e, 0x26, 0xf1, 0xa1, 0x3f, 0x61, 0x05, 0xb6, 0xeb,
-	0x14, 0x37, 0xd0, 0x55, 0xf5, 0x50, 0x35, 0x19, 0x57, 0x46, 0xa0, 0xbb, 0x31, 0x0e, 0x44, 0xb4,
-	0x5b, 0x7c, 0x37, 0x25, 0xd8, 0x6b, 0x32, 0x45, 0xd4, 0xa2, 0x88, 0x10, 0x34, 0x26, 0x6e, 0x72,
-	0x28, 0xbe, 0x97, 0xfd, 0x6d, 0x1f, 0xc1, 0x45, 0x63, 0xf1, 0x33, 0x31, 0xa3, 0x2d, 0xe8, 0xf1,
-	0xba, 0xca, 0x6b, 0x28, 0x8f, 0xf3, 0x8d, 0xaa, 0x1a, 0x3a, 0xc8, 0xd8, 0x1d, 0x55, 0xd6, 0x7e,
-	0x0d, 0x56, 0xe7, 0x09, 0x18, 0xed, 0xdd, 0x94, 0x07, 0x88, 0xd7, 0xd9, 0xd7, 0xa0, 0x13, 0x63,
-	0x6f, 0x4a, 0xef, 0x52, 0x63, 0xaa, 0x32, 0xae, 0x3d, 0xc1, 0xe1, 0xa4, 0xbc, 0xf6, 0x6f, 0x2c,
-	0x99, 0x7c, 0x1a, 0x07, 0x4b, 0xb3, 0xd0, 0xdd, 0x0f, 0xf0, 0x90, 0xa9, 0xeb, 0x38, 0x72, 0x89,
-	0xee, 0x41, 0x7b, 0x88, 0x0f, 0xdc, 0x69, 0x20, 0x31, 0xeb, 0x73, 0xe5, 0x3f, 0xf4, 0x90, 0x33,
-	0x3a, 0x52, 0x82, 0xe6, 0xc7, 0xa1, 0x1b, 0x1f, 0x3e, 0x08, 0x46, 0xf4, 0xda, 0x3f, 0x1c, 0xcb,
-	0xfc, 0xd0, 0x88, 0xf4, 0x08, 0xc7, 0xd1, 0x41, 0xf2, 0x01, 0x26, 0xfe, 0xc1, 0x8c, 0x25, 0x46,
-	0xc7, 0x51, 0x28, 0xd9, 0x85, 0x40, 0xb3, 0xa1, 0x29, 0x2f, 0x84, 0xbb, 0xb2, 0x76, 0x9a, 0x4c,
-	0xa0, 0xc7, 0x7e, 0x42, 0xa2, 0x23, 0x9f, 0xde, 0xc9, 0xdc, 0x8f, 0xe9, 0xda, 0x1e, 0xc3, 0x19,
-	0xfd, 0x36, 0xa2, 0x67, 0x23, 0x9e, 0xc5, 0x09, 0x1e, 0xd3, 0xe5, 0x6e, 0x14, 0x05, 0xc2, 0x0b,
-	0x39, 0x2a, 0xba, 0x9d, 0xc3, 0xef, 0x57, 0x8c, 0x97, 0x9b, 0x8e, 0xe6, 0xed, 0xef, 0xc2, 0x92,
-	0x69, 0x1f, 0xbd, 0x92, 0x21, 0xa8, 0xde, 0xad, 0x95, 0x0a, 0x4d, 0x6f, 0xe3, 0x19, 0x47, 0x58,
-	0xb7, 0xa1, 0x41, 0x41, 0xae, 0xf8, 0xf1, 0x2f, 0x54, 0x88, 0xd0, 0x3f, 0x1d, 0xc6, 0x6c, 0xbf,
-	0x0c, 0xcf, 0x94, 0x28, 0x35, 0x26, 0xda, 0x3a, 0xf4, 0xcb, 0x14, 0x1a, 0xf9, 0x3f, 0xb7, 0xa0,
-	0x2d, 0x80, 0x32, 0xba, 0x29, 0xd1, 0xbf, 0xc5, 0xc0, 0xfb, 0x65, 0x1d, 0x47, 0x1b, 0xe0, 0xff,
-	0x0b, 0xd0, 0xd9, 0x8d, 0x02, 0xdf, 0xa3, 0x80, 0x5f, 0x7f, 0x11, 0x31, 0xf2, 0xcc, 0x49, 0xb7,
-	0x97, 0xb7, 0xe7, 0xa0, 0xda, 0x35, 0x1d, 0x7d, 0x22, 0xfd, 0xb7, 0x73, 0xe0, 0xd6, 0xfe, 0xb1,
-	0x05, 0x3d, 0x65, 0x8b, 0x26, 0x2a, 0x0e, 0x87, 0x11, 0x89, 0xfd, 0x70, 0x44, 0x09, 0x22, 0xfe,
-	0x3a, 0x91, 0x95, 0x50, 0xaa, 0xd5, 0x8b, 0x86, 0xf8, 0x3d, 0x56, 0xab, 0x6b, 0x3c, 0x4d, 0x74,
-	0x2a, 0x5a, 0x85, 0x5e, 0x80, 0x87, 0x23, 0x4c, 0x38, 0x53, 0x9d, 0x31, 0xa9, 0x24, 0xca, 0xc1,
-	0x8b, 0x69, 0x34, 0x25, 0x02, 0x6d, 0x76, 0x1c, 0x95, 0x64, 0xff, 0xde, 0x82, 0x16, 0x77, 0x02,
-	0xda, 0x01, 0xc4, 0x2e, 0x83, 0x81, 0x56, 0x6a, 0x2d, 0x2d, 0x09, 0x38, 0xeb, 0x7b, 0x05, 0x36,
-	0xc7, 0x20, 0x8a, 0xee, 0xa8, 0x25, 0x96, 0xfb, 0xeb, 0x92, 0xa6, 0xc7, 0x04, 0x56, 0x0a, 0x10,
-	0xb9, 0xae, 0xdd, 0xdc, 0x5c, 0xb0, 0x1c, 0x22, 0xdb, 0x3f, 0xb2, 0xa0, 0x5f, 0x66, 0x25, 0x45,
-	0xfe, 0x63, 0x3f, 0x85, 0x0b, 0x31, 0xfb, 0xb8, 0xa6, 0xa3, 0xd1, 0x68, 0x99, 0x18, 0xbb, 0x1f,
-	0x3f, 0x76, 0xc9, 0x08, 0x27, 0x3c, 0x5d, 0x9a, 0x8e, 0x42, 0x41, 0xaf, 0x43, 0x97, 0xe0, 0x84,
-	0xcc, 0x76, 0x26, 0x49, 0x2c, 0x8c, 0xbb, 0xaa, 0x19, 0x37, 0x88, 0xc6, 0x63, 0x8a, 0x49, 0x04,
-	0x8f, 0x93, 0xb1, 0xdb, 0x3f, 0xb1, 0xe0, 0xa2, 0x91, 0x89, 0x16, 0x12, 0x37, 0x49, 0xf0, 0x98,
-	0x2a, 0xe5, 0x56, 0xa5, 0x6b, 0x9a, 0x0f, 0x7e, 0xe8, 0xd3, 0x1a, 0xbe, 0xe1, 0x7a, 0x4f, 0xa2,
-	0x83, 0x03, 0x71, 0x51, 0xe6, 0xa8, 0xc2, 0x72, 0xc9, 0xc3, 0x6b, 0xa0, 0x42, 0xa1, 0xd9, 0xb7,
-	0xcf, 0xff, 0x7c, 0xe4, 0x7a, 0x49, 0x44, 0x58, 0x3e, 0xd4, 0x1c, 0x9d, 0x68, 0x8f, 0xe1, 0x6c,
-	0x2e, 0x3a, 0x39, 0x97, 0x58, 0xd5, 0x2e, 0xa9, 0x9d, 0xcc, 0x25, 0x9f, 0xd6, 0x00, 0x15, 0x83,
-	0x4a, 0xe1, 0x01, 0xc1, 0x71, 0x14, 0x1c, 0x61, 0xb2, 0x47, 0x51, 0x1e, 0x1e, 0xc9, 0x63, 0x58,
-	0xa0, 0x53, 0xdf, 0xed, 0xbb, 0x81, 0x1b, 0x7a, 0x98, 0x48, 0xec, 0x25, 0xd7, 0xe8, 0x2e, 0x3c,
-	0xb3, 0x1f, 0x44, 0xde, 0x93, 0xaf, 0x63, 0x7f, 0x74, 0x98, 0x6c, 0xbb, 0xa3, 0xc7, 0x87, 0x04,
-	0xc7, 0x87, 0x51, 0x30, 0x64, 0x0e, 0xaa, 0x3b, 0x65, 0xdb, 0xe8, 0x2d, 0x58, 0x25, 0x58, 0x80,
-	0x96, 0x8d, 0x12, 0x15, 0x0d, 0xa6, 0x62, 0x2e, 0x1f, 0x85, 0x26, 0xb4, 0x12, 0xbd, 0x13, 0x85,
-	0x7e, 0x12, 0x91, 0x5d, 0x4c, 0xfc, 0x68, 0x28, 0x40, 0x47, 0x71, 0xc3, 0xfe, 0xa1, 0x05, 0x0b,
-	0xea, 0x13, 0x9e, 0xde, 0x4c, 0xe3, 0x78, 0xb2, 0xf5, 0x50, 0x62, 0x36, 0xb6, 0x60, 0x90, 0x94,
-	0x5d, 0xe7, 0xbb, 0xb4, 0x58, 0xd6, 0x04, 0x24, 0x4d, 0x29, 0x54, 0x8a, 0x97, 0xc9, 0xfa, 0x6a,
-	0x9d, 0x4a, 0xf1, 0x5a, 0xf8, 0x5a, 0x69, 0x2b, 0xa4, 0xc1, 0xd8, 0x4a, 0x76, 0xa9, 0x51, 0x6d,
-	0xd1, 0x05, 0xa0, 0x65, 0x71, 0x4a, 0xe4, 0xc3, 0x89, 0xfe, 0x89, 0xee, 0x41, 0x6f, 0x44, 0x26,
-	0xde, 0xce, 0x84, 0xf7, 0x83, 0x6a, 0xda, 0x99, 0x15, 0x62, 0x6f, 0x3a, 0xbb, 0x03, 0xc1, 0xe0,
-	0xa8, 0xdc, 0xe8, 0x2e, 0x00, 0x7d, 0xa6, 0x3d, 0xe0, 0xef, 0xb9, 0xba, 0x06, 0x6f, 0x85, 0x2c,
-	0xbd, 0x25, 0xf8, 0xbe, 0xa3, 0xf0, 0xda, 0x3f, 0xa8, 0x01, 0x2a, 0x6a, 0x47, 0x77, 0xe0, 0x62,
-	0x1c, 0x07, 0x3c, 0x3b, 0xdf, 0x75, 0xc7, 0x78, 0xe7, 0x08, 0x13, 0xe2, 0x0f, 0x25, 0x14, 0x35,
-	0x6f, 0xd2, 0xe3, 0xf1, 0x04, 0xe3, 0xc9, 0x83, 0xc0, 0x3f, 0x62, 0x8f, 0x10, 0xe1, 0x52, 0x9d,
-	0x48, 0x13, 0x53, 0x23, 0x50, 0x14, 0xcf, 0x8f, 0x5a, 0x81, 0x8e, 0xd6, 0xe0, 0x6c, 0x4a, 0xdb,
-	0xc5, 0x64, 0xec, 0x27, 0xa2, 0x04, 0xe7, 0xc9, 0x34, 0x85, 0x0f, 0x5c, 0x3f, 0x78, 0xe4, 0xc6,
-	0x09, 0xcb, 0x8b, 0x8e, 0x93, 0xae, 0xa9, 0x5d, 0x6e, 0x10, 0x44, 0xdf, 0xde, 0x0a, 0x19, 0xbe,
-	0xc2, 0x0c, 0x8f, 0x76, 0x1c, 0x9d, 0x68, 0xdf, 0x80, 0xf3, 0x05, 0x5f, 0x19, 0x6f, 0xd2, 0x9f,
-	0x5a, 0xd0, 0x60, 0xd7, 0x4c, 0x31, 0x8a, 0xcb, 0xd0, 0x61, 0xb5, 0xf4, 0x7d, 0x12, 0xc8, 0x83,
-	0x24, 0xd7, 0xe8, 0xae, 0x1e, 0xe1, 0xba, 0x5e, 0xce, 0x71, 0x45, 0x78, 0xbf, 0xac, 0x85, 0xb7,
-	0xa1, 0x3d, 0xd7, 0xd9, 0x83, 0xd4, 0x1c, 0xdb, 0xef, 0xd7, 0xe0, 0x6c, 0x4e, 0xef, 0xff, 0x64,
-	0x60, 0xaf, 0xc1, 0x19, 0xdd, 0x4b, 0xc6, 0xa8, 0xfe, 0xd6, 0x82, 0x25, 0x53, 0x0b, 0xcc, 0x10,
-	0xe5, 0x4b, 0xd0, 0xf2, 0x5c, 0xea, 0x20, 0xe1, 0x07, 0xb1, 0x42, 0x9b, 0x86, 0x63, 0x78, 0xbd,
-	0xa2, 0xbb, 0x66, 0x8e, 0x1b, 0xda, 0xa0, 0x97, 0x01, 0x7f, 0x9b, 0x13, 0x11, 0xed, 0x6b, 0x55,
-	0x3d, 0x3a, 0xc9, 0xeb, 0x64, 0x62, 0xf6, 0x0c, 0x56, 0xaa, 0x7f, 0x91, 0xfa, 0x80, 0x61, 0x54,
-	0xfa, 0x5d, 0x0b, 0x1c, 0x82, 0xa2, 0x41, 0x0e, 0x36, 0xbf, 0x78, 0x2c, 0xe3, 0x73, 0x30, 0xfa,
-	0x67, 0x16, 0x5c, 0x3b, 0x8e, 0x00, 0x7a, 0x43, 0xc5, 0xd5, 0xeb, 0x27, 0xf8, 0xa9, 0x14, 0x67,
-	0x0f, 0x34, 0x9c, 0x7d, 0xf3, 0x04, 0x2a, 0x14, 0xdc, 0x7d, 0x0f, 0x6e, 0x1c, 0xf3, 0x47, 0x55,
-	0x34, 0xbb, 0xc0, 0x2c, 0xb0, 0xbf, 0x0a, 0x6b, 0xc7, 0xfd, 0x39, 0x93, 0xc7, 0xed, 0x0f, 0xe1,
-	0xd9, 0xca, 0x98, 0xb2, 0x8a, 0x12, 0x92, 0x28, 0x08, 0xb6, 0x86, 0xf2, 0x7d, 0x24, 0xd7, 0x14,
-	0x8c, 0xf1, 0xbf, 0xf7, 0xb0, 0x47, 0x70, 0x22, 0xb2, 0x51, 0xa3, 0xd9, 0x53, 0x7a, 0xe9, 0x8c,
-	0xb6, 0xc2, 0x83, 0x88, 0xb2, 0x07, 0xd8, 0x1d, 0x4d, 0xf1, 0xc3, 0x68, 0xec, 0xfa, 0x69, 0xd7,
-	0x56, 0xa5, 0xd1, 0xd4, 0x1e, 0xf2, 0x5d, 0x91, 0xda, 0x7c, 0x45, 0x6d, 0x0f, 0x69, 0xc2, 0xf3,
-	0xf3, 0xcc, 0xfe, 0xa6, 0xa6, 0x4d, 0x63, 0x4c, 0x18, 0x9d, 0x77, 0x09, 0xd2, 0xb5, 0xfd, 0xbd,
-	0x36, 0x2c, 0x38, 0xf8, 0x23, 0x8e, 0x1a, 0xf7, 0x70, 0x72, 0xfa, 0x59, 0xcb, 0x1a, 0xb4, 0x23,
-	0xfe, 0x21, 0xe2, 0x64, 0x65, 0x9d, 0x75, 0x46, 0x75, 0xe4, 0x36, 0xba, 0xaf, 0x4c, 0x65, 0xf8,
-	0x5c, 0x45, 0x3e, 0x85, 0x55, 0x8b, 0x4a, 0x67, 0x33, 0xdb, 0xf9, 0xd9, 0x0c, 0x1f, 0xac, 0x3c,
-	0x6f, 0xd2, 0x31, 0x7f, 0x42, 0x73, 0x5f, 0x99, 0xd0, 0xb4, 0xca, 0x8d, 0x29, 0x9b, 0xd3, 0xdc,
-	0x91, 0x10, 0x84, 0x8f, 0x59, 0x56, 0x4c, 0xb2, 0xc5, 0xe7, 0xda, 0xa8, 0x14, 0xa2, 0x74, 0x98,
-	0x9a, 0x9b, 0x46, 0x7f, 0xfc, 0x7f, 0x66, 0xf3, 0x5f, 0x37, 0xb3, 0xf9, 0x22, 0x2c, 0xa6, 0x31,
-	0xde, 0xf6, 0x63, 0x56, 0x83, 0x02, 0x3f, 0x4e, 0xc4, 0x13, 0x84, 0xfd, 0x6d, 0xdf, 0x80, 0x6e,
-	0xca, 0x44, 0x0f, 0x35, 0x9f, 0x9a, 0xa6, 0x60, 0x39, 0x5d, 0xdb, 0xaf, 0xc0, 0xb9, 0x94, 0xd1,
-	0xc1, 0xec, 0x69, 0x83, 0xae, 0x42, 0x57, 0xee, 0xf3, 0x76, 0x42, 0xd7, 0xc9, 0x08, 0xf6, 0xcf,
-	0x9b, 0xd0, 0x76, 0xf0, 0x47, 0x5b, 0xa1, 0x9f, 0xa0, 0x97, 0xd2, 0x73, 0x6e, 0x69, 0xf3, 0x20,
-	0xaa, 0x92, 0xd1, 0xf7, 0x70, 0x70, 0xa0, 0x8c, 0x56, 0x7b, 0x5e, 0xf6, 0xee, 0xef, 0xd7, 0x58,
-	0xde, 0x5e, 0x54, 0x44, 0x94, 0x7e, 0x81, 0xca, 0x89, 0xb6, 0x01, 0xc9, 0x65, 0xf6, 0x88, 0x15,
-	0xd3, 0xd9, 0xab, 0x45, 0xf9, 0x8c, 0xc7, 0x31, 0xc8, 0xa1, 0x6f, 0xc2, 0x25, 0x8d, 0x9a, 0x3e,
-	0xe9, 0x72, 0x13, 0xdb, 0xbc, 0xc6, 0xec, 0x61, 0x5e, 0x22, 0x5f, 0xb0, 0x93, 0x3d, 0xde, 0x44,
-	0xad, 0x29, 0xb3, 0x93, 0xf1, 0x38, 0x06, 0x39, 0xb4, 0x0b, 0x48, 0xab, 0x3b, 0x2c, 0x75, 0xc5,
-	0xe4, 0x4a, 0xb1, 0x71, 0xa7, 0xc0, 0xc3, 0x9c, 0x6e, 0x90, 0x45, 0x6f, 0xc1, 0xb9, 0x1c, 0x55,
-	0x8e, 0xb5, 0x56, 0x4a, 0xf5, 0x8d, 0x98, 0xb6, 0x82, 0x1c, 0x3d, 0xb1, 0x7c, 0xea, 0xc6, 0xcb,
-	0xcf, 0x05, 0x55, 0x81, 0xb4, 0x41, 0x8c, 0xdd, 0x9e, 0x17, 0x63, 0xc0, 0x2e, 0xe3, 0x44, 0x19,
-	0x27, 0x0d, 0x2e, 0x63, 0xe4, 0x73, 0xc0, 0x0f, 0x60, 0xc9, 0x50, 0x93, 0x66, 0x7d, 0x60, 0x72,
-	0xb6, 0xe2, 0x40, 0x03, 0x17, 0xd3, 0x63, 0x94, 0xb7, 0x7f, 0x67, 0xf1, 0xd3, 0xc0, 0xb3, 0xb0,
-	0xe2, 0x34, 0xd0, 0x43, 0x9a, 0x04, 0xb1, 0xe8, 0x2c, 0xd1, 0x3f, 0x0b, 0x63, 0xd1, 0xba, 0x61,
-	0x2c, 0x9a, 0xf6, 0x48, 0x1b, 0xca, 0xd0, 0x8c, 0x4a, 0x6a, 0xf3, 0x43, 0xfe, 0xb2, 0xd5, 0x87,
-	0x84, 0x7d, 0x68, 0x3f, 0xc1, 0x33, 0xf6, 0x54, 0xe5, 0xfd, 0x74, 0xb9, 0x64, 0x56, 0x62, 0x92,
-	0xb0, 0xad, 0xb6, 0xb0, 0x52, 0xac, 0xed, 0xcf, 0x2d, 0x5e, 0x02, 0xd2, 0x13, 0x76, 0xc2, 0x6f,
-	0x5a, 0x01, 0xe0, 0x00, 0xe1, 0xdd, 0xec, 0xfa, 0x57, 0x28, 0x12, 0x04, 0xbc, 0x9b, 0x03, 0x01,
-	0x6c, 0x2f, 0xef, 0x8f, 0x66, 0x95, 0x3f, 0x5a, 0xea, 0x10, 0xf1, 0x57, 0x35, 0x38, 0x9b, 0x5a,
-	0x3d, 0x98, 0xc6, 0x49, 0x34, 0xae, 0xb4, 0x7b, 0x2d, 0x87, 0x21, 0xce, 0xe5, 0x6b, 0x4b, 0x5a,
-	0x57, 0xfe, 0xa3, 0xc7, 0xcc, 0xe9, 0x7c, 0xb7, 0x3d, 0x67, 0xbe, 0x6b, 0xff, 0xdd, 0x82, 0x33,
-	0x7a, 0x95, 0xac, 0xf4, 0xdf, 0x6a, 0x5a, 0x6d, 0x95, 0x67, 0x8d, 0x4a, 0x62, 0x7d, 0x7a, 0x2c,
-	0xe2, 0x5c, 0x17, 0x7d, 0x7a, 0xb1, 0x2e, 0x36, 0x65, 0x1b, 0x55, 0x4d, 0xd9, 0x41, 0xda, 0x94,
-	0x6d, 0x2a, 0x4d, 0xd9, 0x41, 0x59, 0x53, 0xb6, 0x35, 0xb7, 0x29, 0xdb, 0x2e, 0x36, 0x65, 0x3f,
-	0xab, 0xc1, 0x45, 0x63, 0x91, 0x3f, 0xa5, 0x17, 0xf4, 0x46, 0xe2, 0x4e, 0xda, 0x24, 0xcc, 0x51,
-	0x95, 0x46, 0x22, 0xe5, 0x69, 0x68, 0x8d, 0x44, 0xba, 0x9f, 0x6f, 0xa3, 0x36, 0xe7, 0xb6, 0x51,
-	0x5b, 0x85, 0x9e, 0xa1, 0xda, 0xf0, 0x6c, 0xe7, 0x1a, 0x9e, 0xa2, 0x51, 0xb9, 0x93, 0x36, 0x2a,
-	0x3b, 0x59, 0xa3, 0x32, 0x25, 0xda, 0x9f, 0xd4, 0xe0, 0x72, 0xe9, 0xc5, 0xf5, 0x6f, 0xe2, 0x29,
-	0xdd, 0x0b, 0xcd, 0x4a, 0x2f, 0xb4, 0xe6, 0x79, 0xa1, 0x6d, 0xf2, 0xc2, 0x1f, 0x0c, 0xb9, 0xc2,
-	0x2f, 0xd5, 0xd3, 0x79, 0xc0, 0xd4, 0x80, 0xad, 0x97, 0x34, 0x60, 0xfb, 0xd0, 0x16, 0x0d, 0x57,
-	0xe1, 0x02, 0xb9, 0x3c, 0x59, 0xe3, 0xb3, 0xaa, 0x59, 0xdb, 0x3a, 0x7d, 0xb3, 0xb6, 0x7d, 0xbc,
-	0x66, 0xad, 0xfd, 0x17, 0x8b, 0x79, 0xb4, 0x08, 0x36, 0x2a, 0x3d, 0x9a, 0xf6, 0x68, 0x6b, 0xe5,
-	0x3d, 0xda, 0x7a, 0xa1, 0x47, 0x7b, 0x1f, 0x9a, 0xf4, 0xbe, 0x91, 0x2f, 0xbd, 0x1b, 0x55, 0x58,
-	0x67, 0xfd, 0xfd, 0x38, 0x7b, 0x29, 0x31, 0xa9, 0xe5, 0xbb, 0x00, 0x19, 0xd1, 0x80, 0xbb, 0x97,
-	0x54, 0xdc, 0xdd, 0x55, 0xa1, 0xf5, 0x37, 0xd8, 0xd9, 0x31, 0x03, 0xaa, 0xca, 0xef, 0xd4, 0x6f,
-	0xd4, 0x5a, 0xfe, 0x46, 0xb5, 0x7f, 0x5d, 0x83, 0x0b, 0x06, 0x68, 0x55, 0xa9, 0xb3, 0xcf, 0x1e,
-	0xc7, 0x8a, 0x42, 0xb9, 0xcc, 0xbc, 0x5a, 0x2f, 0xf7, 0x6a, 0xa3, 0xe0, 0xd5, 0x7b, 0xd2, 0xab,
-	0x1c, 0x8f, 0x5e, 0x2f, 0x47, 0x7c, 0x45, 0x9f, 0x66, 0x6d, 0xf3, 0xd6, 0xf1, 0xda, 0xe6, 0xed,
-	0xaa, 0xb6, 0xf9, 0x29, 0x22, 0xf4, 0x99, 0x05, 0xcf, 0x94, 0x60, 0xd4, 0xd3, 0x04, 0xe8, 0x29,
-	0x8f, 0x05, 0xfe, 0x5a, 0x83, 0x8e, 0x04, 0xc2, 0x95, 0x66, 0x5d, 0x85, 0x2e, 0x83, 0x0c, 0x8a,
-	0x55, 0x19, 0x41, 0x76, 0x29, 0xeb, 0x59, 0x97, 0xb2, 0xb4, 0xd5, 0xdb, 0x38, 0x51, 0xab, 0xb7,
-	0x79, 0xdc, 0x56, 0x6f, 0xab, 0xa4, 0xd5, 0xbb, 0xa2, 0x75, 0x45, 0x39, 0x3e, 0x55, 0xdb, 0x9d,
-	0x86, 0x56, 0x70, 0x67, 0x7e, 0x2b, 0xb8, 0x3b, 0xaf, 0x15, 0x0c, 0xa6, 0x56, 0xf0, 0x2f, 0x6b,
-	0xac, 0x2d, 0xf5, 0x54, 0x0e, 0xaa, 0x1e, 0x90, 0x7a, 0x49, 0x40, 0x1a, 0x59, 0x40, 0x9e, 0xbe,
-	0x6b, 0x0d, 0xae, 0x6b, 0xcf, 0x77, 0x5d, 0x67, 0x9e, 0xeb, 0xba, 0x26, 0xd7, 0x7d, 0x52, 0x67,
-	0x4f, 0xf9, 0xb9, 0x50, 0x52, 0x05, 0x8a, 0xb5, 0x1c, 0x50, 0x2c, 0x26, 0xa9, 0x3a, 0x30, 0x69,
-	0xe4, 0x06, 0x26, 0xa5, 0x09, 0xdc, 0x3c, 0x51, 0x02, 0xb7, 0x8e, 0xeb, 0xe5, 0xf6, 0xb1, 0x12,
-	0xb8, 0x73, 0x9c, 0x04, 0xee, 0xce, 0x8f, 0x02, 0xcc, 0x8b, 0x42, 0xcf, 0x14, 0x85, 0x3f, 0xd6,
-	0xa0, 0xa7, 0x3c, 0x86, 0x4f, 0x95, 0xbf, 0x55, 0x90, 0xbe, 0x98, 0xbd, 0x6a, 0xa4, 0x9a, 0xb9,
-	0x48, 0x3d, 0x7d, 0x9f, 0xff, 0x2b, 0x8b, 0xc2, 0x9f, 0x6a, 0xec, 0x9e, 0x30, 0x0e, 0x7f, 0xe6,
-	0x64, 0x3a, 0xad, 0xe9, 0x6a, 0xa6, 0xcb, 0xb5, 0x21, 0xd3, 0xaf, 0xc1, 0x62, 0x9a, 0x33, 0xca,
-	0xad, 0xab, 0x13, 0xe9, 0x2d, 0x92, 0x12, 0xd2, 0xb9, 0x03, 0x63, 0xe7, 0x3e, 0x2f, 0xd9, 0xa5,
-	0xc0, 0x2f, 0xb7, 0x93, 0xfe, 0x0e, 0x8f, 0x45, 0xd9, 0xb6, 0x32, 0xcc, 0x6a, 0x6b, 0xc3, 0x2c,
-	0x75, 0xf0, 0xd0, 0x99, 0x33, 0x78, 0xe8, 0x1a, 0x06, 0x0f, 0x7f, 0xb6, 0xe0, 0x4a, 0x45, 0xfb,
-	0xe5, 0xb4, 0x99, 0x9b, 0x7a, 0xbe, 0x6e, 0xf6, 0x7c, 0xc3, 0x34, 0xae, 0x6b, 0x96, 0x7e, 0x61,
-	0x6b, 0xce, 0x17, 0xb6, 0x8b, 0x5f, 0xb8, 0xb1, 0x05, 0x6b, 0x5e, 0xb8, 0xee, 0xee, 0x63, 0xe2,
-	0x7b, 0xeb, 0x07, 0xee, 0x3e, 0xf1, 0xbd, 0x97, 0x79, 0x97, 0x61, 0x7d, 0x44, 0x26, 0x1e, 0xff,
-	0xb7, 0x13, 0x8e, 0x9c, 0x36, 0x7a, 0xbc, 0x89, 0xb3, 0x4b, 0x49, 0xdf, 0x3a, 0x97, 0xff, 0x47,
-	0x95, 0xfd, 0x16, 0x5b, 0xdc, 0xfe, 0x67, 0x00, 0x00, 0x00, 0xff, 0xff, 0xca, 0x8c, 0x6d, 0x0d,
-	0xc3, 0x32, 0x00, 0x00,
+	// 3172 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x1b, 0x4d, 0x8f, 0x1c, 0x47,
+	0x55, 0xf3, 0x3d, 0xf3, 0x76, 0xd7, 0x1f, 0xe5, 0xb5, 0x33, 0x5e, 0x3b, 0xcb, 0xa6, 0x65, 0xc7,
+	0x1b, 0x92, 0xac, 0x13, 0xdb, 0x04, 0x13, 0xcb, 0x10, 0xef, 0xd8, 0x0e, 0x9b, 0x6c, 0xbc, 0x93,
+	0x5a, 0x27, 0x20, 0x2e, 0x51, 0x6f, 0x4f, 0xcd, 0x4c, 0xcb, 0x3d, 0xdd, 0xe3, 0xea, 0x9e, 0xc5,
+	0x83, 0xc4, 0x29, 0x20, 0x71, 0x8c, 0x38, 0x72, 0x41, 0x28, 0xe2, 0x88, 0x38, 0x20, 0x21, 0x4e,
+	0x88, 0x13, 0x42, 0x42, 0x80, 0x10, 0x3f, 0x00, 0x89, 0x23, 0x7f, 0x80, 0x0b, 0x48, 0xa8, 0xbe,
+	0x7a, 0xaa, 0xba, 0xab, 0x67, 0x76, 0xb5, 0x11, 0x02, 0x94, 0xdb, 0xd4, 0xfb, 0xaa, 0xd7, 0xef,
+	0xbd, 0x7a, 0xf5, 0xea, 0x3d, 0x0d, 0x3c, 0x3f, 0xa0, 0x63, 0xef, 0xfa, 0x98, 0x46, 0x49, 0x74,
+	0xdd, 0x1b, 0xba, 0x7e, 0x78, 0xdd, 0x8b, 0xc2, 0xbe, 0x3f, 0xd8, 0xe2, 0x20, 0x54, 0xe3, 0x30,
+	0xe7, 0x97, 0x75, 0xa8, 0x77, 0x38, 0x1c, 0xb5, 0xa1, 0x71, 0x48, 0x68, 0xec, 0x47, 0x61, 0xbb,
+	0xb4, 0x51, 0xda, 0x6c, 0x61, 0xb5, 0x44, 0x57, 0xa1, 0xee, 0x05, 0x3e, 0x09, 0x93, 0x76, 0x79,
+	0xa3, 0xb4, 0xb9, 0x74, 0x63, 0x65, 0x8b, 0x33, 0x6f, 0x75, 0x38, 0x10, 0x4b, 0x24, 0xfa, 0x32,
+	0x34, 0xbd, 0xa1, 0x1b, 0x86, 0x24, 0x88, 0xdb, 0x95, 0x8d, 0xca, 0xe6, 0xd2, 0x8d, 0x4b, 0x8a,
+	0x50, 0xec, 0xdc, 0x91, 0xd8, 0x07, 0x61, 0x42, 0xa7, 0x38, 0x25, 0x46, 0x0f, 0x61, 0x25, 0xa2,
+	0x03, 0x37, 0xf4, 0xbf, 0xe3, 0x26, 0x7e, 0x14, 0xc6, 0xed, 0x2a, 0xe7, 0xde, 0x30, 0xb9, 0xf7,
+	0x74, 0x12, 0x21, 0xc2, 0x64, 0x63, 0x0a, 0x44, 0xb4, 0x47, 0x28, 0xa1, 0x71, 0xbb, 0x66, 0x53,
+	0x60, 0x4f, 0x62, 0xa5, 0x02, 0x8a, 0x18, 0x6d, 0x41, 0x6d, 0x4c, 0x18, 0x57, 0x9d, 0x73, 0xb5,
+	0x4d, 0xae, 0x2e, 0x49, 0x59, 0x04, 0x19, 0x72, 0xe1, 0x82, 0x47, 0x68, 0xe2, 0xf7, 0x7d, 0xcf,
+	0x4d, 0xc8, 0xbd, 0x49, 0x32, 0x8c, 0xa8, 0x9f, 0xf8, 0x24, 0x6e, 0x37, 0xb8, 0x80, 0x97, 0x32,
+	0xdf, 0x6d, 0xa5, 0x15, 0x12, 0x0b, 0x04, 0xad, 0xbd, 0x0b, 0x2b, 0x86, 0xb9, 0xd0, 0x19, 0xa8,
+	0x3c, 0x21, 0x53, 0xe9, 0x1a, 0xf6, 0x13, 0x5d, 0x81, 0xda, 0xa1, 0x1b, 0x4c, 0x88, 0xf4, 0xca,
+	0x29, 0xb5, 0xa9, 0x60, 0xc3, 0x02, 0xf9, 0x66, 0xf9, 0x76, 0x69, 0xed, 0x03, 0x40, 0x79, 0xeb,
+	0x59, 0x24, 0xbe, 0x64, 0x4a, 0x3c, 0x27, 0x25, 0xea, 0xbc, 0xba, 0xd8, 0x77, 0x61, 0xc5, 0xb0,
+	0xe8, 0xd1, 0x75, 0x94, 0x6c, 0xba, 0xb0, 0x07, 0x00, 0x5d, 0x32, 0x47, 0xd2, 0x0b, 0xa6, 0xa4,
+	0x25, 0x29, 0xa9, 0x4b, 0x4c, 0x31, 0x7d, 0xb8, 0x34, 0xc7, 0xdc, 0x16, 0xb9, 0xaf, 0x9b, 0x72,
+	0xd3, 0x88, 0xc9, 0x0b, 0x99, 0x6a, 0xfb, 0x38, 0xff, 0xaa, 0x40, 0x5d, 0xc4, 0x3f, 0x72, 0x60,
+	0x59, 0x8f, 0x43, 0x29, 0xdc, 0x80, 0xa1, 0x2d, 0x68, 0x04, 0xd1, 0x60, 0xe0, 0x87, 0x03, 0xb9,
+	0xcf, 0xaa, 0x71, 0x86, 0x76, 0x05, 0x0e, 0x2b, 0x22, 0x74, 0x15, 0xaa, 0x2c, 0xd4, 0xda, 0x15,
+	0x4e, 0x7c, 0xd6, 0x20, 0xe6, 0x9f, 0xcc, 0xd1, 0xe8, 0x2e, 0x2c, 0x93, 0x43, 0x12, 0x26, 0xfb,
+	0x84, 0x1e, 0xfa, 0x1e, 0x69, 0x57, 0x39, 0xf9, 0x45, 0x83, 0xfc, 0x81, 0x46, 0x80, 0x0d, 0x72,
+	0xb4, 0x09, 0x35, 0x7e, 0x06, 0xda, 0x35, 0xce, 0x87, 0x0c, 0x3e, 0xee, 0x23, 0x2c, 0x08, 0xd0,
+	0xcb, 0x50, 0x1f, 0x04, 0xd1, 0x81, 0x1b, 0xb4, 0xeb, 0x46, 0x68, 0x08, 0xd2, 0xb7, 0x39, 0x0a,
+	0x4b, 0x12, 0xa6, 0x95, 0x47, 0xa7, 0xe3, 0x24, 0x12, 0xf1, 0xdf, 0x6e, 0x58, 0xb4, 0xea, 0x68,
+	0x04, 0xd8, 0x20, 0x47, 0x0f, 0xe1, 0xb4, 0x47, 0x49, 0x8f, 0x84, 0x89, 0xef, 0x06, 0xfb, 0x49,
+	0x44, 0x49, 0xbb, 0xc9, 0x25, 0x5c, 0xce, 0x48, 0x30, 0x68, 0x70, 0x96, 0x89, 0x7d, 0xdd, 0x76,
+	0xa7, 0xb3, 0xdf, 0x6d, 0xb7, 0x2c, 0x5f, 0xc7, 0x31, 0x58, 0x10, 0xa0, 0xd7, 0xa1, 0x99, 0x04,
+	0x31, 0x73, 0x79, 0xdc, 0x06, 0x4e, 0x7c, 0xde, 0x20, 0x7e, 0xbc, 0xbb, 0xcf, 0x91, 0x38, 0x25,
+	0x73, 0xae, 0xc2, 0x8a, 0xe1, 0x3a, 0xb4, 0x0a, 0xb5, 0x80, 0x1c, 0x92, 0x40, 0xba, 0x5f, 0x2c,
+	0x9c, 0xb7, 0x00, 0x66, 0x4e, 0x43, 0x37, 0xa0, 0x91, 0xf8, 0x23, 0x12, 0x4d, 0x12, 0x4e, 0xa5,
+	0x65, 0x9a, 0x94, 0xe6, 0xb1, 0xc0, 0x63, 0x45, 0xe8, 0x7c, 0x52, 0x82, 0xb3, 0x39, 0x34, 0x5a,
+	0x07, 0xf0, 0xa2, 0x30, 0x24, 0x9e, 0x16, 0x71, 0x1a, 0x04, 0xad, 0x41, 0x93, 0x92, 0x78, 0x1c,
+	0x85, 0xb1, 0x08, 0xec, 0x16, 0x4e, 0xd7, 0xe8, 0x6b, 0xd0, 0xea, 0xf9, 0xb1, 0x17, 0x1d, 0x12,
+	0x3a, 0x95, 0x01, 0xf6, 0x42, 0x91, 0x1e, 0xf7, 0x15, 0x21, 0x9e, 0xf1, 0x38, 0xf7, 0x61, 0xad,
+	0x98, 0x10, 0xbd, 0x08, 0xa7, 0x06, 0x94, 0x4c, 0x77, 0xfd, 0x38, 0x79, 0xf0, 0x6c, 0xec, 0x53,
+	0x75, 0xda, 0x32, 0x50, 0xa7, 0x0b, 0x28, 0x1f, 0xa0, 0xe8, 0xcd, 0xac, 0x89, 0x36, 0x0a, 0x83,
+	0x39, 0x67, 0xaa, 0x3d, 0xb8, 0x58, 0x48, 0x85, 0x6e, 0xc0, 0x2a, 0x25, 0x03, 0x3f, 0x4e, 0xa8,
+	0xc8, 0x63, 0xca, 0x3a, 0x42, 0x39, 0x2b, 0xce, 0xd9, 0x86, 0x25, 0xed, 0x2c, 0xa0, 0x9b, 0x59,
+	0xdd, 0x2e, 0xe6, 0x0f, 0x4c, 0x4e, 0xa9, 0xf4, 0x33, 0x75, 0xf4, 0x49, 0xfc, 0xe7, 0x24, 0xb0,
+	0xac, 0x1f, 0x3b, 0x74, 0x2b, 0xab, 0xd6, 0x9a, 0xe5, 0x70, 0x66, 0xf5, 0x62, 0x77, 0x9e, 0xe7,
+	0x7a, 0x43, 0x95, 0xf7, 0xda, 0x16, 0x9e, 0x0e, 0xc3, 0x63, 0x41, 0xe6, 0x7c, 0x04, 0xe7, 0x2c,
+	0xf2, 0x58, 0xd8, 0x3f, 0x9d, 0x90, 0xd4, 0xc9, 0x62, 0xc1, 0x6a, 0x09, 0xf2, 0x8c, 0x78, 0x93,
+	0x44, 0x69, 0xaf, 0x96, 0x0c, 0x43, 0x49, 0x3c, 0x1a, 0x8c, 0x12, 0x1e, 0x7a, 0x2d, 0xac, 0x96,
+	0xce, 0x3f, 0xd2, 0x40, 0xd7, 0x76, 0x67, 0xd1, 0x34, 0x33, 0xcb, 0x4e, 0x2f, 0x50, 0x0e, 0xcb,
+	0x40, 0xd1, 0x17, 0xe1, 0x8c, 0x9e, 0xda, 0x38, 0xa5, 0xd8, 0x3a, 0x07, 0x47, 0x57, 0x60, 0x45,
+	0xd6, 0x1e, 0x32, 0x41, 0x09, 0x4d, 0x4c, 0x20, 0x7a, 0x05, 0xce, 0x4a, 0xc0, 0x7b, 0x64, 0x74,
+	0x40, 0x68, 0x3c, 0xf4, 0xc7, 0x3c, 0xc1, 0xb6, 0x70, 0x1e, 0x81, 0x2e, 0xeb, 0x87, 0xaa, 0xc6,
+	0xa9, 0x66, 0x00, 0x86, 0x8d, 0x49, 0x20, 0xbd, 0x5d, 0x17, 0xd8, 0x14, 0xe0, 0x6c, 0x02, 0xca,
+	0x27, 0x45, 0x84, 0xa0, 0x3a, 0x76, 0x93, 0xa1, 0xfc, 0x5e, 0xfe, 0xdb, 0x39, 0x84, 0xf3, 0xd6,
+	0xe4, 0x67, 0x23, 0x46, 0x3b, 0xb0, 0x24, 0xf2, 0xaa, 0xc8, 0xa1, 0xc2, 0xcf, 0xd7, 0xe6, 0xe5,
+	0xd0, 0xce, 0x8c, 0x1c, 0xeb, 0xbc, 0xce, 0x1b, 0xb0, 0xb1, 0x88, 0xc1, 0xaa, 0xef, 0x03, 0x75,
+	0x80, 0x44, 0x9e, 0x7d, 0x03, 0x9a, 0x31, 0xf1, 0x26, 0xec, 0x2e, 0xb5, 0x86, 0x2a, 0xa7, 0xda,
+	0x97, 0x14, 0x38, 0xa5, 0x75, 0x7e, 0x57, 0x82, 0x73, 0x16, 0x0a, 0x1e, 0x66, 0xa1, 0x7b, 0x10,
+	0x90, 0x1e, 0x17, 0xd7, 0xc4, 0x6a, 0x89, 0xee, 0x40, 0xa3, 0x47, 0xfa, 0xee, 0x24, 0x50, 0x35,
+	0xeb, 0x0b, 0xc5, 0x1b, 0xdd, 0x17, 0x84, 0x58, 0x71, 0xb0, 0xf8, 0x18, 0xba, 0xf1, 0xf0, 0x5e,
+	0x30, 0x60, 0xd7, 0xfe, 0x70, 0xa4, 0xe2, 0xc3, 0x00, 0xb2, 0x23, 0x1c, 0x47, 0xfd, 0xe4, 0x43,
+	0x42, 0xfd, 0xfe, 0x94, 0x07, 0x46, 0x13, 0x6b, 0x90, 0xd9, 0x85, 0xc0, 0xa2, 0xa1, 0xa6, 0x2e,
+	0x84, 0xdb, 0xb0, 0x56, 0xac, 0x02, 0x3b, 0xf6, 0x63, 0x1a, 0x1d, 0xfa, 0xec, 0x4e, 0x16, 0x76,
+	0x4c, 0xd7, 0xce, 0x08, 0x4e, 0x99, 0xb7, 0x11, 0x3b, 0x1b, 0xf1, 0x34, 0x4e, 0xc8, 0x88, 0x2d,
+	0xbb, 0x51, 0x14, 0x48, 0x2b, 0x64, 0xa0, 0xe8, 0x66, 0xa6, 0x7e, 0xbf, 0x64, 0xbd, 0xdc, 0xcc,
+	0x6a, 0xde, 0xf9, 0x2e, 0xac, 0xda, 0xf0, 0xe8, 0xb5, 0x59, 0x05, 0xb5, 0x74, 0x63, 0x7d, 0x8e,
+	0xa4, 0x77, 0xc9, 0x54, 0x54, 0x58, 0x37, 0xa1, 0xea, 0x11, 0xaa, 0x36, 0xff, 0xc2, 0x1c, 0x16,
+	0xf6, 0x13, 0x73, 0x62, 0xe7, 0x55, 0x78, 0xae, 0x40, 0xa8, 0x35, 0xd0, 0xb6, 0xa0, 0x5d, 0x24,
+	0xd0, 0x4a, 0xff, 0x8b, 0x12, 0x34, 0x64, 0xa1, 0x8c, 0xae, 0xab, 0xea, 0xbf, 0xb4, 0x51, 0xd1,
+	0x93, 0xba, 0x40, 0x5b, 0xca, 0xff, 0x97, 0xa0, 0xd9, 0x8d, 0x02, 0xdf, 0x63, 0x05, 0xbf, 0xf9,
+	0x22, 0xe2, 0xe0, 0x29, 0x4e, 0xd1, 0x6b, 0xbb, 0x0b, 0xaa, 0xda, 0x4d, 0xb3, 0xfa, 0x44, 0xe6,
+	0xde, 0x99, 0xe2, 0xd6, 0xf9, 0x71, 0x09, 0x96, 0x34, 0x14, 0x0b, 0x54, 0x12, 0xf6, 0x22, 0x1a,
+	0xfb, 0xe1, 0x80, 0x01, 0xa4, 0xff, 0x4d, 0x20, 0x4f, 0xa1, 0x4c, 0xaa, 0x17, 0xf5, 0xc8, 0xfb,
+	0x3c, 0x57, 0x97, 0x45, 0x98, 0x98, 0x50, 0xb4, 0x01, 0x4b, 0x01, 0xe9, 0x0d, 0x08, 0x15, 0x44,
+	0x15, 0x4e, 0xa4, 0x83, 0x18, 0x85, 0x48, 0xa6, 0xd1, 0x84, 0xca, 0x6a, 0xb3, 0x89, 0x75, 0x90,
+	0xf3, 0xc7, 0x12, 0xd4, 0x85, 0x11, 0xd0, 0x1e, 0x20, 0x7e, 0x19, 0x74, 0x8c, 0x54, 0x5b, 0x32,
+	0x82, 0x40, 0x90, 0xbe, 0x9f, 0x23, 0xc3, 0x16, 0x56, 0x74, 0x4b, 0x4f, 0xb1, 0xc2, 0x5e, 0x17,
+	0x0c, 0x39, 0xb6, 0x62, 0x25, 0x57, 0x22, 0x57, 0x8c, 0x9b, 0x5b, 0x30, 0x16, 0x97, 0xc8, 0xce,
+	0x8f, 0x4a, 0xd0, 0x2e, 0xd2, 0x92, 0x55, 0xfe, 0x23, 0x3f, 0x2d, 0x17, 0x62, 0xfe, 0x71, 0x35,
+	0x6c, 0xc0, 0x58, 0x9a, 0x18, 0xb9, 0xcf, 0x1e, 0xbb, 0x74, 0x40, 0x12, 0x11, 0x2e, 0x35, 0xac,
+	0x41, 0xd0, 0x9b, 0xd0, 0xa2, 0x24, 0xa1, 0xd3, 0xbd, 0x71, 0x12, 0xb7, 0x2b, 0x46, 0x9d, 0x2b,
+	0xf6, 0xed, 0x44, 0xa3, 0x11, 0xab, 0x49, 0x24, 0x0d, 0x9e, 0x91, 0x3b, 0x3f, 0x29, 0xc1, 0x79,
+	0x2b, 0x11, 0x4b, 0x24, 0x6e, 0x92, 0x90, 0xd1, 0x38, 0x51, 0x5a, 0xa5, 0x6b, 0x16, 0x0f, 0x7e,
+	0xe8, 0xb3, 0x1c, 0xbe, 0xed, 0x7a, 0x4f, 0xa2, 0x7e, 0x5f, 0x5e, 0x94, 0x19, 0xa8, 0xd4, 0x5c,
+	0xd1, 0x88, 0x1c, 0xa8, 0x41, 0x58, 0xf4, 0x1d, 0x88, 0x9f, 0x0f, 0x5d, 0x2f, 0x89, 0x28, 0x8f,
+	0x87, 0x32, 0x36, 0x81, 0xce, 0x08, 0x4e, 0x67, 0xbc, 0x93, 0x31, 0x49, 0x69, 0xbe, 0x49, 0xca,
+	0xc7, 0x33, 0xc9, 0x27, 0x65, 0x40, 0x79, 0xa7, 0xb2, 0xf2, 0x80, 0x92, 0x38, 0x0a, 0x0e, 0x09,
+	0xdd, 0x4f, 0xa8, 0x9b, 0x90, 0x81, 0x3a, 0x86, 0x39, 0x38, 0xb3, 0xdd, 0x81, 0x1b, 0xb8, 0xa1,
+	0x47, 0xa8, 0xaa, 0xbd, 0xd4, 0x1a, 0xdd, 0x86, 0xe7, 0x0e, 0x82, 0xc8, 0x7b, 0xf2, 0x75, 0xe2,
+	0x0f, 0x86, 0xc9, 0xae, 0x3b, 0x78, 0x3c, 0xa4, 0x24, 0x1e, 0x46, 0x41, 0x8f, 0x1b, 0xa8, 0x82,
+	0x8b, 0xd0, 0xe8, 0x1d, 0xd8, 0xa0, 0x44, 0x16, 0x2d, 0xdb, 0x05, 0x22, 0xaa, 0x5c, 0xc4, 0x42,
+	0x3a, 0x56, 0x9a, 0xb0, 0x4c, 0xf4, 0x5e, 0x14, 0xfa, 0x49, 0x44, 0xbb, 0x84, 0xfa, 0x51, 0x4f,
+	0x16, 0x1d, 0x79, 0x84, 0xf3, 0xc3, 0x12, 0x2c, 0xeb, 0x4f, 0x78, 0x76, 0x33, 0x8d, 0xe2, 0xf1,
+	0xce, 0x7d, 0x55, 0xb3, 0xf1, 0x05, 0x2f, 0x49, 0xf9, 0x75, 0xde, 0x65, 0xc9, 0xb2, 0x2c, 0x4b,
+	0xd2, 0x14, 0xc2, 0xb8, 0x44, 0x9a, 0x64, 0xbd, 0x9d, 0x96, 0xca, 0x85, 0x6f, 0x14, 0xb6, 0x42,
+	0xaa, 0x9c, 0xac, 0x00, 0xcb, 0x94, 0x6a, 0xc8, 0x2e, 0x00, 0x4b, 0x8b, 0x13, 0xaa, 0x1e, 0x4e,
+	0xec, 0x27, 0xba, 0x03, 0x4b, 0xac, 0x7d, 0xb5, 0x37, 0x16, 0xfd, 0xa0, 0xb2, 0x71, 0x66, 0x25,
+	0xdb, 0xdb, 0xb8, 0xdb, 0x91, 0x04, 0x58, 0xa7, 0x46, 0xb7, 0x01, 0xd8, 0x33, 0xed, 0x9e, 0x78,
+	0xcf, 0x55, 0x8c, 0xf2, 0x56, 0xf2, 0xb2, 0x5b, 0x42, 0xe0, 0xb1, 0x46, 0xeb, 0xfc, 0xa0, 0x0c,
+	0x48, 0x52, 0x68, 0xd2, 0xd1, 0x2d, 0x38, 0x1f, 0xc7, 0x81, 0x88, 0xce, 0x47, 0xee, 0x88, 0xec,
+	0x1d, 0x12, 0x4a, 0xfd, 0x9e, 0x2a, 0x45, 0xed, 0x48, 0x76, 0x3c, 0x9e, 0x10, 0x32, 0xbe, 0x17,
+	0xf8, 0x87, 0xfc, 0x11, 0x22, 0x4d, 0x6a, 0x02, 0x59, 0x60, 0x1a, 0x00, 0x56, 0xc5, 0x8b, 0xa3,
+	0x96, 0x83, 0xa3, 0x4d, 0x38, 0x9d, 0xc2, 0xba, 0x84, 0x8e, 0xfc, 0x44, 0xa6, 0xe0, 0x2c, 0x98,
+	0x85, 0x70, 0xdf, 0xf5, 0x83, 0x87, 0x6e, 0x9c, 0xf0, 0xb8, 0x68, 0xe2, 0x74, 0xcd, 0xf4, 0x72,
+	0x83, 0x20, 0xfa, 0xf6, 0x4e, 0xc8, 0xeb, 0x2b, 0xc2, 0xeb, 0xd1, 0x26, 0x36, 0x81, 0xce, 0x35,
+	0x38, 0x9b, 0xb3, 0x95, 0xf5, 0x26, 0xfd, 0x69, 0x09, 0xaa, 0x5d, 0x62, 0xf5, 0xe2, 0x1a, 0x34,
+	0x79, 0x2e, 0xfd, 0x80, 0x06, 0xea, 0x20, 0xa9, 0x35, 0xba, 0x6d, 0x7a, 0xb8, 0x62, 0xa6, 0x73,
+	0x32, 0xc7, 0xbd, 0x5f, 0x32, 0xdc, 0x5b, 0x35, 0x9e, 0xeb, 0x5d, 0xa2, 0xeb, 0x6b, 0xf8, 0xf6,
+	0xfb, 0x65, 0x38, 0xdd, 0x25, 0x9f, 0x3b, 0xf6, 0x0a, 0x9c, 0xea, 0x92, 0x85, 0x5e, 0xfd, 0x7d,
+	0x09, 0x56, 0x6d, 0x2d, 0x30, 0x8b, 0x97, 0x2f, 0x40, 0xdd, 0x73, 0x1f, 0xb9, 0xa9, 0x1d, 0xe4,
+	0x0a, 0x3d, 0xb0, 0x1c, 0xc3, 0xab, 0x73, 0xba, 0x6b, 0x76, 0xbf, 0xa1, 0x6d, 0x68, 0xa9, 0xb7,
+	0x39, 0x95, 0xde, 0xbe, 0x32, 0x47, 0x0a, 0x56, 0xb4, 0x78, 0xc6, 0xe6, 0x4c, 0x61, 0x7d, 0xfe,
+	0x8e, 0xcc, 0x06, 0xbc, 0x46, 0x65, 0xdf, 0xb5, 0x2c, 0x4a, 0x50, 0xd4, 0xc9, 0x94, 0xcd, 0x2f,
+	0x1f, 0x49, 0xf9, 0x4c, 0x19, 0xfd, 0xb3, 0x12, 0x5c, 0x39, 0x0a, 0x03, 0x7a, 0x4b, 0xaf, 0xab,
+	0xb7, 0x8e, 0xb1, 0x55, 0x5a, 0x67, 0x77, 0x8c, 0x3a, 0xfb, 0xfa, 0x31, 0x44, 0x68, 0x75, 0xf7,
+	0x1d, 0xb8, 0x76, 0xc4, 0x4d, 0xf5, 0x6a, 0x76, 0x99, 0x6b, 0xe0, 0x7c, 0x15, 0x36, 0x8f, 0xba,
+	0x9d, 0xcd, 0xe2, 0xce, 0x47, 0xf0, 0xfc, 0x5c, 0x9f, 0xf2, 0x8c, 0x12, 0xd2, 0x28, 0x08, 0x76,
+	0x7a, 0xea, 0x7d, 0xa4, 0xd6, 0xac, 0x18, 0x13, 0xbf, 0xf7, 0x89, 0x47, 0x49, 0x22, 0xa3, 0xd1,
+	0x80, 0x39, 0x13, 0x76, 0xe9, 0x0c, 0x76, 0xc2, 0x7e, 0xc4, 0xc8, 0x03, 0xe2, 0x0e, 0x26, 0xe4,
+	0x7e, 0x34, 0x72, 0xfd, 0xb4, 0x6b, 0xab, 0xc3, 0x58, 0x68, 0xf7, 0x04, 0x56, 0x86, 0xb6, 0x58,
+	0x31, 0xdd, 0x43, 0x16, 0xf0, 0xe2, 0x3c, 0xf3, 0xdf, 0x4c, 0xb5, 0x49, 0x4c, 0x28, 0x87, 0x8b,
+	0x2e, 0x41, 0xba, 0x76, 0xbe, 0xd7, 0x80, 0x65, 0x4c, 0x9e, 0x8a, 0xaa, 0x71, 0x9f, 0x24, 0x27,
+	0x9f, 0xb5, 0x6c, 0x42, 0x23, 0x12, 0x1f, 0xd2, 0xae, 0x64, 0x3a, 0xeb, 0x1c, 0x8a, 0x15, 0x1a,
+	0xdd, 0xd5, 0xa6, 0x32, 0x62, 0xae, 0xa2, 0x9e, 0xc2, 0xba, 0x46, 0x85, 0xb3, 0x99, 0xdd, 0xec,
+	0x6c, 0x46, 0x0c, 0x56, 0x5e, 0xb4, 0xc9, 0x58, 0x3c, 0xa1, 0xb9, 0xab, 0x4d, 0x68, 0xea, 0xc5,
+	0xca, 0x14, 0xcd, 0x69, 0x6e, 0xa9, 0x12, 0x44, 0x8c, 0x59, 0xd6, 0x6d, 0xbc, 0xf9, 0xe7, 0xda,
+	0xa0, 0xb0, 0x44, 0x69, 0x6e, 0x54, 0xb4, 0x93, 0x62, 0xda, 0xe3, 0xf3, 0x99, 0xcd, 0xff, 0xdd,
+	0xcc, 0xe6, 0x2b, 0xb0, 0x92, 0xfa, 0x98, 0x35, 0xa2, 0xd9, 0x39, 0x0e, 0xfc, 0x38, 0x91, 0x4f,
+	0x10, 0xfe, 0x9b, 0x95, 0xb9, 0x71, 0xe2, 0xca, 0xee, 0x61, 0x13, 0x8b, 0x85, 0x73, 0x17, 0x5a,
+	0x29, 0x2b, 0x3b, 0xea, 0x62, 0x96, 0x9a, 0x96, 0xd0, 0xe9, 0xba, 0x80, 0xfd, 0x35, 0x38, 0x93,
+	0xb2, 0x63, 0xc2, 0x9f, 0x41, 0xac, 0x27, 0xa8, 0xb8, 0x44, 0xeb, 0xa1, 0x85, 0x67, 0x00, 0xe7,
+	0x34, 0xd7, 0x15, 0xbb, 0xfd, 0x64, 0x3f, 0x71, 0x93, 0x49, 0xec, 0xfc, 0xa6, 0x04, 0xa7, 0xd8,
+	0x92, 0x19, 0x4f, 0x80, 0xd0, 0x29, 0x28, 0xfb, 0x2a, 0x0f, 0x96, 0xfd, 0x9e, 0xba, 0x9b, 0xcb,
+	0xb3, 0xbb, 0xf9, 0x32, 0xbb, 0x3c, 0x5d, 0x6f, 0xc8, 0x9a, 0x62, 0xf2, 0x41, 0x3f, 0x03, 0xb0,
+	0x2c, 0x14, 0xb8, 0x71, 0x72, 0xcf, 0x7b, 0x22, 0x5f, 0x1e, 0x6a, 0x29, 0x5e, 0x68, 0x89, 0x37,
+	0xdc, 0x09, 0x7b, 0xe4, 0x99, 0x6c, 0x60, 0x69, 0x10, 0x26, 0x37, 0x24, 0xcf, 0x12, 0x81, 0xae,
+	0x73, 0xf4, 0x0c, 0xc0, 0xd2, 0x26, 0xa1, 0xf4, 0xbd, 0x58, 0x4c, 0x7e, 0x5a, 0x58, 0xae, 0x9c,
+	0x9f, 0xd7, 0xa0, 0x81, 0xc9, 0xd3, 0x9d, 0xd0, 0x4f, 0xd0, 0x2b, 0x69, 0x9e, 0x2b, 0x19, 0xf3,
+	0x30, 0x66, 0x26, 0x0e, 0xdf, 0x27, 0x41, 0x5f, 0x1b, 0x2d, 0x2f, 0x79, 0xb3, 0xbe, 0x47, 0xbb,
+	0xbc, 0x51, 0xd1, 0x8a, 0x3e, 0xc6, 0x32, 0x43, 0x62, 0x9d, 0x12, 0xed, 0x02, 0x52, 0xcb, 0xd9,
+	0x23, 0x5e, 0x4e, 0xa7, 0x2f, 0xe7, 0xf9, 0x67, 0x34, 0xd8, 0xc2, 0x87, 0xbe, 0x09, 0x17, 0x0c,
+	0x68, 0xfa, 0xa4, 0xcd, 0x4c, 0xac, 0xb3, 0x12, 0x53, 0x3a, 0x5c, 0xc0, 0x9f, 0xd3, 0x93, 0x3f,
+	0x5e, 0xdb, 0xb5, 0xb9, 0x7a, 0x72, 0x1a, 0x6c, 0xe1, 0x43, 0x5d, 0x40, 0x46, 0xde, 0xe5, 0x47,
+	0x57, 0x4e, 0xee, 0x34, 0x1d, 0xf7, 0x72, 0x34, 0xdc, 0xe8, 0x16, 0x5e, 0xf4, 0x0e, 0x9c, 0xc9,
+	0x40, 0xd5, 0x58, 0x6f, 0xbd, 0x50, 0xde, 0x80, 0x4b, 0xcb, 0xf1, 0xb1, 0x8c, 0x25, 0xa6, 0x8e,
+	0x22, 0xfd, 0x9e, 0xd3, 0x05, 0x28, 0x1d, 0x04, 0x05, 0x7a, 0x51, 0x8e, 0x41, 0x5b, 0x1b, 0x15,
+	0xad, 0x3b, 0x86, 0xc9, 0xd3, 0x2e, 0x91, 0x84, 0x1c, 0x8f, 0x3e, 0x84, 0x55, 0x4b, 0x4e, 0x9e,
+	0xb6, 0x81, 0xf3, 0x39, 0x9a, 0x01, 0x2d, 0x54, 0x5c, 0x8e, 0x95, 0xdf, 0xf9, 0x43, 0x49, 0x9c,
+	0x7b, 0x11, 0x85, 0xf3, 0xce, 0xfd, 0x19, 0xa8, 0x24, 0x41, 0x2c, 0x4f, 0x3d, 0xfb, 0x99, 0x1b,
+	0x0b, 0x57, 0x2c, 0x63, 0xe1, 0xb4, 0x47, 0x5c, 0xd5, 0x86, 0x86, 0x8c, 0xd3, 0x98, 0x9f, 0x8a,
+	0x97, 0xbd, 0x01, 0x63, 0x67, 0xf7, 0x09, 0x99, 0xf2, 0xa7, 0xba, 0x98, 0x27, 0xa8, 0x25, 0xd7,
+	0x92, 0x75, 0x7e, 0x19, 0xaa, 0x21, 0xb5, 0x94, 0x6b, 0xd6, 0xf6, 0x5c, 0x31, 0x4e, 0xd8, 0x31,
+	0xbf, 0x69, 0x1d, 0x40, 0x14, 0x48, 0x8f, 0x66, 0xe5, 0x8f, 0x06, 0x51, 0x45, 0xd0, 0xa3, 0x4c,
+	0x11, 0xc4, 0x71, 0x59, 0x7b, 0xd4, 0xe6, 0xd9, 0xa3, 0xae, 0x0f, 0x51, 0x7f, 0x5d, 0x86, 0xd3,
+	0xa9, 0xd6, 0x9d, 0x49, 0x9c, 0x44, 0xa3, 0xb9, 0x7a, 0x6f, 0x66, 0x6a, 0xa8, 0x33, 0xd9, 0xdc,
+	0x92, 0xe6, 0x95, 0xff, 0xe9, 0x31, 0x7b, 0x3a, 0xdf, 0x6e, 0x2c, 0x98, 0x6f, 0x3b, 0xff, 0x64,
+	0x77, 0x87, 0x91, 0x25, 0xe7, 0xda, 0x6f, 0x23, 0xcd, 0xb6, 0xda, 0xb3, 0x4e, 0x07, 0x31, 0x6e,
+	0x66, 0x18, 0x2d, 0x0a, 0xd2, 0x75, 0xbe, 0x29, 0x5d, 0x9d, 0xd7, 0x94, 0xee, 0xa4, 0x4d, 0xe9,
+	0x9a, 0xd6, 0x94, 0xee, 0x14, 0x35, 0xa5, 0xeb, 0x0b, 0x9b, 0xd2, 0x8d, 0x7c, 0x53, 0xfa, 0xd3,
+	0x32, 0x9c, 0xb7, 0x26, 0xf9, 0x13, 0x5a, 0xc1, 0x6c, 0xa4, 0xee, 0xa5, 0x4d, 0xd2, 0x0c, 0x54,
+	0x6b, 0xa4, 0x32, 0x9a, 0xaa, 0xd1, 0x48, 0x65, 0xf8, 0x6c, 0x1b, 0xb9, 0xb6, 0xb0, 0x8d, 0x5c,
+	0xcf, 0xf5, 0x4c, 0xf5, 0x86, 0x6f, 0x23, 0xd3, 0xf0, 0x95, 0x8d, 0xda, 0xbd, 0xb4, 0x51, 0xdb,
+	0x9c, 0x35, 0x6a, 0x53, 0xa0, 0xf3, 0x71, 0x19, 0x2e, 0x16, 0x5e, 0x5c, 0xff, 0x25, 0x96, 0x32,
+	0xad, 0x50, 0x9b, 0x6b, 0x85, 0xfa, 0x22, 0x2b, 0x34, 0x6c, 0x56, 0xf8, 0x93, 0x25, 0x56, 0xc4,
+	0xa5, 0x7a, 0x32, 0x0b, 0xd8, 0x1a, 0xd0, 0x95, 0x82, 0x06, 0x74, 0x1b, 0x1a, 0xb2, 0xe1, 0x2c,
+	0x4d, 0xa0, 0x96, 0xc7, 0x6b, 0xfc, 0xce, 0x6b, 0x56, 0xd7, 0x4f, 0xde, 0xac, 0x6e, 0x1c, 0xad,
+	0x59, 0xed, 0xfc, 0xad, 0x04, 0xe7, 0xf3, 0xc5, 0x41, 0x6f, 0x41, 0x0e, 0x4a, 0x7b, 0xd4, 0xe5,
+	0xe2, 0x1e, 0x75, 0x25, 0xd7, 0xa3, 0xbe, 0x0b, 0x35, 0x76, 0xdf, 0xa8, 0x97, 0xee, 0xb5, 0x79,
+	0xb5, 0xce, 0xd6, 0x07, 0xf1, 0xec, 0xa5, 0xc8, 0xb9, 0xd6, 0x6e, 0x03, 0xcc, 0x80, 0x96, 0x77,
+	0xc7, 0xaa, 0xfe, 0xee, 0x68, 0xe9, 0x4f, 0x8b, 0x6f, 0xc0, 0x45, 0xeb, 0x26, 0x0b, 0xef, 0x58,
+	0xf3, 0x46, 0x2d, 0x67, 0x6f, 0x54, 0xe7, 0xb7, 0x65, 0x38, 0x97, 0x97, 0x3c, 0xff, 0x0d, 0xd2,
+	0xe6, 0xcd, 0x01, 0x4d, 0xa0, 0x5a, 0xce, 0xac, 0x5a, 0x29, 0xb6, 0x6a, 0x35, 0x67, 0xd5, 0x3b,
+	0xca, 0xaa, 0xa2, 0x1e, 0xbd, 0x5a, 0x5c, 0xf1, 0xe5, 0x6d, 0x3a, 0x1b, 0x1b, 0xd4, 0x8f, 0x36,
+	0x36, 0x68, 0xcc, 0x1b, 0x1b, 0x9c, 0xc0, 0x43, 0x9f, 0x96, 0xe0, 0xb9, 0x82, 0x1a, 0xf5, 0x24,
+	0x0e, 0xfa, 0x8c, 0xc7, 0x22, 0x7f, 0x2f, 0x43, 0x53, 0x15, 0xc2, 0x73, 0xd5, 0xba, 0x0c, 0x2d,
+	0x5e, 0x32, 0x68, 0x5a, 0xcd, 0x00, 0xea, 0x25, 0x58, 0x99, 0xbd, 0x04, 0x0b, 0x5b, 0xdd, 0xd5,
+	0x63, 0xb5, 0xba, 0x6b, 0x47, 0x6d, 0x75, 0xd7, 0x0b, 0x5a, 0xdd, 0xeb, 0x46, 0x57, 0x58, 0xd4,
+	0xa7, 0x1a, 0xc4, 0xd6, 0x0a, 0x6f, 0x2e, 0x6e, 0x85, 0xb7, 0x16, 0xb5, 0xc2, 0xc1, 0xd6, 0x0a,
+	0xff, 0x55, 0x19, 0x96, 0x95, 0xb1, 0x4f, 0x1c, 0x07, 0x86, 0x43, 0x2a, 0x05, 0x0e, 0xa9, 0xce,
+	0x1c, 0xf2, 0xd9, 0x9b, 0xd6, 0x62, 0xba, 0xc6, 0x62, 0xd3, 0x35, 0x17, 0x99, 0xae, 0x65, 0x33,
+	0xdd, 0xc7, 0x15, 0xfe, 0x94, 0x5f, 0x58, 0x4a, 0xea, 0x85, 0x62, 0x39, 0x53, 0x28, 0xe6, 0x83,
+	0x54, 0x1f, 0x18, 0x55, 0x33, 0x03, 0xa3, 0xc2, 0x00, 0xae, 0x1d, 0x2b, 0x80, 0xeb, 0x47, 0xb5,
+	0x72, 0xe3, 0x48, 0x01, 0xdc, 0x3c, 0x4a, 0x00, 0xb7, 0x16, 0x7b, 0x01, 0x16, 0x79, 0x61, 0xc9,
+	0xe6, 0x85, 0x3f, 0x97, 0x61, 0x49, 0x7b, 0x0c, 0x9f, 0x28, 0x7e, 0xe7, 0x95, 0xf4, 0xf9, 0xe8,
+	0xd5, 0x3d, 0x55, 0xcb, 0x78, 0xea, 0xb3, 0xb7, 0xf9, 0x7f, 0x32, 0x29, 0xfc, 0xa5, 0xcc, 0xef,
+	0x09, 0xeb, 0xf0, 0x6b, 0x41, 0xa4, 0xb3, 0x9c, 0xae, 0x47, 0xba, 0x5a, 0x5b, 0x22, 0xfd, 0x0a,
+	0xac, 0xa4, 0x31, 0xa3, 0xdd, 0xba, 0x26, 0x90, 0xdd, 0x22, 0x29, 0x20, 0x9d, 0xbb, 0x70, 0x72,
+	0x61, 0xf3, 0x02, 0x2c, 0x2b, 0xfc, 0x32, 0x98, 0x74, 0x1f, 0xe1, 0x8b, 0x22, 0xb4, 0x36, 0xcc,
+	0x6b, 0x18, 0xc3, 0x3c, 0x7d, 0xf0, 0xd2, 0x5c, 0x30, 0x78, 0x69, 0x59, 0x06, 0x2f, 0x7f, 0x2d,
+	0xc1, 0xa5, 0x39, 0xed, 0x97, 0x93, 0x46, 0x6e, 0x6a, 0xf9, 0x8a, 0xdd, 0xf2, 0x55, 0xdb, 0xb8,
+	0xb2, 0x56, 0xf8, 0x85, 0xf5, 0x05, 0x5f, 0xd8, 0xc8, 0x7f, 0xe1, 0xf6, 0x0e, 0x6c, 0x7a, 0xe1,
+	0x96, 0x7b, 0x40, 0xa8, 0xef, 0x6d, 0xf5, 0xdd, 0x03, 0xea, 0x7b, 0xaf, 0x8a, 0x2e, 0xc3, 0x16,
+	0x9b, 0x5e, 0x8b, 0xbf, 0xdd, 0x88, 0xca, 0x69, 0x7b, 0x49, 0x34, 0x71, 0xba, 0x0c, 0xf4, 0xad,
+	0x33, 0xd9, 0x3f, 0xea, 0x1c, 0xd4, 0xf9, 0xe2, 0xe6, 0xbf, 0x07, 0x00, 0x12, 0xe4, 0x37, 0xa1,
+	0xc3, 0x33, 0x00, 0x00,
 }
@@ -430,6 +430,149 @@ func (m *ResultConfigList) GetErrMsg() string {
 	return ""
 }
 
+type ResultRaftStatus struct {
+	Code                 Code              `protobuf:"varint,1,opt,name=code,proto3,enum=chain.Code" json:"code,omitempty"`
+	Id                   string            `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Url                  string            `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Role                 string            `protobuf:"bytes,4,opt,name=role,proto3" json:"role,omitempty"`
+	Term                 int32             `protobuf:"varint,5,opt,name=term,proto3" json:"term,omitempty"`
+	LeaderId             string            `protobuf:"bytes,6,opt,name=leaderId,proto3" json:"leaderId,omitempty"`
+	LeaderUrl            string            `protobuf:"bytes,7,opt,name=leaderUrl,proto3" json:"leaderUrl,omitempty"`
+	Version              int32             `protobuf:"varint,8,opt,name=version,proto3" json:"version,omitempty"`
+	CommitIndex          int32             `protobuf:"varint,9,opt,name=commitIndex,proto3" json:"commitIndex,omitempty"`
+	LastLogIndex         int32             `protobuf:"varint,10,opt,name=lastLogIndex,proto3" json:"lastLogIndex,omitempty"`
+	LastHeartbeat        int64             `protobuf:"varint,11,opt,name=lastHeartbeat,proto3" json:"lastHeartbeat,omitempty"`
+	Member               bool              `protobuf:"varint,12,opt,name=member,proto3" json:"member,omitempty"`
+	Peers                []*RaftPeerStatus `protobuf:"bytes,13,rep,name=peers,proto3" json:"peers,omitempty"`
+	ErrMsg               string            `protobuf:"bytes,14,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ResultRaftStatus) Reset()         { *m = ResultRaftStatus{} }
+func (m *ResultRaftStatus) String() string { return proto.CompactTextString(m) }
+func (*ResultRaftStatus) ProtoMessage()    {}
+func (*ResultRaftStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd306958b311fddd, []int{7}
+}
+
+func (m *ResultRaftStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResultRaftStatus.Unmarshal(m, b)
+}
+func (m *ResultRaftStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResultRaftStatus.Marshal(b, m, deterministic)
+}
+func (m *ResultRaftStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResultRaftStatus.Merge(m, src)
+}
+func (m *ResultRaftStatus) XXX_Size() int {
+	return xxx_messageInfo_ResultRaftStatus.Size(m)
+}
+func (m *ResultRaftStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResultRaftStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResultRaftStatus proto.InternalMessageInfo
+
+func (m *ResultRaftStatus) GetCode() Code {
+	if m != nil {
+		return m.Code
+	}
+	return Code_Success
+}
+
+func (m *ResultRaftStatus) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ResultRaftStatus) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *ResultRaftStatus) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *ResultRaftStatus) GetTerm() int32 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+func (m *ResultRaftStatus) GetLeaderId() string {
+	if m != nil {
+		return m.LeaderId
+	}
+	return ""
+}
+
+func (m *ResultRaftStatus) GetLeaderUrl() string {
+	if m != nil {
+		return m.LeaderUrl
+	}
+	return ""
+}
+
+func (m *ResultRaftStatus) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *ResultRaftStatus) GetCommitIndex() int32 {
+	if m != nil {
+		return m.CommitIndex
+	}
+	return 0
+}
+
+func (m *ResultRaftStatus) GetLastLogIndex() int32 {
+	if m != nil {
+		return m.LastLogIndex
+	}
+	return 0
+}
+
+func (m *ResultRaftStatus) GetLastHeartbeat() int64 {
+	if m != nil {
+		return m.LastHeartbeat
+	}
+	return 0
+}
+
+func (m *ResultRaftStatus) GetMember() bool {
+	if m != nil {
+		return m.Member
+	}
+	return false
+}
+
+func (m *ResultRaftStatus) GetPeers() []*RaftPeerStatus {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+func (m *ResultRaftStatus) GetErrMsg() string {
+	if m != nil {
+		return m.ErrMsg
+	}
+	return ""
+}
+
 type ResultUpload struct {
 	Code                 Code     `protobuf:"varint,1,opt,name=code,proto3,enum=chain.Code" json:"code,omitempty"`
 	Source               string   `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
@@ -444,7 +587,7 @@ func (m *ResultUpload) Reset()         { *m = ResultUpload{} }
 func (m *ResultUpload) String() string { return proto.CompactTextString(m) }
 func (*ResultUpload) ProtoMessage()    {}
 func (*ResultUpload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd306958b311fddd, []int{7}
+	return fileDescriptor_dd306958b311fddd, []int{8}
 }
 
 func (m *ResultUpload) XXX_Unmarshal(b []byte) error {
@@ -506,7 +649,7 @@ func (m *ResultPeers) Reset()         { *m = ResultPeers{} }
 func (m *ResultPeers) String() string { return proto.CompactTextString(m) }
 func (*ResultPeers) ProtoMessage()    {}
 func (*ResultPeers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd306958b311fddd, []int{8}
+	return fileDescriptor_dd306958b311fddd, []int{9}
 }
 
 func (m *ResultPeers) XXX_Unmarshal(b []byte) error {
@@ -561,7 +704,7 @@ func (m *ResultCAInfo) Reset()         { *m = ResultCAInfo{} }
 func (m *ResultCAInfo) String() string { return proto.CompactTextString(m) }
 func (*ResultCAInfo) ProtoMessage()    {}
 func (*ResultCAInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd306958b311fddd, []int{9}
+	return fileDescriptor_dd306958b311fddd, []int{10}
 }
 
 func (m *ResultCAInfo) XXX_Unmarshal(b []byte) error {
@@ -616,7 +759,7 @@ func (m *ResultAffiliation) Reset()         { *m = ResultAffiliation{} }
 func (m *ResultAffiliation) String() string { return proto.CompactTextString(m) }
 func (*ResultAffiliation) ProtoMessage()    {}
 func (*ResultAffiliation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd306958b311fddd, []int{10}
+	return fileDescriptor_dd306958b311fddd, []int{11}
 }
 
 func (m *ResultAffiliation) XXX_Unmarshal(b []byte) error {
@@ -671,7 +814,7 @@ func (m *ResultIdentityResponses) Reset()         { *m = ResultIdentityResponses
 func (m *ResultIdentityResponses) String() string { return proto.CompactTextString(m) }
 func (*ResultIdentityResponses) ProtoMessage()    {}
 func (*ResultIdentityResponses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd306958b311fddd, []int{11}
+	return fileDescriptor_dd306958b311fddd, []int{12}
 }
 
 func (m *ResultIdentityResponses) XXX_Unmarshal(b []byte) error {
@@ -726,7 +869,7 @@ func (m *ResultIdentityResponse) Reset()         { *m = ResultIdentityResponse{}
 func (m *ResultIdentityResponse) String() string { return proto.CompactTextString(m) }
 func (*ResultIdentityResponse) ProtoMessage()    {}
 func (*ResultIdentityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd306958b311fddd, []int{12}
+	return fileDescriptor_dd306958b311fddd, []int{13}
 }
 
 func (m *ResultIdentityResponse) XXX_Unmarshal(b []byte) error {
@@ -781,7 +924,7 @@ func (m *ResultSigningIdentityResponse) Reset()         { *m = ResultSigningIden
 func (m *ResultSigningIdentityResponse) String() string { return proto.CompactTextString(m) }
 func (*ResultSigningIdentityResponse) ProtoMessage()    {}
 func (*ResultSigningIdentityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd306958b311fddd, []int{13}
+	return fileDescriptor_dd306958b311fddd, []int{14}
 }
 
 func (m *ResultSigningIdentityResponse) XXX_Unmarshal(b []byte) error {
@@ -836,7 +979,7 @@ func (m *ResultRevocationResponse) Reset()         { *m = ResultRevocationRespon
 func (m *ResultRevocationResponse) String() string { return proto.CompactTextString(m) }
 func (*ResultRevocationResponse) ProtoMessage()    {}
 func (*ResultRevocationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd306958b311fddd, []int{14}
+	return fileDescriptor_dd306958b311fddd, []int{15}
 }
 
 func (m *ResultRevocationResponse) XXX_Unmarshal(b []byte) error {
@@ -878,6 +1021,116 @@ func (m *ResultRevocationResponse) GetErrMsg() string {
 	return ""
 }
 
+type ResultCapabilities struct {
+	Code                 Code          `protobuf:"varint,1,opt,name=code,proto3,enum=chain.Code" json:"code,omitempty"`
+	Capabilities         *Capabilities `protobuf:"bytes,2,opt,name=capabilities,proto3" json:"capabilities,omitempty"`
+	ErrMsg               string        `protobuf:"bytes,3,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ResultCapabilities) Reset()         { *m = ResultCapabilities{} }
+func (m *ResultCapabilities) String() string { return proto.CompactTextString(m) }
+func (*ResultCapabilities) ProtoMessage()    {}
+func (*ResultCapabilities) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd306958b311fddd, []int{16}
+}
+
+func (m *ResultCapabilities) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResultCapabilities.Unmarshal(m, b)
+}
+func (m *ResultCapabilities) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResultCapabilities.Marshal(b, m, deterministic)
+}
+func (m *ResultCapabilities) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResultCapabilities.Merge(m, src)
+}
+func (m *ResultCapabilities) XXX_Size() int {
+	return xxx_messageInfo_ResultCapabilities.Size(m)
+}
+func (m *ResultCapabilities) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResultCapabilities.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResultCapabilities proto.InternalMessageInfo
+
+func (m *ResultCapabilities) GetCode() Code {
+	if m != nil {
+		return m.Code
+	}
+	return Code_Success
+}
+
+func (m *ResultCapabilities) GetCapabilities() *Capabilities {
+	if m != nil {
+		return m.Capabilities
+	}
+	return nil
+}
+
+func (m *ResultCapabilities) GetErrMsg() string {
+	if m != nil {
+		return m.ErrMsg
+	}
+	return ""
+}
+
+type ResultACLs struct {
+	Code                 Code              `protobuf:"varint,1,opt,name=code,proto3,enum=chain.Code" json:"code,omitempty"`
+	Acls                 map[string]string `protobuf:"bytes,2,rep,name=acls,proto3" json:"acls,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ErrMsg               string            `protobuf:"bytes,3,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ResultACLs) Reset()         { *m = ResultACLs{} }
+func (m *ResultACLs) String() string { return proto.CompactTextString(m) }
+func (*ResultACLs) ProtoMessage()    {}
+func (*ResultACLs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd306958b311fddd, []int{17}
+}
+
+func (m *ResultACLs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResultACLs.Unmarshal(m, b)
+}
+func (m *ResultACLs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResultACLs.Marshal(b, m, deterministic)
+}
+func (m *ResultACLs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResultACLs.Merge(m, src)
+}
+func (m *ResultACLs) XXX_Size() int {
+	return xxx_messageInfo_ResultACLs.Size(m)
+}
+func (m *ResultACLs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResultACLs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResultACLs proto.InternalMessageInfo
+
+func (m *ResultACLs) GetCode() Code {
+	if m != nil {
+		return m.Code
+	}
+	return Code_Success
+}
+
+func (m *ResultACLs) GetAcls() map[string]string {
+	if m != nil {
+		return m.Acls
+	}
+	return nil
+}
+
+func (m *ResultACLs) GetErrMsg() string {
+	if m != nil {
+		return m.ErrMsg
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("chain.Code", Code_name, Code_value)
 	proto.RegisterType((*Result)(nil), "chain.Result")
@@ -887,6 +1140,7 @@ func init() {
 	proto.RegisterType((*ResultBlock)(nil), "chain.ResultBlock")
 	proto.RegisterType((*ResultConfig)(nil), "chain.ResultConfig")
 	proto.RegisterType((*ResultConfigList)(nil), "chain.ResultConfigList")
+	proto.RegisterType((*ResultRaftStatus)(nil), "chain.ResultRaftStatus")
 	proto.RegisterType((*ResultUpload)(nil), "chain.ResultUpload")
 	proto.RegisterType((*ResultPeers)(nil), "chain.ResultPeers")
 	proto.RegisterType((*ResultCAInfo)(nil), "chain.ResultCAInfo")
@@ -895,48 +1149,69 @@ func init() {
 	proto.RegisterType((*ResultIdentityResponse)(nil), "chain.ResultIdentityResponse")
 	proto.RegisterType((*ResultSigningIdentityResponse)(nil), "chain.ResultSigningIdentityResponse")
 	proto.RegisterType((*ResultRevocationResponse)(nil), "chain.ResultRevocationResponse")
+	proto.RegisterType((*ResultCapabilities)(nil), "chain.ResultCapabilities")
+	proto.RegisterType((*ResultACLs)(nil), "chain.ResultACLs")
+	proto.RegisterMapType((map[string]string)(nil), "chain.ResultACLs.AclsEntry")
 }
 
 func init() { proto.RegisterFile("grpc/proto/chain/result.proto", fileDescriptor_dd306958b311fddd) }
 
 var fileDescriptor_dd306958b311fddd = []byte{
-	// 594 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x5f, 0x8b, 0xd3, 0x40,
-	0x14, 0xc5, 0x8d, 0x9b, 0xad, 0xee, 0xed, 0x2a, 0x35, 0xc8, 0x6e, 0xb7, 0x5a, 0xad, 0x05, 0xb5,
-	0xa8, 0x9b, 0x42, 0xfd, 0x04, 0x6d, 0x17, 0xb5, 0xa0, 0x20, 0xb3, 0x2c, 0x88, 0x6f, 0xe9, 0xe4,
-	0x26, 0x1d, 0x0d, 0x33, 0x61, 0x26, 0x5d, 0x58, 0x10, 0x05, 0x9f, 0xfd, 0xd0, 0x92, 0x99, 0x49,
-	0xb7, 0x7f, 0xcc, 0xa6, 0xf5, 0xa5, 0x34, 0xf7, 0x9e, 0x39, 0xbf, 0x33, 0x33, 0x37, 0x04, 0xda,
-	0xb1, 0x4c, 0x69, 0x3f, 0x95, 0x22, 0x13, 0x7d, 0x3a, 0x0b, 0x18, 0xef, 0x4b, 0x54, 0xf3, 0x24,
-	0xf3, 0x75, 0xc9, 0xdb, 0xd7, 0xb5, 0xd6, 0xc9, 0x86, 0x8a, 0x06, 0x46, 0xd1, 0xea, 0x6c, 0xb6,
-	0xf2, 0x5f, 0x2a, 0x42, 0xb4, 0x8a, 0x4d, 0x44, 0x82, 0x61, 0x8c, 0xb2, 0xb4, 0x4d, 0x05, 0x8f,
-	0x58, 0x6c, 0xdb, 0x8f, 0x36, 0xda, 0x29, 0x16, 0x6b, 0xbb, 0x17, 0x50, 0x23, 0x3a, 0xae, 0xf7,
-	0x14, 0xdc, 0x1c, 0xd9, 0x74, 0x3a, 0x4e, 0xef, 0xfe, 0xa0, 0xee, 0x6b, 0xa9, 0x3f, 0x16, 0x21,
-	0x12, 0xdd, 0xf0, 0x3c, 0x70, 0xc3, 0x20, 0x0b, 0x9a, 0xb7, 0x3b, 0x4e, 0xef, 0x80, 0xe8, 0xff,
-	0xde, 0x11, 0xd4, 0x50, 0xca, 0x4f, 0x2a, 0x6e, 0xee, 0xe9, 0xaa, 0x7d, 0xea, 0x7e, 0x81, 0x03,
-	0x63, 0x3b, 0x94, 0x72, 0x17, 0xe7, 0xbd, 0x4a, 0xe7, 0x6f, 0x70, 0x68, 0x9c, 0xc7, 0xe3, 0x8f,
-	0x4c, 0x6d, 0x11, 0xfb, 0x19, 0xb8, 0x09, 0x53, 0x99, 0x8e, 0x5d, 0x1f, 0xdc, 0x2b, 0x04, 0x7a,
-	0x35, 0xd1, 0xad, 0x52, 0x56, 0x06, 0x0f, 0x2c, 0x6b, 0x16, 0x70, 0x8e, 0xc9, 0x84, 0x47, 0xa2,
-	0x1a, 0xf8, 0x02, 0x5c, 0xc6, 0x23, 0x61, 0x81, 0x5e, 0x21, 0xb8, 0xb6, 0x20, 0xba, 0x7f, 0xc3,
-	0x0e, 0xeb, 0x86, 0x3a, 0x4a, 0x04, 0xfd, 0x5e, 0xcd, 0xeb, 0xc2, 0xfe, 0x34, 0x57, 0x5a, 0xe0,
-	0xa1, 0x55, 0xe8, 0xd5, 0xc4, 0xb4, 0x4a, 0x59, 0x7c, 0x71, 0x9a, 0x7a, 0x62, 0xaa, 0x61, 0xcf,
-	0xa1, 0x66, 0x86, 0x6b, 0xfd, 0x3c, 0x75, 0x91, 0xd8, 0x66, 0x29, 0x8f, 0x41, 0x63, 0x99, 0xb7,
-	0xdd, 0x0d, 0x3e, 0x86, 0x03, 0x63, 0x3b, 0x39, 0x53, 0x76, 0x46, 0xae, 0x0b, 0xa5, 0x28, 0x55,
-	0x6c, 0xed, 0x22, 0x4d, 0x44, 0x10, 0x56, 0x63, 0x8e, 0xa0, 0xa6, 0xc4, 0x5c, 0x52, 0xb4, 0x13,
-	0x6e, 0x9f, 0xf2, 0xe9, 0x4c, 0x83, 0x6c, 0x66, 0xed, 0xf5, 0xff, 0x25, 0xa8, 0xbb, 0x02, 0x4d,
-	0x8b, 0xbb, 0xfb, 0x8c, 0x28, 0x55, 0x35, 0xb3, 0x07, 0x6e, 0xfe, 0x32, 0xea, 0x5d, 0xd5, 0x07,
-	0x0f, 0xad, 0xe0, 0x8c, 0x29, 0x2a, 0x2e, 0x51, 0x5e, 0xe5, 0x2e, 0x44, 0x2b, 0x4a, 0xb7, 0x39,
-	0x5f, 0xdc, 0xe0, 0x70, 0xbb, 0xf1, 0x7c, 0x03, 0xae, 0x44, 0x95, 0xda, 0xfb, 0x6b, 0x5a, 0xc1,
-	0x7b, 0xb4, 0x06, 0x04, 0x55, 0x2a, 0xb8, 0x42, 0xa2, 0x55, 0xa5, 0xd8, 0x1f, 0xc5, 0xab, 0x31,
-	0x8c, 0x22, 0x96, 0xb0, 0x20, 0x63, 0x82, 0x57, 0xb3, 0xfd, 0x15, 0x76, 0xcb, 0x0a, 0x96, 0x2c,
-	0xb6, 0xa4, 0xff, 0x82, 0x63, 0x43, 0x9f, 0x84, 0xc8, 0x33, 0x96, 0x5d, 0x15, 0xeb, 0xb6, 0x38,
-	0xf2, 0xd7, 0x8b, 0x0c, 0xf9, 0x91, 0x1f, 0x5b, 0xc1, 0xba, 0x51, 0x45, 0x80, 0x9f, 0x70, 0xf4,
-	0xef, 0x00, 0xbb, 0xf0, 0x9d, 0xff, 0xe7, 0xff, 0x71, 0xa0, 0x6d, 0x02, 0x9c, 0xb3, 0x98, 0x33,
-	0x1e, 0xef, 0x9e, 0x63, 0xb0, 0x92, 0xe3, 0x89, 0x15, 0x94, 0xd8, 0x55, 0xc4, 0xf9, 0xed, 0x40,
-	0xd3, 0xc4, 0x21, 0x78, 0x29, 0xe8, 0xca, 0x55, 0x56, 0x27, 0x39, 0x5d, 0x49, 0x72, 0x62, 0x05,
-	0x9b, 0x4e, 0x37, 0x87, 0x78, 0xd5, 0x06, 0x37, 0x37, 0xf5, 0xea, 0x70, 0xe7, 0x7c, 0x4e, 0x29,
-	0x2a, 0xd5, 0xb8, 0xe5, 0xdd, 0x05, 0xf7, 0x5d, 0xc0, 0x92, 0x86, 0x33, 0xfa, 0x00, 0x2f, 0x99,
-	0xf0, 0x91, 0x73, 0x21, 0xfc, 0x28, 0x98, 0x4a, 0x46, 0x4f, 0x69, 0xc2, 0x90, 0x67, 0x7e, 0xfe,
-	0x7d, 0x34, 0x9f, 0x43, 0x83, 0x1e, 0x15, 0xef, 0x70, 0x5e, 0xfa, 0xda, 0x58, 0xff, 0x7c, 0x4e,
-	0x6b, 0xfa, 0xe1, 0xed, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x35, 0x0d, 0x0e, 0x52, 0xfa, 0x07,
-	0x00, 0x00,
+	// 890 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x5d, 0x6f, 0x23, 0x35,
+	0x14, 0x65, 0x9a, 0x49, 0xda, 0xdc, 0xa4, 0x55, 0x30, 0x4b, 0x77, 0x36, 0x4b, 0x97, 0x50, 0x01,
+	0x8a, 0x58, 0x36, 0x95, 0xc2, 0xc3, 0x22, 0xde, 0xda, 0x2e, 0x1f, 0x91, 0x8a, 0xb4, 0x72, 0x55,
+	0x09, 0xf1, 0xe6, 0x78, 0x9c, 0xac, 0x59, 0x67, 0x3c, 0xb2, 0x9d, 0x8a, 0x4a, 0x08, 0x24, 0x1e,
+	0x78, 0xe2, 0x17, 0xf0, 0x27, 0xf8, 0x8b, 0xc8, 0x1f, 0x93, 0x66, 0x9a, 0x0e, 0x93, 0xee, 0x4b,
+	0x64, 0xdf, 0x7b, 0x7c, 0xce, 0xf1, 0xf5, 0xb5, 0x33, 0x70, 0x34, 0x57, 0x39, 0x3d, 0xc9, 0x95,
+	0x34, 0xf2, 0x84, 0xbe, 0x21, 0x3c, 0x3b, 0x51, 0x4c, 0x2f, 0x85, 0x19, 0xb9, 0x10, 0x6a, 0xba,
+	0x58, 0xff, 0xc9, 0x06, 0x8a, 0x12, 0x8f, 0xe8, 0x0f, 0x36, 0x53, 0xf6, 0x97, 0xca, 0x94, 0x05,
+	0xc4, 0xa6, 0x84, 0x60, 0xe9, 0x9c, 0xa9, 0xca, 0x34, 0x95, 0xd9, 0x8c, 0xcf, 0x43, 0xfa, 0xe9,
+	0x46, 0x3a, 0x67, 0xab, 0xb5, 0xcf, 0xee, 0x13, 0xcf, 0x32, 0x26, 0x7c, 0xfe, 0xf8, 0x0a, 0x5a,
+	0xd8, 0x6d, 0x07, 0x7d, 0x0c, 0xb1, 0xb5, 0x94, 0x44, 0x83, 0x68, 0x78, 0x30, 0xee, 0x8c, 0x1c,
+	0x7a, 0x74, 0x2e, 0x53, 0x86, 0x5d, 0x02, 0x21, 0x88, 0x53, 0x62, 0x48, 0xb2, 0x33, 0x88, 0x86,
+	0x6d, 0xec, 0xc6, 0xe8, 0x10, 0x5a, 0x4c, 0xa9, 0x1f, 0xf5, 0x3c, 0x69, 0xb8, 0x68, 0x98, 0x1d,
+	0xff, 0x04, 0x6d, 0x4f, 0x7b, 0xaa, 0xd4, 0x43, 0x98, 0x1b, 0xb5, 0xcc, 0xbf, 0x40, 0xd7, 0x33,
+	0x9f, 0x9f, 0x5f, 0x70, 0xbd, 0x85, 0xed, 0x4f, 0x20, 0x16, 0x5c, 0x1b, 0x67, 0xbb, 0x33, 0xde,
+	0x2f, 0x00, 0x6e, 0x35, 0x76, 0xa9, 0x4a, 0x2d, 0x03, 0xef, 0x07, 0x2d, 0x5f, 0xb3, 0x49, 0x36,
+	0x93, 0xf5, 0x82, 0x9f, 0x43, 0xcc, 0xb3, 0x99, 0x0c, 0x82, 0xa8, 0x00, 0xdc, 0x52, 0x60, 0x97,
+	0xff, 0x9f, 0x1d, 0x76, 0xbc, 0xea, 0x99, 0x90, 0xf4, 0x6d, 0xbd, 0xde, 0x31, 0x34, 0xa7, 0x16,
+	0x19, 0x04, 0xbb, 0x01, 0xe1, 0x56, 0x63, 0x9f, 0xaa, 0xd4, 0xca, 0x56, 0xd5, 0x74, 0x1d, 0x55,
+	0x2f, 0xf6, 0x19, 0xb4, 0x7c, 0xf3, 0xdd, 0xad, 0xa7, 0x0b, 0xe2, 0x90, 0xac, 0xd4, 0xe3, 0xd0,
+	0x5b, 0xd7, 0xdb, 0xee, 0x04, 0x3f, 0x82, 0xb6, 0xa7, 0x9d, 0xbc, 0xd2, 0xa1, 0x47, 0x6e, 0x03,
+	0x95, 0x52, 0xff, 0x34, 0x0a, 0x2d, 0x4c, 0x66, 0xe6, 0xd2, 0x10, 0xb3, 0xd4, 0xf5, 0x5a, 0x07,
+	0xb0, 0xc3, 0xd3, 0xd0, 0xe2, 0x3b, 0x3c, 0x45, 0x3d, 0x68, 0x2c, 0x95, 0x08, 0xd4, 0x76, 0x68,
+	0x9b, 0x55, 0x49, 0xc1, 0x92, 0xd8, 0x85, 0xdc, 0xd8, 0xc6, 0x0c, 0x53, 0x8b, 0xa4, 0x39, 0x88,
+	0x86, 0x4d, 0xec, 0xc6, 0xa8, 0x0f, 0x7b, 0x82, 0x91, 0x94, 0xa9, 0x49, 0x9a, 0xb4, 0x1c, 0x76,
+	0x35, 0xb7, 0x3b, 0xf2, 0xe3, 0x2b, 0x25, 0x92, 0x5d, 0x97, 0xbc, 0x0d, 0xa0, 0x04, 0x76, 0xaf,
+	0x99, 0xd2, 0x5c, 0x66, 0xc9, 0x9e, 0x23, 0x2c, 0xa6, 0x68, 0x00, 0x1d, 0x2a, 0x17, 0x0b, 0x6e,
+	0x26, 0x59, 0xca, 0x7e, 0x4d, 0xda, 0x2e, 0xbb, 0x1e, 0x42, 0xc7, 0xd0, 0x15, 0x44, 0x9b, 0x0b,
+	0x39, 0xf7, 0x10, 0x70, 0x90, 0x52, 0x0c, 0x7d, 0x0a, 0xfb, 0x76, 0xfe, 0x03, 0x23, 0xca, 0x4c,
+	0x19, 0x31, 0x49, 0x67, 0x10, 0x0d, 0x1b, 0xb8, 0x1c, 0xb4, 0x75, 0x5d, 0xb0, 0xc5, 0x94, 0xa9,
+	0xa4, 0x3b, 0x88, 0x86, 0x7b, 0x38, 0xcc, 0xd0, 0x73, 0x68, 0xda, 0xf7, 0x45, 0x27, 0xfb, 0x83,
+	0xc6, 0xb0, 0x33, 0xfe, 0x30, 0xd4, 0xd0, 0x16, 0xf9, 0x35, 0x63, 0xca, 0x17, 0x1a, 0x7b, 0xcc,
+	0xda, 0xe1, 0x1c, 0x94, 0x0e, 0x47, 0x17, 0x7d, 0x77, 0x95, 0x0b, 0x49, 0xd2, 0xfa, 0x73, 0x39,
+	0x84, 0x96, 0x96, 0x4b, 0x45, 0x59, 0x38, 0x9b, 0x30, 0xb3, 0x95, 0xcf, 0x89, 0x79, 0x13, 0x0e,
+	0xc8, 0x8d, 0xd7, 0x44, 0xe3, 0x92, 0x68, 0x5e, 0x5c, 0xac, 0xd7, 0xce, 0x5b, 0xad, 0xe6, 0x10,
+	0x62, 0xbb, 0x0b, 0xd7, 0x72, 0x9d, 0xf1, 0xa3, 0x00, 0x78, 0xc5, 0x35, 0x95, 0xd7, 0x4c, 0xdd,
+	0x58, 0x16, 0xec, 0x10, 0x95, 0x3d, 0xb8, 0x5c, 0x5d, 0xaf, 0xd3, 0xed, 0xde, 0x8e, 0x2f, 0x21,
+	0x56, 0x4c, 0xe7, 0xe1, 0x72, 0x25, 0x01, 0xf0, 0x3d, 0x0b, 0x04, 0x98, 0xe9, 0x5c, 0x66, 0x9a,
+	0x61, 0x87, 0xaa, 0x94, 0xfd, 0xad, 0x78, 0xb7, 0x4e, 0x67, 0x33, 0x2e, 0x38, 0x31, 0xb6, 0x77,
+	0x6a, 0xb5, 0x47, 0x25, 0xed, 0x7e, 0x00, 0xac, 0x51, 0x6c, 0xa9, 0xfe, 0x07, 0x3c, 0xf6, 0xea,
+	0x93, 0x94, 0x65, 0x86, 0x9b, 0x9b, 0x62, 0xdd, 0x16, 0x25, 0x7f, 0xbe, 0xf2, 0x60, 0x4b, 0xfe,
+	0x38, 0x00, 0xee, 0x12, 0xd5, 0x18, 0xf8, 0x1d, 0x0e, 0xef, 0x37, 0xf0, 0x10, 0xfd, 0xe8, 0xdd,
+	0xf5, 0xff, 0x8e, 0xe0, 0xc8, 0x1b, 0xb8, 0xe4, 0xf3, 0x8c, 0x67, 0xf3, 0x87, 0xfb, 0x18, 0x97,
+	0x7c, 0x3c, 0x0b, 0x80, 0x0a, 0xba, 0x1a, 0x3b, 0x7f, 0x46, 0x90, 0x84, 0x87, 0x90, 0x5d, 0x4b,
+	0x5a, 0x3a, 0xca, 0x7a, 0x27, 0x2f, 0x4a, 0x4e, 0x9e, 0x14, 0xb7, 0x7d, 0x83, 0xa9, 0xc6, 0xc4,
+	0x5f, 0x11, 0xa0, 0x70, 0x15, 0x48, 0x4e, 0xa6, 0x5c, 0x70, 0xc3, 0xb7, 0x69, 0x88, 0x97, 0xd0,
+	0xa5, 0x6b, 0x0b, 0x82, 0x8d, 0x0f, 0x0a, 0xe0, 0x5a, 0x0a, 0x97, 0x80, 0x95, 0x46, 0xfe, 0x8d,
+	0x00, 0xc2, 0xe5, 0x38, 0xbf, 0xd8, 0xc2, 0xc0, 0x09, 0xc4, 0x84, 0x0a, 0x1d, 0x3a, 0xf2, 0xe9,
+	0x6a, 0xff, 0x05, 0xc3, 0xe8, 0x94, 0x0a, 0xfd, 0x6d, 0x66, 0xd4, 0x0d, 0x76, 0xc0, 0x2a, 0xe1,
+	0xfe, 0x4b, 0x68, 0xaf, 0xa0, 0xf6, 0x6f, 0xe5, 0x2d, 0xbb, 0x71, 0xaa, 0x6d, 0x6c, 0x87, 0xe8,
+	0x11, 0x34, 0xaf, 0x89, 0x58, 0x16, 0xef, 0x9b, 0x9f, 0x7c, 0xb3, 0xf3, 0x75, 0xf4, 0xc5, 0x11,
+	0xc4, 0xd6, 0x0f, 0xea, 0xc0, 0xee, 0xe5, 0x92, 0x52, 0xa6, 0x75, 0xef, 0x3d, 0xb4, 0x07, 0xf1,
+	0x77, 0x84, 0x8b, 0x5e, 0x74, 0x36, 0x81, 0x21, 0xcd, 0x46, 0x64, 0xca, 0x14, 0xa7, 0xa3, 0x19,
+	0x99, 0x2a, 0x4e, 0x5f, 0x50, 0xc1, 0x59, 0x66, 0x46, 0xf6, 0xdb, 0xcf, 0x7f, 0xe7, 0x79, 0xdb,
+	0x67, 0xc5, 0xfb, 0x67, 0x43, 0x3f, 0xf7, 0xee, 0x7e, 0x1a, 0x4e, 0x5b, 0x6e, 0xf2, 0xd5, 0x7f,
+	0x03, 0x00, 0xb0, 0x74, 0xfb, 0x52, 0xf3, 0x0a, 0x00, 0x00,
 }
@@ -25,70 +25,75 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 func init() { proto.RegisterFile("grpc/proto/chain/server.proto", fileDescriptor_3248a815c2f630c6) }
 
 var fileDescriptor_3248a815c2f630c6 = []byte{
-	// 994 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xdd, 0x6e, 0xdc, 0x44,
-	0x14, 0xde, 0x88, 0x10, 0x92, 0x93, 0x64, 0xbb, 0x4c, 0x68, 0x92, 0x3a, 0x4d, 0x5b, 0x59, 0x95,
-	0xa8, 0x68, 0xbb, 0x2b, 0x2d, 0x08, 0x81, 0x44, 0x2e, 0x76, 0x4d, 0x9b, 0x2e, 0xa4, 0x55, 0xba,
-	0x21, 0x5c, 0xf4, 0x02, 0xd5, 0xb5, 0xcf, 0x6e, 0x46, 0x75, 0x67, 0xb6, 0xb6, 0x1b, 0xb1, 0xf7,
-	0xbc, 0x06, 0x6f, 0xc1, 0xc3, 0xc0, 0x43, 0xf0, 0x0e, 0x68, 0x7e, 0xec, 0x9d, 0xf1, 0xd8, 0x9b,
-	0x94, 0x4b, 0x7f, 0x7f, 0x73, 0xce, 0xb1, 0x67, 0x6c, 0xc3, 0xe1, 0x34, 0x9d, 0x45, 0xbd, 0x59,
-	0xca, 0x73, 0xde, 0x8b, 0x2e, 0x42, 0xca, 0x7a, 0x19, 0xa6, 0x97, 0x98, 0x76, 0x25, 0x44, 0x3e,
-	0x95, 0x98, 0xe7, 0xaa, 0x52, 0xcc, 0x3e, 0x24, 0xb9, 0x52, 0x79, 0xb7, 0x1c, 0x3a, 0x0a, 0x35,
-	0x75, 0xc7, 0xa5, 0x2e, 0x42, 0xc6, 0x30, 0xd1, 0xfc, 0xbd, 0x3a, 0x9e, 0xb2, 0x88, 0xc7, 0xa8,
-	0x15, 0x07, 0x8e, 0x62, 0x86, 0x45, 0x7d, 0x35, 0x85, 0x25, 0x18, 0x4f, 0x97, 0xd0, 0x11, 0x67,
-	0x13, 0x3a, 0x55, 0x74, 0xff, 0xaf, 0x2d, 0x58, 0x3f, 0x91, 0xfa, 0x60, 0x40, 0x7a, 0xb0, 0x3a,
-	0x62, 0x13, 0x4e, 0x3a, 0x5d, 0xa9, 0xec, 0x8e, 0xf1, 0x7d, 0x10, 0x0a, 0xc4, 0xdb, 0x29, 0x11,
-	0xd1, 0x73, 0x30, 0x10, 0xa0, 0xdf, 0x22, 0x0f, 0x61, 0xed, 0x09, 0x4b, 0x79, 0x92, 0x98, 0x16,
-	0x85, 0x78, 0xdb, 0x96, 0xc5, 0x6f, 0x91, 0x1e, 0xac, 0x8f, 0x11, 0x95, 0x9c, 0x2c, 0xe4, 0x05,
-	0xd6, 0x60, 0x98, 0xd2, 0x2c, 0xc7, 0xd4, 0x36, 0x28, 0xcc, 0x35, 0x3c, 0x85, 0xf6, 0x20, 0x8e,
-	0x07, 0x93, 0x09, 0x4d, 0x68, 0x98, 0x53, 0xce, 0xc8, 0xfe, 0xc2, 0x66, 0x33, 0xde, 0xbe, 0x65,
-	0x36, 0x18, 0xbf, 0x45, 0x4e, 0xe0, 0xf3, 0x31, 0xbe, 0xe3, 0x97, 0x68, 0x46, 0x1d, 0x98, 0x15,
-	0x54, 0xc8, 0xab, 0xd2, 0x9e, 0xf3, 0x98, 0x4e, 0xe6, 0x0d, 0x69, 0x0e, 0xb9, 0x34, 0xed, 0x05,
-	0x90, 0x63, 0xcc, 0x07, 0x49, 0x62, 0xc0, 0x19, 0xb9, 0xbd, 0x88, 0x73, 0xd9, 0xa5, 0x79, 0xbf,
-	0x81, 0xe7, 0x3a, 0x86, 0xf3, 0x20, 0x7c, 0x11, 0xbe, 0x43, 0x72, 0x7f, 0x59, 0x6e, 0xa1, 0x5a,
-	0x9a, 0xff, 0x14, 0xda, 0xc2, 0x59, 0x7f, 0x4f, 0x6c, 0x66, 0x69, 0xce, 0xaf, 0xb0, 0x6b, 0xab,
-	0xcb, 0x1a, 0xef, 0x35, 0xe5, 0x5d, 0xab, 0xbe, 0x53, 0xe8, 0xa8, 0xce, 0x46, 0x31, 0xb2, 0x9c,
-	0xe6, 0x14, 0x33, 0xe2, 0x55, 0xbb, 0x5e, 0x70, 0xde, 0x1d, 0x2b, 0x4b, 0x13, 0xf3, 0x31, 0x66,
-	0x33, 0xce, 0x32, 0xcc, 0xfc, 0x16, 0x79, 0x0d, 0xfb, 0x55, 0x57, 0x59, 0xab, 0xdf, 0x9c, 0x5c,
-	0x56, 0x7b, 0xf5, 0x0a, 0x3f, 0x43, 0x3b, 0x48, 0x31, 0xcc, 0xb1, 0x20, 0xcd, 0x99, 0xda, 0x8c,
-	0x77, 0xb8, 0x34, 0x4d, 0x85, 0xa9, 0x27, 0xb0, 0x2e, 0xcc, 0x66, 0xae, 0x0e, 0x7b, 0x02, 0x9b,
-	0xc7, 0x58, 0x12, 0xe4, 0xa6, 0xd5, 0xee, 0xf5, 0x63, 0xce, 0x61, 0xc7, 0xd0, 0x97, 0xd3, 0x3b,
-	0xac, 0x8d, 0x2b, 0x07, 0x77, 0x9d, 0x56, 0xd5, 0xd6, 0xad, 0x6b, 0xd5, 0x66, 0xae, 0x0e, 0x7b,
-	0x0d, 0x37, 0xd5, 0xa8, 0xcf, 0xe8, 0x94, 0x51, 0x36, 0x2d, 0x33, 0xef, 0x56, 0xef, 0x45, 0x45,
-	0xe0, 0xdd, 0xb7, 0xa2, 0x2b, 0xac, 0xb1, 0xc2, 0x2b, 0xb9, 0xd5, 0xab, 0xf1, 0xf6, 0x56, 0xff,
-	0xbf, 0xd9, 0x47, 0xb0, 0x36, 0xc6, 0x4b, 0xfe, 0x16, 0xcd, 0x93, 0x5b, 0x21, 0xde, 0x5d, 0x2b,
-	0x43, 0x80, 0x91, 0xdc, 0x2d, 0x0b, 0x7b, 0xff, 0xcf, 0x15, 0xd8, 0xd6, 0xaf, 0x0d, 0xf5, 0x2e,
-	0x23, 0x3d, 0x58, 0x53, 0xdd, 0x92, 0x2f, 0xb4, 0x5d, 0x33, 0x0a, 0x75, 0x0f, 0xeb, 0x87, 0xb0,
-	0xfa, 0x13, 0xa7, 0xac, 0x3c, 0xd9, 0xb5, 0x5c, 0x60, 0xae, 0xb8, 0x0b, 0xab, 0x27, 0x34, 0xcb,
-	0xab, 0x62, 0x81, 0x79, 0x1d, 0x7b, 0x77, 0xa7, 0xa9, 0xdf, 0xea, 0xff, 0xf3, 0x09, 0xdc, 0x28,
-	0xeb, 0xa3, 0x2c, 0xe0, 0x31, 0x92, 0x3e, 0xac, 0x9f, 0xcf, 0x12, 0x1e, 0xc6, 0x41, 0x40, 0x8a,
-	0x05, 0x14, 0x50, 0x79, 0xbd, 0x29, 0xd0, 0x6f, 0x3d, 0x58, 0x21, 0x8f, 0x60, 0x63, 0xc4, 0xb2,
-	0x3c, 0x4c, 0x92, 0x20, 0x20, 0x6d, 0xad, 0xd2, 0x88, 0x5b, 0xe5, 0xb7, 0xb0, 0xa9, 0x39, 0x14,
-	0x8b, 0x74, 0x6c, 0x3d, 0x56, 0xd7, 0x09, 0x02, 0x51, 0xbf, 0xdf, 0x22, 0xdf, 0xc0, 0xb6, 0xd4,
-	0xb0, 0x9c, 0x86, 0x39, 0x06, 0x41, 0xd9, 0xa6, 0x81, 0xba, 0xab, 0xfd, 0x00, 0x6d, 0x83, 0x17,
-	0x0b, 0xee, 0xb8, 0xb6, 0xc6, 0x35, 0x1f, 0xc1, 0xc6, 0xf9, 0x6c, 0x9a, 0x86, 0x31, 0x1a, 0x9d,
-	0x69, 0xc4, 0x5d, 0xeb, 0x2b, 0x58, 0x1f, 0x31, 0xf1, 0x70, 0x18, 0xb3, 0x53, 0x80, 0xab, 0x95,
-	0xdd, 0x28, 0xed, 0x20, 0x9b, 0xb3, 0xc8, 0xe8, 0x46, 0xa0, 0x12, 0x73, 0x5d, 0x0f, 0xe0, 0xb3,
-	0x97, 0x1f, 0x30, 0x9d, 0x07, 0x01, 0xd9, 0xd2, 0x9c, 0xbc, 0x76, 0x94, 0xfd, 0x3f, 0x56, 0x00,
-	0xd4, 0xbd, 0x3d, 0x45, 0x4c, 0xc9, 0x77, 0x00, 0x27, 0x3c, 0x0a, 0x13, 0x71, 0x91, 0x95, 0x0f,
-	0xdf, 0x18, 0xdf, 0x2f, 0x50, 0x8f, 0x58, 0x19, 0x12, 0x93, 0x03, 0xdc, 0xd2, 0xcf, 0x91, 0xf2,
-	0xee, 0x1a, 0x1b, 0xd7, 0xc0, 0xeb, 0xdd, 0xfd, 0xbf, 0x57, 0x61, 0x4d, 0x95, 0x41, 0x8e, 0xe0,
-	0x86, 0xac, 0x55, 0x5d, 0xca, 0x4f, 0xa8, 0xf6, 0x22, 0x4b, 0x7e, 0x40, 0xd9, 0xaf, 0x20, 0x1d,
-	0xaf, 0xbf, 0xa2, 0x46, 0xb0, 0x6f, 0xd8, 0x87, 0x09, 0x8f, 0xde, 0x0e, 0xe7, 0xcf, 0x90, 0x4e,
-	0x2f, 0x72, 0xb2, 0xb7, 0xc8, 0xb1, 0x88, 0x4a, 0x51, 0x92, 0x93, 0xe7, 0xef, 0x6e, 0x4d, 0x54,
-	0x98, 0x5d, 0x98, 0x47, 0xb1, 0x01, 0x7f, 0x4c, 0xcc, 0x2f, 0xbf, 0x8f, 0x7e, 0xac, 0x89, 0x11,
-	0x70, 0x63, 0xcc, 0x4e, 0x65, 0x2e, 0x67, 0x33, 0x8c, 0xcc, 0x6f, 0xb9, 0x02, 0x5b, 0x3a, 0x9f,
-	0x97, 0x70, 0xbb, 0x69, 0x3e, 0x32, 0xef, 0xa0, 0x61, 0x46, 0x32, 0xb8, 0xbe, 0xb2, 0xe7, 0xe0,
-	0xd5, 0xcf, 0x49, 0x06, 0xde, 0xaa, 0x9d, 0xd5, 0xc7, 0xc6, 0x89, 0xc1, 0x34, 0xc4, 0x15, 0x54,
-	0x7d, 0x5c, 0xff, 0xdf, 0x15, 0xd8, 0xd2, 0xa7, 0x97, 0xfc, 0x56, 0x27, 0x47, 0x00, 0x62, 0xdb,
-	0xea, 0x2b, 0xe3, 0x19, 0x57, 0x88, 0x3c, 0x06, 0xf7, 0xec, 0x09, 0x96, 0x84, 0xdc, 0x91, 0x1b,
-	0xc7, 0x58, 0xb8, 0x3b, 0x55, 0x77, 0xf5, 0x84, 0x90, 0xa0, 0xdf, 0x22, 0xdf, 0xc3, 0xf6, 0x18,
-	0x23, 0x7e, 0x59, 0x56, 0xb1, 0x57, 0x75, 0x6a, 0xda, 0xdd, 0xcc, 0x8f, 0x01, 0x46, 0x8c, 0x16,
-	0x2b, 0x5a, 0x7b, 0x81, 0xe6, 0x8e, 0x7c, 0xf8, 0x0c, 0xbe, 0xa4, 0xbc, 0x8b, 0x8c, 0x71, 0xde,
-	0x9d, 0x84, 0x6f, 0x52, 0x1a, 0x3d, 0x8e, 0x12, 0x8a, 0x2c, 0xef, 0x8a, 0x9f, 0x17, 0xf5, 0xa7,
-	0xa2, 0x3c, 0xc3, 0xcd, 0x33, 0xf9, 0x6f, 0x76, 0x2a, 0xa0, 0x57, 0x9d, 0xea, 0xbf, 0xcd, 0x9b,
-	0x35, 0x79, 0xf1, 0xf5, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x27, 0x8b, 0x53, 0xd7, 0xd4, 0x0d,
-	0x00, 0x00,
+	// 1087 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xcd, 0x72, 0xdb, 0x36,
+	0x10, 0x56, 0x1a, 0xd7, 0x75, 0xd6, 0xb2, 0x22, 0xc3, 0x8d, 0x7f, 0xe8, 0x38, 0xc9, 0x68, 0x72,
+	0xf0, 0x34, 0x89, 0x34, 0x55, 0x3b, 0x99, 0x76, 0xa6, 0x3e, 0xc8, 0x4c, 0xe2, 0xaa, 0x55, 0x32,
+	0x8e, 0x5c, 0xf7, 0x90, 0x43, 0x27, 0x30, 0xb9, 0x92, 0x31, 0x61, 0x40, 0x99, 0xa4, 0x3d, 0xd5,
+	0xbd, 0xcf, 0xd0, 0x27, 0xe9, 0xc3, 0xb4, 0xa7, 0xbe, 0x4a, 0x07, 0x00, 0x7f, 0x00, 0x82, 0x94,
+	0x9d, 0x1e, 0xf1, 0x7d, 0xfb, 0x7d, 0xd8, 0x5d, 0x70, 0x09, 0x12, 0xf6, 0xa6, 0xd1, 0xcc, 0xeb,
+	0xcd, 0xa2, 0x30, 0x09, 0x7b, 0xde, 0x39, 0x65, 0xbc, 0x17, 0x63, 0x74, 0x85, 0x51, 0x57, 0x42,
+	0xe4, 0x73, 0x89, 0x39, 0x76, 0x54, 0x84, 0xf1, 0x65, 0x90, 0xa8, 0x28, 0x67, 0xc7, 0xa2, 0x3d,
+	0x9a, 0x52, 0x0f, 0x6c, 0xea, 0x9c, 0x72, 0x8e, 0x41, 0xca, 0x3f, 0xaa, 0xe2, 0x19, 0xf7, 0x42,
+	0x1f, 0xd3, 0x88, 0x5d, 0x2b, 0x62, 0x86, 0x59, 0x7e, 0x15, 0x89, 0x05, 0xe8, 0x4f, 0x17, 0xd0,
+	0x5e, 0xc8, 0x27, 0x6c, 0xaa, 0xe8, 0xfe, 0x5f, 0x4d, 0x58, 0x19, 0xc9, 0x78, 0x77, 0x40, 0x7a,
+	0xb0, 0x34, 0xe4, 0x93, 0x90, 0xb4, 0xbb, 0x32, 0xb2, 0x3b, 0xc6, 0x0b, 0x97, 0x0a, 0xc4, 0xd9,
+	0xc8, 0x11, 0x51, 0xb3, 0x3b, 0x10, 0x60, 0xa7, 0x41, 0x9e, 0xc0, 0xf2, 0x4b, 0x1e, 0x85, 0x41,
+	0xa0, 0x4b, 0x14, 0xe2, 0xac, 0x19, 0x92, 0x4e, 0x83, 0xf4, 0x60, 0x65, 0x8c, 0xa8, 0xc2, 0x49,
+	0x11, 0x9e, 0x61, 0x35, 0x82, 0x29, 0x8b, 0x13, 0x8c, 0x4c, 0x81, 0xc2, 0x6c, 0xc1, 0x2b, 0x68,
+	0x0d, 0x7c, 0x7f, 0x30, 0x99, 0xb0, 0x80, 0xd1, 0x84, 0x85, 0x9c, 0x6c, 0x17, 0x32, 0x93, 0x71,
+	0xb6, 0x0d, 0xb1, 0xc6, 0x74, 0x1a, 0x64, 0x04, 0xeb, 0x63, 0xfc, 0x18, 0x5e, 0xa1, 0x6e, 0xb5,
+	0xab, 0x67, 0x50, 0x22, 0xaf, 0x73, 0x7b, 0x1d, 0xfa, 0x6c, 0x32, 0xaf, 0x71, 0xb3, 0xc8, 0x85,
+	0x6e, 0x6f, 0x80, 0x1c, 0x61, 0x32, 0x08, 0x02, 0x0d, 0x8e, 0xc9, 0xfd, 0xc2, 0xce, 0x66, 0x17,
+	0xfa, 0xfd, 0x06, 0x8e, 0xad, 0x38, 0x9c, 0xbb, 0xf4, 0x0d, 0xfd, 0x88, 0xe4, 0xf1, 0x22, 0xdf,
+	0x2c, 0x6a, 0xa1, 0xff, 0x2b, 0x68, 0x1d, 0xa1, 0x8e, 0xe9, 0x67, 0x62, 0x32, 0x0b, 0x7d, 0x7e,
+	0x85, 0x4d, 0x33, 0x3a, 0xcf, 0xf1, 0x51, 0x9d, 0xdf, 0x8d, 0xf2, 0x3b, 0x86, 0xb6, 0xaa, 0x6c,
+	0xe8, 0x23, 0x4f, 0x58, 0xc2, 0x30, 0x26, 0x4e, 0xb9, 0xea, 0x82, 0x73, 0x1e, 0x18, 0x5e, 0x29,
+	0x31, 0x1f, 0x63, 0x3c, 0x0b, 0x79, 0x8c, 0x71, 0xa7, 0x41, 0xde, 0xc3, 0x76, 0x59, 0x95, 0xe7,
+	0xda, 0xa9, 0x77, 0xce, 0xb3, 0xbd, 0x7e, 0x87, 0x9f, 0xa1, 0xe5, 0x46, 0x48, 0x13, 0xcc, 0x48,
+	0xbd, 0xa7, 0x26, 0xe3, 0xec, 0x2d, 0x74, 0x53, 0x66, 0xea, 0x09, 0xac, 0x32, 0x33, 0x99, 0xeb,
+	0xcd, 0x5e, 0xc2, 0xea, 0x11, 0xe6, 0x04, 0xb9, 0x67, 0x94, 0x7b, 0x73, 0x9b, 0x53, 0xd8, 0xd0,
+	0xe2, 0xf3, 0xee, 0xed, 0x55, 0xda, 0xe5, 0x8d, 0xbb, 0x49, 0xa9, 0x6a, 0x74, 0xab, 0x4a, 0x35,
+	0x99, 0xeb, 0xcd, 0xde, 0xc3, 0x3d, 0xd5, 0xea, 0x13, 0x36, 0xe5, 0x8c, 0x4f, 0x73, 0xcf, 0x87,
+	0xe5, 0xb3, 0x28, 0x05, 0x38, 0x8f, 0x0d, 0xeb, 0x12, 0xab, 0xed, 0xf0, 0x4e, 0x8e, 0x7a, 0xd9,
+	0xde, 0x1c, 0xf5, 0xff, 0xeb, 0x7d, 0x00, 0xcb, 0x63, 0xbc, 0x0a, 0x3f, 0xa0, 0xfe, 0xe6, 0x56,
+	0x88, 0xf3, 0xd0, 0xf0, 0x10, 0xa0, 0x27, 0xa7, 0xa5, 0x90, 0xf7, 0xff, 0xbc, 0x0d, 0x6b, 0xe9,
+	0xb5, 0xa1, 0xee, 0x32, 0xd2, 0x83, 0x65, 0x55, 0x2d, 0xf9, 0x32, 0x95, 0xa7, 0x8c, 0x42, 0xed,
+	0x97, 0xf5, 0x13, 0x58, 0xfa, 0x29, 0x64, 0x9c, 0x10, 0x33, 0x5c, 0x60, 0x76, 0x70, 0x17, 0x96,
+	0x46, 0x2c, 0x4e, 0xca, 0xc1, 0x02, 0x73, 0xda, 0xe6, 0x74, 0x47, 0x51, 0xa7, 0x41, 0x8e, 0xa0,
+	0xe9, 0xd2, 0x19, 0x3d, 0x63, 0x81, 0x39, 0xd1, 0x59, 0x4e, 0x1a, 0xe7, 0xec, 0x98, 0x77, 0x9b,
+	0x46, 0xc9, 0x07, 0x7a, 0xfd, 0x74, 0x36, 0x8d, 0xa8, 0x8f, 0x39, 0x51, 0x9c, 0x70, 0xd9, 0x6d,
+	0x9e, 0x86, 0x56, 0x5d, 0x65, 0x4b, 0x03, 0x77, 0x14, 0x97, 0xf3, 0x17, 0x98, 0xb3, 0x6e, 0xe6,
+	0xef, 0x8e, 0xc4, 0xbe, 0xcf, 0x01, 0x4e, 0x67, 0x3e, 0x4d, 0x50, 0xca, 0xb6, 0x2c, 0x99, 0x22,
+	0xad, 0x8d, 0xfa, 0xff, 0xdc, 0x86, 0xbb, 0xf9, 0xc1, 0x30, 0xee, 0x86, 0x3e, 0x92, 0x3e, 0xac,
+	0x9c, 0xce, 0x82, 0x90, 0xfa, 0xae, 0x4b, 0x32, 0x81, 0x02, 0x4a, 0xf7, 0xba, 0x02, 0x3b, 0x8d,
+	0xfd, 0x5b, 0xe4, 0x29, 0xdc, 0x19, 0xf2, 0x38, 0xa1, 0x41, 0xe0, 0xba, 0xa4, 0x95, 0x46, 0xa5,
+	0x88, 0x5d, 0xde, 0x73, 0x58, 0x4d, 0x39, 0x14, 0x9b, 0xb4, 0xcd, 0x78, 0x2c, 0xef, 0xe3, 0xba,
+	0xe2, 0xe0, 0x3a, 0x0d, 0xf2, 0x2d, 0xac, 0xc9, 0x18, 0x9e, 0x30, 0x9a, 0xa0, 0xeb, 0xe6, 0xfd,
+	0xd1, 0x50, 0x7b, 0xb7, 0x1f, 0xa0, 0xa5, 0xf1, 0x62, 0xc3, 0x0d, 0x5b, 0x56, 0xbb, 0xe7, 0x53,
+	0xb8, 0x93, 0x9d, 0x68, 0x51, 0x59, 0xed, 0xc1, 0x7d, 0x05, 0x2b, 0x43, 0x2e, 0xa6, 0x42, 0xeb,
+	0x9d, 0x02, 0xec, 0x58, 0x59, 0x8d, 0x8a, 0x1d, 0xc4, 0x73, 0xee, 0x69, 0xd5, 0x08, 0x54, 0x62,
+	0xb6, 0x6a, 0x1f, 0xbe, 0x78, 0x7b, 0x89, 0xd1, 0xdc, 0x75, 0x49, 0x33, 0xe5, 0xe4, 0xda, 0x3e,
+	0xdb, 0x3f, 0x6e, 0x01, 0xa8, 0xb3, 0x3d, 0x46, 0x8c, 0xc8, 0x77, 0x00, 0xa3, 0xd0, 0xa3, 0x81,
+	0x58, 0xc4, 0xf9, 0xd4, 0x8d, 0xf1, 0xa2, 0x40, 0x1d, 0x62, 0x78, 0x48, 0x4c, 0x36, 0xb0, 0x99,
+	0x3e, 0x49, 0x4a, 0xbb, 0x59, 0x68, 0x75, 0xbc, 0x5a, 0xdd, 0xff, 0x7b, 0x09, 0x96, 0x55, 0x1a,
+	0xe4, 0x00, 0xee, 0xca, 0x5c, 0xd5, 0x52, 0x7e, 0x3b, 0xb6, 0x0a, 0x2f, 0xb1, 0x2e, 0xdd, 0xbd,
+	0xa9, 0x7d, 0xfa, 0xf9, 0x38, 0x84, 0x6d, 0x4d, 0x7e, 0x18, 0x84, 0xde, 0x87, 0xc3, 0xf9, 0x8f,
+	0xc8, 0xa6, 0xe7, 0x49, 0xfe, 0xc8, 0x8f, 0xf1, 0xc2, 0x20, 0x4a, 0x49, 0x49, 0x4e, 0xce, 0xe9,
+	0x66, 0x85, 0x15, 0x8d, 0xcf, 0xf5, 0x3b, 0x48, 0x83, 0x3f, 0xc5, 0xe6, 0x97, 0xdf, 0x87, 0x2f,
+	0x2a, 0x6c, 0x04, 0x5c, 0x6b, 0xb3, 0x51, 0xea, 0xcb, 0xc9, 0x0c, 0x3d, 0xfd, 0x23, 0x36, 0xc3,
+	0x16, 0xf6, 0xe7, 0x2d, 0xdc, 0xaf, 0xeb, 0x8f, 0xf4, 0xdb, 0xad, 0xe9, 0x91, 0x34, 0xae, 0xce,
+	0xec, 0x35, 0x38, 0xd5, 0x7d, 0x92, 0x86, 0x3b, 0x95, 0xbd, 0xfa, 0x54, 0x3b, 0xd1, 0x98, 0x1a,
+	0xbb, 0x8c, 0xaa, 0xb6, 0xeb, 0xff, 0xfb, 0x19, 0x34, 0xd3, 0xb7, 0x97, 0xfc, 0x49, 0x21, 0x07,
+	0x00, 0x62, 0x6c, 0xd3, 0x95, 0xf6, 0x8c, 0x2b, 0x44, 0x70, 0xce, 0x96, 0xd9, 0xc1, 0x9c, 0x90,
+	0x13, 0x79, 0xe7, 0x08, 0x33, 0x75, 0xbb, 0xac, 0x2e, 0xbf, 0x21, 0x24, 0xd8, 0x69, 0x90, 0xef,
+	0x61, 0x6d, 0x8c, 0x5e, 0x78, 0x95, 0x67, 0xb1, 0x55, 0x56, 0xa6, 0xb4, 0x3d, 0xcc, 0xcf, 0x00,
+	0x86, 0x9c, 0x65, 0x3b, 0x1a, 0xb3, 0xc0, 0x12, 0x3b, 0xfc, 0x6b, 0x68, 0xbe, 0xc0, 0x00, 0x13,
+	0xac, 0x4d, 0xd1, 0x92, 0x1c, 0x00, 0x8c, 0xe9, 0x24, 0x39, 0x49, 0x68, 0x72, 0x69, 0x4c, 0x7d,
+	0x81, 0x96, 0x3a, 0x52, 0x10, 0x9d, 0xc6, 0xe1, 0x10, 0xf6, 0x3d, 0xde, 0xa5, 0x67, 0x18, 0x31,
+	0xaf, 0x3b, 0xa1, 0x67, 0x11, 0xf3, 0x9e, 0x79, 0x01, 0x43, 0x9e, 0x74, 0xc5, 0x8f, 0xa2, 0xfa,
+	0x2b, 0x54, 0xf2, 0xc3, 0xd5, 0x13, 0xf9, 0x1f, 0x7c, 0x2c, 0xa0, 0x77, 0xed, 0xf2, 0x7f, 0xe4,
+	0xd9, 0xb2, 0x5c, 0x7c, 0xf3, 0xdf, 0x00, 0x70, 0x72, 0x63, 0xe7, 0x40, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -830,6 +835,10 @@ type LedgerChannelClient interface {
 	Create(ctx context.Context, in *ChannelCreate, opts ...grpc.CallOption) (*Result, error)
 	Join(ctx context.Context, in *ChannelJoin, opts ...grpc.CallOption) (*Result, error)
 	List(ctx context.Context, in *ChannelList, opts ...grpc.CallOption) (*ResultArr, error)
+	Capabilities(ctx context.Context, in *ChannelCapabilities, opts ...grpc.CallOption) (*ResultCapabilities, error)
+	UpgradeCapability(ctx context.Context, in *ChannelCapabilityUpgrade, opts ...grpc.CallOption) (*Result, error)
+	ACLs(ctx context.Context, in *ChannelACLs, opts ...grpc.CallOption) (*ResultACLs, error)
+	UpdateACLs(ctx context.Context, in *ChannelACLUpdate, opts ...grpc.CallOption) (*Result, error)
 }
 
 type ledgerChannelClient struct {
@@ -867,11 +876,51 @@ func (c *ledgerChannelClient) List(ctx context.Context, in *ChannelList, opts ..
 	return out, nil
 }
 
+func (c *ledgerChannelClient) Capabilities(ctx context.Context, in *ChannelCapabilities, opts ...grpc.CallOption) (*ResultCapabilities, error) {
+	out := new(ResultCapabilities)
+	err := c.cc.Invoke(ctx, "/chain.LedgerChannel/Capabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerChannelClient) UpgradeCapability(ctx context.Context, in *ChannelCapabilityUpgrade, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/chain.LedgerChannel/UpgradeCapability", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerChannelClient) ACLs(ctx context.Context, in *ChannelACLs, opts ...grpc.CallOption) (*ResultACLs, error) {
+	out := new(ResultACLs)
+	err := c.cc.Invoke(ctx, "/chain.LedgerChannel/ACLs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerChannelClient) UpdateACLs(ctx context.Context, in *ChannelACLUpdate, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/chain.LedgerChannel/UpdateACLs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LedgerChannelServer is the server API for LedgerChannel service.
 type LedgerChannelServer interface {
 	Create(context.Context, *ChannelCreate) (*Result, error)
 	Join(context.Context, *ChannelJoin) (*Result, error)
 	List(context.Context, *ChannelList) (*ResultArr, error)
+	Capabilities(context.Context, *ChannelCapabilities) (*ResultCapabilities, error)
+	UpgradeCapability(context.Context, *ChannelCapabilityUpgrade) (*Result, error)
+	ACLs(context.Context, *ChannelACLs) (*ResultACLs, error)
+	UpdateACLs(context.Context, *ChannelACLUpdate) (*Result, error)
 }
 
 func RegisterLedgerChannelServer(s *grpc.Server, srv LedgerChannelServer) {
@@ -932,6 +981,78 @@ func _LedgerChannel_List_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LedgerChannel_Capabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelCapabilities)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerChannelServer).Capabilities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chain.LedgerChannel/Capabilities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerChannelServer).Capabilities(ctx, req.(*ChannelCapabilities))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerChannel_UpgradeCapability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelCapabilityUpgrade)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerChannelServer).UpgradeCapability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chain.LedgerChannel/UpgradeCapability",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerChannelServer).UpgradeCapability(ctx, req.(*ChannelCapabilityUpgrade))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerChannel_ACLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelACLs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerChannelServer).ACLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chain.LedgerChannel/ACLs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerChannelServer).ACLs(ctx, req.(*ChannelACLs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerChannel_UpdateACLs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChannelACLUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerChannelServer).UpdateACLs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chain.LedgerChannel/UpdateACLs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerChannelServer).UpdateACLs(ctx, req.(*ChannelACLUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _LedgerChannel_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "chain.LedgerChannel",
 	HandlerType: (*LedgerChannelServer)(nil),
@@ -948,6 +1069,22 @@ var _LedgerChannel_serviceDesc = grpc.ServiceDesc{
 			MethodName: "List",
 			Handler:    _LedgerChannel_List_Handler,
 		},
+		{
+			MethodName: "Capabilities",
+			Handler:    _LedgerChannel_Capabilities_Handler,
+		},
+		{
+			MethodName: "UpgradeCapability",
+			Handler:    _LedgerChannel_UpgradeCapability_Handler,
+		},
+		{
+			MethodName: "ACLs",
+			Handler:    _LedgerChannel_ACLs_Handler,
+		},
+		{
+			MethodName: "UpdateACLs",
+			Handler:    _LedgerChannel_UpdateACLs_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "grpc/proto/chain/server.proto",
@@ -1716,6 +1853,8 @@ type LedgerConfigClient interface {
 	GetConfig(ctx context.Context, in *ReqConfig, opts ...grpc.CallOption) (*ResultConfig, error)
 	RecoverConfig(ctx context.Context, in *ReqConfigRecover, opts ...grpc.CallOption) (*Result, error)
 	InitConfig(ctx context.Context, in *ReqInit, opts ...grpc.CallOption) (*Result, error)
+	DeleteConfig(ctx context.Context, in *ReqConfig, opts ...grpc.CallOption) (*Result, error)
+	RaftStatus(ctx context.Context, in *ReqRaftStatus, opts ...grpc.CallOption) (*ResultRaftStatus, error)
 }
 
 type ledgerConfigClient struct {
@@ -1762,12 +1901,32 @@ func (c *ledgerConfigClient) InitConfig(ctx context.Context, in *ReqInit, opts .
 	return out, nil
 }
 
+func (c *ledgerConfigClient) DeleteConfig(ctx context.Context, in *ReqConfig, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/chain.LedgerConfig/DeleteConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerConfigClient) RaftStatus(ctx context.Context, in *ReqRaftStatus, opts ...grpc.CallOption) (*ResultRaftStatus, error) {
+	out := new(ResultRaftStatus)
+	err := c.cc.Invoke(ctx, "/chain.LedgerConfig/RaftStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LedgerConfigServer is the server API for LedgerConfig service.
 type LedgerConfigServer interface {
 	ListConfig(context.Context, *ReqConfigList) (*ResultConfigList, error)
 	GetConfig(context.Context, *ReqConfig) (*ResultConfig, error)
 	RecoverConfig(context.Context, *ReqConfigRecover) (*Result, error)
 	InitConfig(context.Context, *ReqInit) (*Result, error)
+	DeleteConfig(context.Context, *ReqConfig) (*Result, error)
+	RaftStatus(context.Context, *ReqRaftStatus) (*ResultRaftStatus, error)
 }
 
 func RegisterLedgerConfigServer(s *grpc.Server, srv LedgerConfigServer) {
@@ -1846,6 +2005,42 @@ func _LedgerConfig_InitConfig_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LedgerConfig_DeleteConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerConfigServer).DeleteConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chain.LedgerConfig/DeleteConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerConfigServer).DeleteConfig(ctx, req.(*ReqConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerConfig_RaftStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqRaftStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerConfigServer).RaftStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/chain.LedgerConfig/RaftStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerConfigServer).RaftStatus(ctx, req.(*ReqRaftStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _LedgerConfig_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "chain.LedgerConfig",
 	HandlerType: (*LedgerConfigServer)(nil),
@@ -1866,6 +2061,14 @@ var _LedgerConfig_serviceDesc = grpc.ServiceDesc{
 			MethodName: "InitConfig",
 			Handler:    _LedgerConfig_InitConfig_Handler,
 		},
+		{
+			MethodName: "DeleteConfig",
+			Handler:    _LedgerConfig_DeleteConfig_Handler,
+		},
+		{
+			MethodName: "RaftStatus",
+			Handler:    _LedgerConfig_RaftStatus_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "grpc/proto/chain/server.proto",
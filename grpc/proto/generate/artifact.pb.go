@@ -0,0 +1,744 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: grpc/proto/generate/artifact.proto
+
+package generate
+
+import (
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
+
+type ReqDownloadArtifacts struct {
+	LeagueDomain         string   `protobuf:"bytes,1,opt,name=leagueDomain,proto3" json:"leagueDomain,omitempty"`
+	OrgType              OrgType  `protobuf:"varint,2,opt,name=orgType,proto3,enum=generate.OrgType" json:"orgType,omitempty"`
+	OrgName              string   `protobuf:"bytes,3,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	OrgDomain            string   `protobuf:"bytes,4,opt,name=orgDomain,proto3" json:"orgDomain,omitempty"`
+	ChildName            string   `protobuf:"bytes,5,opt,name=childName,proto3" json:"childName,omitempty"`
+	IsUser               bool     `protobuf:"varint,6,opt,name=isUser,proto3" json:"isUser,omitempty"`
+	ExcludePrivateKey    bool     `protobuf:"varint,7,opt,name=excludePrivateKey,proto3" json:"excludePrivateKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqDownloadArtifacts) Reset()         { *m = ReqDownloadArtifacts{} }
+func (m *ReqDownloadArtifacts) String() string { return proto.CompactTextString(m) }
+func (*ReqDownloadArtifacts) ProtoMessage()    {}
+func (*ReqDownloadArtifacts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ad0881d8fc171a, []int{0}
+}
+
+func (m *ReqDownloadArtifacts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqDownloadArtifacts.Unmarshal(m, b)
+}
+func (m *ReqDownloadArtifacts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqDownloadArtifacts.Marshal(b, m, deterministic)
+}
+func (m *ReqDownloadArtifacts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqDownloadArtifacts.Merge(m, src)
+}
+func (m *ReqDownloadArtifacts) XXX_Size() int {
+	return xxx_messageInfo_ReqDownloadArtifacts.Size(m)
+}
+func (m *ReqDownloadArtifacts) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqDownloadArtifacts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqDownloadArtifacts proto.InternalMessageInfo
+
+func (m *ReqDownloadArtifacts) GetLeagueDomain() string {
+	if m != nil {
+		return m.LeagueDomain
+	}
+	return ""
+}
+
+func (m *ReqDownloadArtifacts) GetOrgType() OrgType {
+	if m != nil {
+		return m.OrgType
+	}
+	return OrgType_Order
+}
+
+func (m *ReqDownloadArtifacts) GetOrgName() string {
+	if m != nil {
+		return m.OrgName
+	}
+	return ""
+}
+
+func (m *ReqDownloadArtifacts) GetOrgDomain() string {
+	if m != nil {
+		return m.OrgDomain
+	}
+	return ""
+}
+
+func (m *ReqDownloadArtifacts) GetChildName() string {
+	if m != nil {
+		return m.ChildName
+	}
+	return ""
+}
+
+func (m *ReqDownloadArtifacts) GetIsUser() bool {
+	if m != nil {
+		return m.IsUser
+	}
+	return false
+}
+
+func (m *ReqDownloadArtifacts) GetExcludePrivateKey() bool {
+	if m != nil {
+		return m.ExcludePrivateKey
+	}
+	return false
+}
+
+type RespDownloadArtifacts struct {
+	Code                 Code     `protobuf:"varint,1,opt,name=code,proto3,enum=generate.Code" json:"code,omitempty"`
+	ErrMsg               string   `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RespDownloadArtifacts) Reset()         { *m = RespDownloadArtifacts{} }
+func (m *RespDownloadArtifacts) String() string { return proto.CompactTextString(m) }
+func (*RespDownloadArtifacts) ProtoMessage()    {}
+func (*RespDownloadArtifacts) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ad0881d8fc171a, []int{1}
+}
+
+func (m *RespDownloadArtifacts) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RespDownloadArtifacts.Unmarshal(m, b)
+}
+func (m *RespDownloadArtifacts) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RespDownloadArtifacts.Marshal(b, m, deterministic)
+}
+func (m *RespDownloadArtifacts) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespDownloadArtifacts.Merge(m, src)
+}
+func (m *RespDownloadArtifacts) XXX_Size() int {
+	return xxx_messageInfo_RespDownloadArtifacts.Size(m)
+}
+func (m *RespDownloadArtifacts) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespDownloadArtifacts.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespDownloadArtifacts proto.InternalMessageInfo
+
+func (m *RespDownloadArtifacts) GetCode() Code {
+	if m != nil {
+		return m.Code
+	}
+	return Code_Success
+}
+
+func (m *RespDownloadArtifacts) GetErrMsg() string {
+	if m != nil {
+		return m.ErrMsg
+	}
+	return ""
+}
+
+func (m *RespDownloadArtifacts) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ReqInspectBlock struct {
+	LeagueDomain         string   `protobuf:"bytes,1,opt,name=leagueDomain,proto3" json:"leagueDomain,omitempty"`
+	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqInspectBlock) Reset()         { *m = ReqInspectBlock{} }
+func (m *ReqInspectBlock) String() string { return proto.CompactTextString(m) }
+func (*ReqInspectBlock) ProtoMessage()    {}
+func (*ReqInspectBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ad0881d8fc171a, []int{2}
+}
+
+func (m *ReqInspectBlock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqInspectBlock.Unmarshal(m, b)
+}
+func (m *ReqInspectBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqInspectBlock.Marshal(b, m, deterministic)
+}
+func (m *ReqInspectBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqInspectBlock.Merge(m, src)
+}
+func (m *ReqInspectBlock) XXX_Size() int {
+	return xxx_messageInfo_ReqInspectBlock.Size(m)
+}
+func (m *ReqInspectBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqInspectBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqInspectBlock proto.InternalMessageInfo
+
+func (m *ReqInspectBlock) GetLeagueDomain() string {
+	if m != nil {
+		return m.LeagueDomain
+	}
+	return ""
+}
+
+func (m *ReqInspectBlock) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type ReqInspectChannelTx struct {
+	LeagueDomain         string   `protobuf:"bytes,1,opt,name=leagueDomain,proto3" json:"leagueDomain,omitempty"`
+	ChannelID            string   `protobuf:"bytes,2,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqInspectChannelTx) Reset()         { *m = ReqInspectChannelTx{} }
+func (m *ReqInspectChannelTx) String() string { return proto.CompactTextString(m) }
+func (*ReqInspectChannelTx) ProtoMessage()    {}
+func (*ReqInspectChannelTx) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ad0881d8fc171a, []int{3}
+}
+
+func (m *ReqInspectChannelTx) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqInspectChannelTx.Unmarshal(m, b)
+}
+func (m *ReqInspectChannelTx) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqInspectChannelTx.Marshal(b, m, deterministic)
+}
+func (m *ReqInspectChannelTx) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqInspectChannelTx.Merge(m, src)
+}
+func (m *ReqInspectChannelTx) XXX_Size() int {
+	return xxx_messageInfo_ReqInspectChannelTx.Size(m)
+}
+func (m *ReqInspectChannelTx) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqInspectChannelTx.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqInspectChannelTx proto.InternalMessageInfo
+
+func (m *ReqInspectChannelTx) GetLeagueDomain() string {
+	if m != nil {
+		return m.LeagueDomain
+	}
+	return ""
+}
+
+func (m *ReqInspectChannelTx) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *ReqInspectChannelTx) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type RespInspect struct {
+	Code                 Code     `protobuf:"varint,1,opt,name=code,proto3,enum=generate.Code" json:"code,omitempty"`
+	ErrMsg               string   `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
+	Json                 string   `protobuf:"bytes,3,opt,name=json,proto3" json:"json,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RespInspect) Reset()         { *m = RespInspect{} }
+func (m *RespInspect) String() string { return proto.CompactTextString(m) }
+func (*RespInspect) ProtoMessage()    {}
+func (*RespInspect) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ad0881d8fc171a, []int{4}
+}
+
+func (m *RespInspect) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RespInspect.Unmarshal(m, b)
+}
+func (m *RespInspect) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RespInspect.Marshal(b, m, deterministic)
+}
+func (m *RespInspect) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespInspect.Merge(m, src)
+}
+func (m *RespInspect) XXX_Size() int {
+	return xxx_messageInfo_RespInspect.Size(m)
+}
+func (m *RespInspect) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespInspect.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespInspect proto.InternalMessageInfo
+
+func (m *RespInspect) GetCode() Code {
+	if m != nil {
+		return m.Code
+	}
+	return Code_Success
+}
+
+func (m *RespInspect) GetErrMsg() string {
+	if m != nil {
+		return m.ErrMsg
+	}
+	return ""
+}
+
+func (m *RespInspect) GetJson() string {
+	if m != nil {
+		return m.Json
+	}
+	return ""
+}
+
+type ReqImportCrypto struct {
+	LeagueDomain         string              `protobuf:"bytes,1,opt,name=leagueDomain,proto3" json:"leagueDomain,omitempty"`
+	Data                 []byte              `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	Orgs                 []*ImportOrgMapping `protobuf:"bytes,3,rep,name=orgs,proto3" json:"orgs,omitempty"`
+	Connection           *ImportConnection   `protobuf:"bytes,4,opt,name=connection,proto3" json:"connection,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ReqImportCrypto) Reset()         { *m = ReqImportCrypto{} }
+func (m *ReqImportCrypto) String() string { return proto.CompactTextString(m) }
+func (*ReqImportCrypto) ProtoMessage()    {}
+func (*ReqImportCrypto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ad0881d8fc171a, []int{5}
+}
+
+func (m *ReqImportCrypto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqImportCrypto.Unmarshal(m, b)
+}
+func (m *ReqImportCrypto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqImportCrypto.Marshal(b, m, deterministic)
+}
+func (m *ReqImportCrypto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqImportCrypto.Merge(m, src)
+}
+func (m *ReqImportCrypto) XXX_Size() int {
+	return xxx_messageInfo_ReqImportCrypto.Size(m)
+}
+func (m *ReqImportCrypto) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqImportCrypto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqImportCrypto proto.InternalMessageInfo
+
+func (m *ReqImportCrypto) GetLeagueDomain() string {
+	if m != nil {
+		return m.LeagueDomain
+	}
+	return ""
+}
+
+func (m *ReqImportCrypto) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ReqImportCrypto) GetOrgs() []*ImportOrgMapping {
+	if m != nil {
+		return m.Orgs
+	}
+	return nil
+}
+
+func (m *ReqImportCrypto) GetConnection() *ImportConnection {
+	if m != nil {
+		return m.Connection
+	}
+	return nil
+}
+
+type ImportOrgMapping struct {
+	DirName              string   `protobuf:"bytes,1,opt,name=dirName,proto3" json:"dirName,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Domain               string   `protobuf:"bytes,3,opt,name=domain,proto3" json:"domain,omitempty"`
+	MspID                string   `protobuf:"bytes,4,opt,name=mspID,proto3" json:"mspID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportOrgMapping) Reset()         { *m = ImportOrgMapping{} }
+func (m *ImportOrgMapping) String() string { return proto.CompactTextString(m) }
+func (*ImportOrgMapping) ProtoMessage()    {}
+func (*ImportOrgMapping) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ad0881d8fc171a, []int{6}
+}
+
+func (m *ImportOrgMapping) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportOrgMapping.Unmarshal(m, b)
+}
+func (m *ImportOrgMapping) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportOrgMapping.Marshal(b, m, deterministic)
+}
+func (m *ImportOrgMapping) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportOrgMapping.Merge(m, src)
+}
+func (m *ImportOrgMapping) XXX_Size() int {
+	return xxx_messageInfo_ImportOrgMapping.Size(m)
+}
+func (m *ImportOrgMapping) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportOrgMapping.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportOrgMapping proto.InternalMessageInfo
+
+func (m *ImportOrgMapping) GetDirName() string {
+	if m != nil {
+		return m.DirName
+	}
+	return ""
+}
+
+func (m *ImportOrgMapping) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ImportOrgMapping) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ImportOrgMapping) GetMspID() string {
+	if m != nil {
+		return m.MspID
+	}
+	return ""
+}
+
+type ImportConnection struct {
+	ConfigID             string            `protobuf:"bytes,1,opt,name=configID,proto3" json:"configID,omitempty"`
+	OrgName              string            `protobuf:"bytes,2,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	UserName             string            `protobuf:"bytes,3,opt,name=userName,proto3" json:"userName,omitempty"`
+	Tls                  bool              `protobuf:"varint,4,opt,name=tls,proto3" json:"tls,omitempty"`
+	Level                string            `protobuf:"bytes,5,opt,name=level,proto3" json:"level,omitempty"`
+	PeerURLs             map[string]string `protobuf:"bytes,6,rep,name=peerURLs,proto3" json:"peerURLs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	OrdererURLs          map[string]string `protobuf:"bytes,7,rep,name=ordererURLs,proto3" json:"ordererURLs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ImportConnection) Reset()         { *m = ImportConnection{} }
+func (m *ImportConnection) String() string { return proto.CompactTextString(m) }
+func (*ImportConnection) ProtoMessage()    {}
+func (*ImportConnection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ad0881d8fc171a, []int{7}
+}
+
+func (m *ImportConnection) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportConnection.Unmarshal(m, b)
+}
+func (m *ImportConnection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportConnection.Marshal(b, m, deterministic)
+}
+func (m *ImportConnection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportConnection.Merge(m, src)
+}
+func (m *ImportConnection) XXX_Size() int {
+	return xxx_messageInfo_ImportConnection.Size(m)
+}
+func (m *ImportConnection) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportConnection.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportConnection proto.InternalMessageInfo
+
+func (m *ImportConnection) GetConfigID() string {
+	if m != nil {
+		return m.ConfigID
+	}
+	return ""
+}
+
+func (m *ImportConnection) GetOrgName() string {
+	if m != nil {
+		return m.OrgName
+	}
+	return ""
+}
+
+func (m *ImportConnection) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *ImportConnection) GetTls() bool {
+	if m != nil {
+		return m.Tls
+	}
+	return false
+}
+
+func (m *ImportConnection) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
+func (m *ImportConnection) GetPeerURLs() map[string]string {
+	if m != nil {
+		return m.PeerURLs
+	}
+	return nil
+}
+
+func (m *ImportConnection) GetOrdererURLs() map[string]string {
+	if m != nil {
+		return m.OrdererURLs
+	}
+	return nil
+}
+
+type ImportedOrg struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Domain               string   `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	OrgType              OrgType  `protobuf:"varint,3,opt,name=orgType,proto3,enum=generate.OrgType" json:"orgType,omitempty"`
+	MspID                string   `protobuf:"bytes,4,opt,name=mspID,proto3" json:"mspID,omitempty"`
+	Nodes                []string `protobuf:"bytes,5,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Users                []string `protobuf:"bytes,6,rep,name=users,proto3" json:"users,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportedOrg) Reset()         { *m = ImportedOrg{} }
+func (m *ImportedOrg) String() string { return proto.CompactTextString(m) }
+func (*ImportedOrg) ProtoMessage()    {}
+func (*ImportedOrg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ad0881d8fc171a, []int{8}
+}
+
+func (m *ImportedOrg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportedOrg.Unmarshal(m, b)
+}
+func (m *ImportedOrg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportedOrg.Marshal(b, m, deterministic)
+}
+func (m *ImportedOrg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportedOrg.Merge(m, src)
+}
+func (m *ImportedOrg) XXX_Size() int {
+	return xxx_messageInfo_ImportedOrg.Size(m)
+}
+func (m *ImportedOrg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportedOrg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportedOrg proto.InternalMessageInfo
+
+func (m *ImportedOrg) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ImportedOrg) GetDomain() string {
+	if m != nil {
+		return m.Domain
+	}
+	return ""
+}
+
+func (m *ImportedOrg) GetOrgType() OrgType {
+	if m != nil {
+		return m.OrgType
+	}
+	return OrgType_Order
+}
+
+func (m *ImportedOrg) GetMspID() string {
+	if m != nil {
+		return m.MspID
+	}
+	return ""
+}
+
+func (m *ImportedOrg) GetNodes() []string {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *ImportedOrg) GetUsers() []string {
+	if m != nil {
+		return m.Users
+	}
+	return nil
+}
+
+type RespImportCrypto struct {
+	Code                 Code           `protobuf:"varint,1,opt,name=code,proto3,enum=generate.Code" json:"code,omitempty"`
+	ErrMsg               string         `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
+	Orgs                 []*ImportedOrg `protobuf:"bytes,3,rep,name=orgs,proto3" json:"orgs,omitempty"`
+	Config               []byte         `protobuf:"bytes,4,opt,name=config,proto3" json:"config,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *RespImportCrypto) Reset()         { *m = RespImportCrypto{} }
+func (m *RespImportCrypto) String() string { return proto.CompactTextString(m) }
+func (*RespImportCrypto) ProtoMessage()    {}
+func (*RespImportCrypto) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9ad0881d8fc171a, []int{9}
+}
+
+func (m *RespImportCrypto) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RespImportCrypto.Unmarshal(m, b)
+}
+func (m *RespImportCrypto) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RespImportCrypto.Marshal(b, m, deterministic)
+}
+func (m *RespImportCrypto) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespImportCrypto.Merge(m, src)
+}
+func (m *RespImportCrypto) XXX_Size() int {
+	return xxx_messageInfo_RespImportCrypto.Size(m)
+}
+func (m *RespImportCrypto) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespImportCrypto.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespImportCrypto proto.InternalMessageInfo
+
+func (m *RespImportCrypto) GetCode() Code {
+	if m != nil {
+		return m.Code
+	}
+	return Code_Success
+}
+
+func (m *RespImportCrypto) GetErrMsg() string {
+	if m != nil {
+		return m.ErrMsg
+	}
+	return ""
+}
+
+func (m *RespImportCrypto) GetOrgs() []*ImportedOrg {
+	if m != nil {
+		return m.Orgs
+	}
+	return nil
+}
+
+func (m *RespImportCrypto) GetConfig() []byte {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ReqDownloadArtifacts)(nil), "generate.ReqDownloadArtifacts")
+	proto.RegisterType((*RespDownloadArtifacts)(nil), "generate.RespDownloadArtifacts")
+	proto.RegisterType((*ReqInspectBlock)(nil), "generate.ReqInspectBlock")
+	proto.RegisterType((*ReqInspectChannelTx)(nil), "generate.ReqInspectChannelTx")
+	proto.RegisterType((*RespInspect)(nil), "generate.RespInspect")
+	proto.RegisterType((*ReqImportCrypto)(nil), "generate.ReqImportCrypto")
+	proto.RegisterType((*ImportOrgMapping)(nil), "generate.ImportOrgMapping")
+	proto.RegisterType((*ImportConnection)(nil), "generate.ImportConnection")
+	proto.RegisterMapType((map[string]string)(nil), "generate.ImportConnection.OrdererURLsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "generate.ImportConnection.PeerURLsEntry")
+	proto.RegisterType((*ImportedOrg)(nil), "generate.ImportedOrg")
+	proto.RegisterType((*RespImportCrypto)(nil), "generate.RespImportCrypto")
+}
+
+func init() {
+	proto.RegisterFile("grpc/proto/generate/artifact.proto", fileDescriptor_c9ad0881d8fc171a)
+}
+
+var fileDescriptor_c9ad0881d8fc171a = []byte{
+	// 730 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x96, 0x93, 0x34, 0x4d, 0x36, 0x6d, 0x49, 0xb7, 0x3f, 0xb2, 0x22, 0x24, 0x22, 0x9f, 0x82,
+	0x0a, 0xae, 0x54, 0x2e, 0xa8, 0x48, 0x48, 0x34, 0xe1, 0x10, 0x41, 0x49, 0x59, 0xb5, 0x17, 0x24,
+	0x0e, 0x5b, 0x7b, 0xea, 0x9a, 0x3a, 0xbb, 0xee, 0xae, 0x53, 0x9a, 0x33, 0x4f, 0xc0, 0x0b, 0x70,
+	0xe6, 0x05, 0x78, 0x3f, 0xb4, 0x3f, 0x76, 0x7e, 0x30, 0x50, 0xca, 0x6d, 0xbf, 0x99, 0xd9, 0x6f,
+	0x66, 0xbf, 0x99, 0xb1, 0x91, 0x17, 0x89, 0x34, 0xd8, 0x4f, 0x05, 0xcf, 0xf8, 0x7e, 0x04, 0x0c,
+	0x04, 0xcd, 0x60, 0x9f, 0x8a, 0x2c, 0xbe, 0xa0, 0x41, 0xe6, 0x6b, 0x3b, 0x6e, 0xe4, 0x8e, 0xce,
+	0xa3, 0xb2, 0x68, 0x60, 0x93, 0xb1, 0x34, 0xa1, 0x9d, 0x6e, 0x59, 0x80, 0x00, 0x39, 0x49, 0x2c,
+	0x99, 0xf7, 0xa5, 0x82, 0xb6, 0x09, 0x5c, 0x0f, 0xf8, 0x67, 0x96, 0x70, 0x1a, 0xbe, 0xb2, 0xa9,
+	0x24, 0xf6, 0xd0, 0x5a, 0x02, 0x34, 0x9a, 0xc0, 0x80, 0x8f, 0x69, 0xcc, 0x5c, 0xa7, 0xeb, 0xf4,
+	0x9a, 0x64, 0xc1, 0x86, 0xf7, 0xd0, 0x2a, 0x17, 0xd1, 0xe9, 0x34, 0x05, 0xb7, 0xd2, 0x75, 0x7a,
+	0x1b, 0x07, 0x9b, 0x7e, 0x9e, 0xc5, 0x1f, 0x19, 0x07, 0xc9, 0x23, 0xb0, 0xab, 0x83, 0xdf, 0xd1,
+	0x31, 0xb8, 0x55, 0xcd, 0x95, 0x43, 0xfc, 0x10, 0x35, 0xb9, 0x88, 0x6c, 0x9e, 0x9a, 0xf6, 0xcd,
+	0x0c, 0xca, 0x1b, 0x5c, 0xc6, 0x49, 0xa8, 0x6f, 0xae, 0x18, 0x6f, 0x61, 0xc0, 0xbb, 0xa8, 0x1e,
+	0xcb, 0x33, 0x09, 0xc2, 0xad, 0x77, 0x9d, 0x5e, 0x83, 0x58, 0x84, 0x9f, 0xa0, 0x4d, 0xb8, 0x0d,
+	0x92, 0x49, 0x08, 0x27, 0x22, 0xbe, 0xa1, 0x19, 0xbc, 0x81, 0xa9, 0xbb, 0xaa, 0x43, 0x7e, 0x75,
+	0x78, 0x11, 0xda, 0x21, 0x20, 0xd3, 0x32, 0x15, 0x6a, 0x01, 0x0f, 0x41, 0xbf, 0x7e, 0xe3, 0x60,
+	0x63, 0xf6, 0xbc, 0x3e, 0x0f, 0x81, 0x68, 0x9f, 0x2a, 0x01, 0x84, 0x38, 0x96, 0x91, 0x16, 0xa1,
+	0x49, 0x2c, 0xc2, 0x18, 0xd5, 0x42, 0x9a, 0x51, 0xfd, 0xda, 0x35, 0xa2, 0xcf, 0xde, 0x10, 0x3d,
+	0x20, 0x70, 0x3d, 0x64, 0x32, 0x85, 0x20, 0x3b, 0x4a, 0x78, 0x70, 0x75, 0x27, 0xa1, 0x73, 0xaa,
+	0xca, 0x1c, 0xd5, 0x15, 0xda, 0x9a, 0x51, 0xf5, 0x2f, 0x29, 0x63, 0x90, 0x9c, 0xde, 0xde, 0x89,
+	0x4e, 0x4b, 0xaa, 0x2f, 0x0c, 0x07, 0xb6, 0xe8, 0x99, 0xa1, 0xb4, 0xee, 0x8f, 0xa8, 0xa5, 0x04,
+	0xb2, 0xd9, 0xfe, 0x57, 0x96, 0x4f, 0x92, 0x33, 0x3b, 0x04, 0xfa, 0xec, 0xfd, 0x70, 0x8c, 0x2e,
+	0xe3, 0x94, 0x8b, 0xac, 0x2f, 0xa6, 0x69, 0xc6, 0xef, 0xab, 0x0b, 0xf6, 0x51, 0x8d, 0x8b, 0x48,
+	0xba, 0xd5, 0x6e, 0xb5, 0xd7, 0x3a, 0xe8, 0xcc, 0x6a, 0x33, 0xec, 0x23, 0x11, 0x1d, 0xd3, 0x34,
+	0x8d, 0x59, 0x44, 0x74, 0x1c, 0x3e, 0x44, 0x28, 0xe0, 0x8c, 0x41, 0x90, 0xc5, 0xdc, 0x8c, 0x5f,
+	0xc9, 0xad, 0x7e, 0x11, 0x41, 0xe6, 0xa2, 0x3d, 0x86, 0xda, 0xcb, 0xac, 0x6a, 0xce, 0xc3, 0x58,
+	0xe8, 0x69, 0x35, 0x25, 0xe7, 0x50, 0x55, 0xcb, 0x94, 0xd9, 0xe8, 0xa1, 0xcf, 0x4a, 0xa5, 0xd0,
+	0xbc, 0xcf, 0xe8, 0x61, 0x11, 0xde, 0x46, 0x2b, 0x63, 0x99, 0x0e, 0x07, 0x76, 0x1f, 0x0c, 0xf0,
+	0xbe, 0x55, 0x51, 0x7b, 0xb9, 0x20, 0xdc, 0x41, 0x8d, 0x80, 0xb3, 0x8b, 0x38, 0x1a, 0x0e, 0x6c,
+	0xc6, 0x02, 0xcf, 0x2f, 0x5d, 0x65, 0x71, 0xe9, 0x3a, 0xa8, 0x31, 0x91, 0x20, 0xe6, 0xf6, 0xb1,
+	0xc0, 0xb8, 0x8d, 0xaa, 0x59, 0x22, 0x75, 0xea, 0x06, 0x51, 0x47, 0x55, 0x4e, 0x02, 0x37, 0x90,
+	0xd8, 0x05, 0x34, 0x00, 0x0f, 0x50, 0x23, 0x05, 0x10, 0x67, 0xe4, 0xad, 0x74, 0xeb, 0x5a, 0xee,
+	0xde, 0xef, 0x85, 0xf3, 0x4f, 0x6c, 0xe8, 0x6b, 0x96, 0x89, 0x29, 0x29, 0x6e, 0xe2, 0x63, 0xd4,
+	0xe2, 0x22, 0x04, 0x61, 0x89, 0x56, 0x35, 0xd1, 0xde, 0x1f, 0x88, 0x46, 0xb3, 0x68, 0xc3, 0x35,
+	0x7f, 0xbf, 0xf3, 0x02, 0xad, 0x2f, 0x64, 0x52, 0xaf, 0xb9, 0x82, 0xa9, 0x95, 0x46, 0x1d, 0xd5,
+	0x6b, 0x6e, 0x68, 0x32, 0xc9, 0x35, 0x31, 0xe0, 0xb0, 0xf2, 0xdc, 0xe9, 0xbc, 0x44, 0xed, 0x65,
+	0xf6, 0x7f, 0xb9, 0xef, 0x7d, 0x77, 0x50, 0xcb, 0xd4, 0x0b, 0xe1, 0x48, 0x44, 0x45, 0xcb, 0x9d,
+	0xd2, 0x96, 0x57, 0x16, 0x5a, 0x3e, 0xf7, 0x35, 0xad, 0xfe, 0xf5, 0x6b, 0x5a, 0x3a, 0x1f, 0xca,
+	0xca, 0x78, 0x08, 0xd2, 0x5d, 0xe9, 0x56, 0x95, 0x55, 0x03, 0x65, 0x55, 0xad, 0x35, 0x3d, 0x6a,
+	0x12, 0x03, 0xbc, 0xaf, 0x0e, 0x6a, 0xeb, 0x9d, 0x5e, 0x5c, 0xba, 0xfb, 0x2f, 0xf6, 0xe3, 0x85,
+	0xc5, 0xdb, 0x59, 0x6e, 0xa0, 0x16, 0xc4, 0xee, 0xdc, 0x2e, 0xaa, 0x9b, 0x11, 0xd5, 0xe5, 0xaf,
+	0x11, 0x8b, 0x8e, 0xde, 0xa3, 0xbd, 0x80, 0xf9, 0xf4, 0x1c, 0x44, 0x1c, 0xf8, 0x17, 0xf4, 0x5c,
+	0xc4, 0xc1, 0xd3, 0x20, 0x89, 0x81, 0x65, 0xbe, 0xfa, 0x93, 0x99, 0x7f, 0x56, 0x41, 0x7c, 0xb4,
+	0x9e, 0x7f, 0xa8, 0x4f, 0x94, 0xfd, 0xc3, 0x56, 0xc9, 0xdf, 0xee, 0xbc, 0xae, 0xf1, 0xb3, 0x9f,
+	0x03, 0x00, 0x43, 0xfe, 0x46, 0x82, 0x5a, 0x07, 0x00, 0x00,
+}
@@ -346,13 +346,15 @@ func (m *RespCreateLeague) GetErrMsg() string {
 }
 
 type ReqCreateOrg struct {
-	OrgType              OrgType  `protobuf:"varint,1,opt,name=orgType,proto3,enum=generate.OrgType" json:"orgType,omitempty"`
-	LeagueDomain         string   `protobuf:"bytes,2,opt,name=leagueDomain,proto3" json:"leagueDomain,omitempty"`
-	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Domain               string   `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	OrgType              OrgType         `protobuf:"varint,1,opt,name=orgType,proto3,enum=generate.OrgType" json:"orgType,omitempty"`
+	LeagueDomain         string          `protobuf:"bytes,2,opt,name=leagueDomain,proto3" json:"leagueDomain,omitempty"`
+	Name                 string          `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Domain               string          `protobuf:"bytes,4,opt,name=domain,proto3" json:"domain,omitempty"`
+	NodeOUs              bool            `protobuf:"varint,5,opt,name=nodeOUs,proto3" json:"nodeOUs,omitempty"`
+	IntermediateCA       *IntermediateCA `protobuf:"bytes,6,opt,name=intermediateCA,proto3" json:"intermediateCA,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
 func (m *ReqCreateOrg) Reset()         { *m = ReqCreateOrg{} }
@@ -408,6 +410,91 @@ func (m *ReqCreateOrg) GetDomain() string {
 	return ""
 }
 
+func (m *ReqCreateOrg) GetNodeOUs() bool {
+	if m != nil {
+		return m.NodeOUs
+	}
+	return false
+}
+
+func (m *ReqCreateOrg) GetIntermediateCA() *IntermediateCA {
+	if m != nil {
+		return m.IntermediateCA
+	}
+	return nil
+}
+
+type IntermediateCA struct {
+	PriData              []byte        `protobuf:"bytes,1,opt,name=priData,proto3" json:"priData,omitempty"`
+	PriTlsData           []byte        `protobuf:"bytes,2,opt,name=priTlsData,proto3" json:"priTlsData,omitempty"`
+	Csr                  *CSR          `protobuf:"bytes,3,opt,name=csr,proto3" json:"csr,omitempty"`
+	SignAlgorithm        SignAlgorithm `protobuf:"varint,4,opt,name=signAlgorithm,proto3,enum=generate.SignAlgorithm" json:"signAlgorithm,omitempty"`
+	MaxPathLen           int32         `protobuf:"varint,5,opt,name=maxPathLen,proto3" json:"maxPathLen,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *IntermediateCA) Reset()         { *m = IntermediateCA{} }
+func (m *IntermediateCA) String() string { return proto.CompactTextString(m) }
+func (*IntermediateCA) ProtoMessage()    {}
+func (*IntermediateCA) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a6d3a83fb7b1ea9, []int{5}
+}
+
+func (m *IntermediateCA) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IntermediateCA.Unmarshal(m, b)
+}
+func (m *IntermediateCA) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IntermediateCA.Marshal(b, m, deterministic)
+}
+func (m *IntermediateCA) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntermediateCA.Merge(m, src)
+}
+func (m *IntermediateCA) XXX_Size() int {
+	return xxx_messageInfo_IntermediateCA.Size(m)
+}
+func (m *IntermediateCA) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntermediateCA.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntermediateCA proto.InternalMessageInfo
+
+func (m *IntermediateCA) GetPriData() []byte {
+	if m != nil {
+		return m.PriData
+	}
+	return nil
+}
+
+func (m *IntermediateCA) GetPriTlsData() []byte {
+	if m != nil {
+		return m.PriTlsData
+	}
+	return nil
+}
+
+func (m *IntermediateCA) GetCsr() *CSR {
+	if m != nil {
+		return m.Csr
+	}
+	return nil
+}
+
+func (m *IntermediateCA) GetSignAlgorithm() SignAlgorithm {
+	if m != nil {
+		return m.SignAlgorithm
+	}
+	return SignAlgorithm_SHA256WithRSA
+}
+
+func (m *IntermediateCA) GetMaxPathLen() int32 {
+	if m != nil {
+		return m.MaxPathLen
+	}
+	return 0
+}
+
 type RespCreateOrg struct {
 	Code                 Code     `protobuf:"varint,1,opt,name=code,proto3,enum=generate.Code" json:"code,omitempty"`
 	ErrMsg               string   `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
@@ -420,7 +507,7 @@ func (m *RespCreateOrg) Reset()         { *m = RespCreateOrg{} }
 func (m *RespCreateOrg) String() string { return proto.CompactTextString(m) }
 func (*RespCreateOrg) ProtoMessage()    {}
 func (*RespCreateOrg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a6d3a83fb7b1ea9, []int{5}
+	return fileDescriptor_4a6d3a83fb7b1ea9, []int{6}
 }
 
 func (m *RespCreateOrg) XXX_Unmarshal(b []byte) error {
@@ -471,7 +558,7 @@ func (m *ReqCreateCsr) Reset()         { *m = ReqCreateCsr{} }
 func (m *ReqCreateCsr) String() string { return proto.CompactTextString(m) }
 func (*ReqCreateCsr) ProtoMessage()    {}
 func (*ReqCreateCsr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a6d3a83fb7b1ea9, []int{6}
+	return fileDescriptor_4a6d3a83fb7b1ea9, []int{7}
 }
 
 func (m *ReqCreateCsr) XXX_Unmarshal(b []byte) error {
@@ -546,7 +633,7 @@ func (m *RespCreateCsr) Reset()         { *m = RespCreateCsr{} }
 func (m *RespCreateCsr) String() string { return proto.CompactTextString(m) }
 func (*RespCreateCsr) ProtoMessage()    {}
 func (*RespCreateCsr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a6d3a83fb7b1ea9, []int{7}
+	return fileDescriptor_4a6d3a83fb7b1ea9, []int{8}
 }
 
 func (m *RespCreateCsr) XXX_Unmarshal(b []byte) error {
@@ -593,7 +680,7 @@ func (m *ReqCreateOrgNode) Reset()         { *m = ReqCreateOrgNode{} }
 func (m *ReqCreateOrgNode) String() string { return proto.CompactTextString(m) }
 func (*ReqCreateOrgNode) ProtoMessage()    {}
 func (*ReqCreateOrgNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a6d3a83fb7b1ea9, []int{8}
+	return fileDescriptor_4a6d3a83fb7b1ea9, []int{9}
 }
 
 func (m *ReqCreateOrgNode) XXX_Unmarshal(b []byte) error {
@@ -640,7 +727,7 @@ func (m *RespCreateOrgNode) Reset()         { *m = RespCreateOrgNode{} }
 func (m *RespCreateOrgNode) String() string { return proto.CompactTextString(m) }
 func (*RespCreateOrgNode) ProtoMessage()    {}
 func (*RespCreateOrgNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a6d3a83fb7b1ea9, []int{9}
+	return fileDescriptor_4a6d3a83fb7b1ea9, []int{10}
 }
 
 func (m *RespCreateOrgNode) XXX_Unmarshal(b []byte) error {
@@ -688,7 +775,7 @@ func (m *ReqCreateOrgUser) Reset()         { *m = ReqCreateOrgUser{} }
 func (m *ReqCreateOrgUser) String() string { return proto.CompactTextString(m) }
 func (*ReqCreateOrgUser) ProtoMessage()    {}
 func (*ReqCreateOrgUser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a6d3a83fb7b1ea9, []int{10}
+	return fileDescriptor_4a6d3a83fb7b1ea9, []int{11}
 }
 
 func (m *ReqCreateOrgUser) XXX_Unmarshal(b []byte) error {
@@ -742,7 +829,7 @@ func (m *RespCreateOrgUser) Reset()         { *m = RespCreateOrgUser{} }
 func (m *RespCreateOrgUser) String() string { return proto.CompactTextString(m) }
 func (*RespCreateOrgUser) ProtoMessage()    {}
 func (*RespCreateOrgUser) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a6d3a83fb7b1ea9, []int{11}
+	return fileDescriptor_4a6d3a83fb7b1ea9, []int{12}
 }
 
 func (m *RespCreateOrgUser) XXX_Unmarshal(b []byte) error {
@@ -777,6 +864,684 @@ func (m *RespCreateOrgUser) GetErrMsg() string {
 	return ""
 }
 
+type ReqRevokeCert struct {
+	OrgType              OrgType              `protobuf:"varint,1,opt,name=orgType,proto3,enum=generate.OrgType" json:"orgType,omitempty"`
+	LeagueDomain         string               `protobuf:"bytes,2,opt,name=leagueDomain,proto3" json:"leagueDomain,omitempty"`
+	OrgName              string               `protobuf:"bytes,3,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	OrgDomain            string               `protobuf:"bytes,4,opt,name=orgDomain,proto3" json:"orgDomain,omitempty"`
+	SerialNumber         string               `protobuf:"bytes,5,opt,name=serialNumber,proto3" json:"serialNumber,omitempty"`
+	Reason               int32                `protobuf:"varint,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	NextUpdate           int64                `protobuf:"varint,7,opt,name=nextUpdate,proto3" json:"nextUpdate,omitempty"`
+	ChannelUpdate        *RevokeChannelUpdate `protobuf:"bytes,8,opt,name=channelUpdate,proto3" json:"channelUpdate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ReqRevokeCert) Reset()         { *m = ReqRevokeCert{} }
+func (m *ReqRevokeCert) String() string { return proto.CompactTextString(m) }
+func (*ReqRevokeCert) ProtoMessage()    {}
+func (*ReqRevokeCert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a6d3a83fb7b1ea9, []int{13}
+}
+
+func (m *ReqRevokeCert) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqRevokeCert.Unmarshal(m, b)
+}
+func (m *ReqRevokeCert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqRevokeCert.Marshal(b, m, deterministic)
+}
+func (m *ReqRevokeCert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqRevokeCert.Merge(m, src)
+}
+func (m *ReqRevokeCert) XXX_Size() int {
+	return xxx_messageInfo_ReqRevokeCert.Size(m)
+}
+func (m *ReqRevokeCert) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqRevokeCert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqRevokeCert proto.InternalMessageInfo
+
+func (m *ReqRevokeCert) GetOrgType() OrgType {
+	if m != nil {
+		return m.OrgType
+	}
+	return OrgType_Order
+}
+
+func (m *ReqRevokeCert) GetLeagueDomain() string {
+	if m != nil {
+		return m.LeagueDomain
+	}
+	return ""
+}
+
+func (m *ReqRevokeCert) GetOrgName() string {
+	if m != nil {
+		return m.OrgName
+	}
+	return ""
+}
+
+func (m *ReqRevokeCert) GetOrgDomain() string {
+	if m != nil {
+		return m.OrgDomain
+	}
+	return ""
+}
+
+func (m *ReqRevokeCert) GetSerialNumber() string {
+	if m != nil {
+		return m.SerialNumber
+	}
+	return ""
+}
+
+func (m *ReqRevokeCert) GetReason() int32 {
+	if m != nil {
+		return m.Reason
+	}
+	return 0
+}
+
+func (m *ReqRevokeCert) GetNextUpdate() int64 {
+	if m != nil {
+		return m.NextUpdate
+	}
+	return 0
+}
+
+func (m *ReqRevokeCert) GetChannelUpdate() *RevokeChannelUpdate {
+	if m != nil {
+		return m.ChannelUpdate
+	}
+	return nil
+}
+
+type RevokeChannelUpdate struct {
+	ConfigID             string          `protobuf:"bytes,1,opt,name=configID,proto3" json:"configID,omitempty"`
+	ChannelID            string          `protobuf:"bytes,2,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	OrgName              string          `protobuf:"bytes,3,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	OrgUser              string          `protobuf:"bytes,4,opt,name=orgUser,proto3" json:"orgUser,omitempty"`
+	PeerName             string          `protobuf:"bytes,5,opt,name=peerName,proto3" json:"peerName,omitempty"`
+	Signers              []*RevokeSigner `protobuf:"bytes,6,rep,name=signers,proto3" json:"signers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *RevokeChannelUpdate) Reset()         { *m = RevokeChannelUpdate{} }
+func (m *RevokeChannelUpdate) String() string { return proto.CompactTextString(m) }
+func (*RevokeChannelUpdate) ProtoMessage()    {}
+func (*RevokeChannelUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a6d3a83fb7b1ea9, []int{14}
+}
+
+func (m *RevokeChannelUpdate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeChannelUpdate.Unmarshal(m, b)
+}
+func (m *RevokeChannelUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeChannelUpdate.Marshal(b, m, deterministic)
+}
+func (m *RevokeChannelUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeChannelUpdate.Merge(m, src)
+}
+func (m *RevokeChannelUpdate) XXX_Size() int {
+	return xxx_messageInfo_RevokeChannelUpdate.Size(m)
+}
+func (m *RevokeChannelUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeChannelUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeChannelUpdate proto.InternalMessageInfo
+
+func (m *RevokeChannelUpdate) GetConfigID() string {
+	if m != nil {
+		return m.ConfigID
+	}
+	return ""
+}
+
+func (m *RevokeChannelUpdate) GetChannelID() string {
+	if m != nil {
+		return m.ChannelID
+	}
+	return ""
+}
+
+func (m *RevokeChannelUpdate) GetOrgName() string {
+	if m != nil {
+		return m.OrgName
+	}
+	return ""
+}
+
+func (m *RevokeChannelUpdate) GetOrgUser() string {
+	if m != nil {
+		return m.OrgUser
+	}
+	return ""
+}
+
+func (m *RevokeChannelUpdate) GetPeerName() string {
+	if m != nil {
+		return m.PeerName
+	}
+	return ""
+}
+
+func (m *RevokeChannelUpdate) GetSigners() []*RevokeSigner {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+type RevokeSigner struct {
+	OrgName              string   `protobuf:"bytes,1,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	OrgUser              string   `protobuf:"bytes,2,opt,name=orgUser,proto3" json:"orgUser,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeSigner) Reset()         { *m = RevokeSigner{} }
+func (m *RevokeSigner) String() string { return proto.CompactTextString(m) }
+func (*RevokeSigner) ProtoMessage()    {}
+func (*RevokeSigner) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a6d3a83fb7b1ea9, []int{15}
+}
+
+func (m *RevokeSigner) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeSigner.Unmarshal(m, b)
+}
+func (m *RevokeSigner) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeSigner.Marshal(b, m, deterministic)
+}
+func (m *RevokeSigner) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeSigner.Merge(m, src)
+}
+func (m *RevokeSigner) XXX_Size() int {
+	return xxx_messageInfo_RevokeSigner.Size(m)
+}
+func (m *RevokeSigner) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeSigner.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeSigner proto.InternalMessageInfo
+
+func (m *RevokeSigner) GetOrgName() string {
+	if m != nil {
+		return m.OrgName
+	}
+	return ""
+}
+
+func (m *RevokeSigner) GetOrgUser() string {
+	if m != nil {
+		return m.OrgUser
+	}
+	return ""
+}
+
+type RespRevokeCert struct {
+	Code                 Code     `protobuf:"varint,1,opt,name=code,proto3,enum=generate.Code" json:"code,omitempty"`
+	ErrMsg               string   `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
+	CrlPem               []byte   `protobuf:"bytes,3,opt,name=crlPem,proto3" json:"crlPem,omitempty"`
+	TxID                 string   `protobuf:"bytes,4,opt,name=txID,proto3" json:"txID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RespRevokeCert) Reset()         { *m = RespRevokeCert{} }
+func (m *RespRevokeCert) String() string { return proto.CompactTextString(m) }
+func (*RespRevokeCert) ProtoMessage()    {}
+func (*RespRevokeCert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a6d3a83fb7b1ea9, []int{16}
+}
+
+func (m *RespRevokeCert) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RespRevokeCert.Unmarshal(m, b)
+}
+func (m *RespRevokeCert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RespRevokeCert.Marshal(b, m, deterministic)
+}
+func (m *RespRevokeCert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespRevokeCert.Merge(m, src)
+}
+func (m *RespRevokeCert) XXX_Size() int {
+	return xxx_messageInfo_RespRevokeCert.Size(m)
+}
+func (m *RespRevokeCert) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespRevokeCert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespRevokeCert proto.InternalMessageInfo
+
+func (m *RespRevokeCert) GetCode() Code {
+	if m != nil {
+		return m.Code
+	}
+	return Code_Success
+}
+
+func (m *RespRevokeCert) GetErrMsg() string {
+	if m != nil {
+		return m.ErrMsg
+	}
+	return ""
+}
+
+func (m *RespRevokeCert) GetCrlPem() []byte {
+	if m != nil {
+		return m.CrlPem
+	}
+	return nil
+}
+
+func (m *RespRevokeCert) GetTxID() string {
+	if m != nil {
+		return m.TxID
+	}
+	return ""
+}
+
+type ReqVerifyMSP struct {
+	OrgType              OrgType  `protobuf:"varint,1,opt,name=orgType,proto3,enum=generate.OrgType" json:"orgType,omitempty"`
+	LeagueDomain         string   `protobuf:"bytes,2,opt,name=leagueDomain,proto3" json:"leagueDomain,omitempty"`
+	OrgName              string   `protobuf:"bytes,3,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	OrgDomain            string   `protobuf:"bytes,4,opt,name=orgDomain,proto3" json:"orgDomain,omitempty"`
+	ChildName            string   `protobuf:"bytes,5,opt,name=childName,proto3" json:"childName,omitempty"`
+	IsUser               bool     `protobuf:"varint,6,opt,name=isUser,proto3" json:"isUser,omitempty"`
+	ExpireDays           int32    `protobuf:"varint,7,opt,name=expireDays,proto3" json:"expireDays,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqVerifyMSP) Reset()         { *m = ReqVerifyMSP{} }
+func (m *ReqVerifyMSP) String() string { return proto.CompactTextString(m) }
+func (*ReqVerifyMSP) ProtoMessage()    {}
+func (*ReqVerifyMSP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a6d3a83fb7b1ea9, []int{17}
+}
+
+func (m *ReqVerifyMSP) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqVerifyMSP.Unmarshal(m, b)
+}
+func (m *ReqVerifyMSP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqVerifyMSP.Marshal(b, m, deterministic)
+}
+func (m *ReqVerifyMSP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqVerifyMSP.Merge(m, src)
+}
+func (m *ReqVerifyMSP) XXX_Size() int {
+	return xxx_messageInfo_ReqVerifyMSP.Size(m)
+}
+func (m *ReqVerifyMSP) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqVerifyMSP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqVerifyMSP proto.InternalMessageInfo
+
+func (m *ReqVerifyMSP) GetOrgType() OrgType {
+	if m != nil {
+		return m.OrgType
+	}
+	return OrgType_Order
+}
+
+func (m *ReqVerifyMSP) GetLeagueDomain() string {
+	if m != nil {
+		return m.LeagueDomain
+	}
+	return ""
+}
+
+func (m *ReqVerifyMSP) GetOrgName() string {
+	if m != nil {
+		return m.OrgName
+	}
+	return ""
+}
+
+func (m *ReqVerifyMSP) GetOrgDomain() string {
+	if m != nil {
+		return m.OrgDomain
+	}
+	return ""
+}
+
+func (m *ReqVerifyMSP) GetChildName() string {
+	if m != nil {
+		return m.ChildName
+	}
+	return ""
+}
+
+func (m *ReqVerifyMSP) GetIsUser() bool {
+	if m != nil {
+		return m.IsUser
+	}
+	return false
+}
+
+func (m *ReqVerifyMSP) GetExpireDays() int32 {
+	if m != nil {
+		return m.ExpireDays
+	}
+	return 0
+}
+
+type MSPProblem struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Warning              bool     `protobuf:"varint,3,opt,name=warning,proto3" json:"warning,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MSPProblem) Reset()         { *m = MSPProblem{} }
+func (m *MSPProblem) String() string { return proto.CompactTextString(m) }
+func (*MSPProblem) ProtoMessage()    {}
+func (*MSPProblem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a6d3a83fb7b1ea9, []int{18}
+}
+
+func (m *MSPProblem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MSPProblem.Unmarshal(m, b)
+}
+func (m *MSPProblem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MSPProblem.Marshal(b, m, deterministic)
+}
+func (m *MSPProblem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MSPProblem.Merge(m, src)
+}
+func (m *MSPProblem) XXX_Size() int {
+	return xxx_messageInfo_MSPProblem.Size(m)
+}
+func (m *MSPProblem) XXX_DiscardUnknown() {
+	xxx_messageInfo_MSPProblem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MSPProblem proto.InternalMessageInfo
+
+func (m *MSPProblem) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *MSPProblem) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *MSPProblem) GetWarning() bool {
+	if m != nil {
+		return m.Warning
+	}
+	return false
+}
+
+type RespVerifyMSP struct {
+	Code                 Code          `protobuf:"varint,1,opt,name=code,proto3,enum=generate.Code" json:"code,omitempty"`
+	ErrMsg               string        `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
+	Valid                bool          `protobuf:"varint,3,opt,name=valid,proto3" json:"valid,omitempty"`
+	Problems             []*MSPProblem `protobuf:"bytes,4,rep,name=problems,proto3" json:"problems,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *RespVerifyMSP) Reset()         { *m = RespVerifyMSP{} }
+func (m *RespVerifyMSP) String() string { return proto.CompactTextString(m) }
+func (*RespVerifyMSP) ProtoMessage()    {}
+func (*RespVerifyMSP) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a6d3a83fb7b1ea9, []int{19}
+}
+
+func (m *RespVerifyMSP) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RespVerifyMSP.Unmarshal(m, b)
+}
+func (m *RespVerifyMSP) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RespVerifyMSP.Marshal(b, m, deterministic)
+}
+func (m *RespVerifyMSP) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespVerifyMSP.Merge(m, src)
+}
+func (m *RespVerifyMSP) XXX_Size() int {
+	return xxx_messageInfo_RespVerifyMSP.Size(m)
+}
+func (m *RespVerifyMSP) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespVerifyMSP.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespVerifyMSP proto.InternalMessageInfo
+
+func (m *RespVerifyMSP) GetCode() Code {
+	if m != nil {
+		return m.Code
+	}
+	return Code_Success
+}
+
+func (m *RespVerifyMSP) GetErrMsg() string {
+	if m != nil {
+		return m.ErrMsg
+	}
+	return ""
+}
+
+func (m *RespVerifyMSP) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *RespVerifyMSP) GetProblems() []*MSPProblem {
+	if m != nil {
+		return m.Problems
+	}
+	return nil
+}
+
+type ReqListCertExpiry struct {
+	LeagueDomain         string   `protobuf:"bytes,1,opt,name=leagueDomain,proto3" json:"leagueDomain,omitempty"`
+	WithinDays           int32    `protobuf:"varint,2,opt,name=withinDays,proto3" json:"withinDays,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqListCertExpiry) Reset()         { *m = ReqListCertExpiry{} }
+func (m *ReqListCertExpiry) String() string { return proto.CompactTextString(m) }
+func (*ReqListCertExpiry) ProtoMessage()    {}
+func (*ReqListCertExpiry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a6d3a83fb7b1ea9, []int{20}
+}
+
+func (m *ReqListCertExpiry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqListCertExpiry.Unmarshal(m, b)
+}
+func (m *ReqListCertExpiry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqListCertExpiry.Marshal(b, m, deterministic)
+}
+func (m *ReqListCertExpiry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqListCertExpiry.Merge(m, src)
+}
+func (m *ReqListCertExpiry) XXX_Size() int {
+	return xxx_messageInfo_ReqListCertExpiry.Size(m)
+}
+func (m *ReqListCertExpiry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqListCertExpiry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqListCertExpiry proto.InternalMessageInfo
+
+func (m *ReqListCertExpiry) GetLeagueDomain() string {
+	if m != nil {
+		return m.LeagueDomain
+	}
+	return ""
+}
+
+func (m *ReqListCertExpiry) GetWithinDays() int32 {
+	if m != nil {
+		return m.WithinDays
+	}
+	return 0
+}
+
+type CertExpiry struct {
+	Path                 string   `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Source               string   `protobuf:"bytes,2,opt,name=source,proto3" json:"source,omitempty"`
+	Subject              string   `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	Issuer               string   `protobuf:"bytes,4,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	SerialNumber         string   `protobuf:"bytes,5,opt,name=serialNumber,proto3" json:"serialNumber,omitempty"`
+	NotAfter             int64    `protobuf:"varint,6,opt,name=notAfter,proto3" json:"notAfter,omitempty"`
+	DaysRemaining        int32    `protobuf:"varint,7,opt,name=daysRemaining,proto3" json:"daysRemaining,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CertExpiry) Reset()         { *m = CertExpiry{} }
+func (m *CertExpiry) String() string { return proto.CompactTextString(m) }
+func (*CertExpiry) ProtoMessage()    {}
+func (*CertExpiry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a6d3a83fb7b1ea9, []int{21}
+}
+
+func (m *CertExpiry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CertExpiry.Unmarshal(m, b)
+}
+func (m *CertExpiry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CertExpiry.Marshal(b, m, deterministic)
+}
+func (m *CertExpiry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CertExpiry.Merge(m, src)
+}
+func (m *CertExpiry) XXX_Size() int {
+	return xxx_messageInfo_CertExpiry.Size(m)
+}
+func (m *CertExpiry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CertExpiry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CertExpiry proto.InternalMessageInfo
+
+func (m *CertExpiry) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *CertExpiry) GetSource() string {
+	if m != nil {
+		return m.Source
+	}
+	return ""
+}
+
+func (m *CertExpiry) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *CertExpiry) GetIssuer() string {
+	if m != nil {
+		return m.Issuer
+	}
+	return ""
+}
+
+func (m *CertExpiry) GetSerialNumber() string {
+	if m != nil {
+		return m.SerialNumber
+	}
+	return ""
+}
+
+func (m *CertExpiry) GetNotAfter() int64 {
+	if m != nil {
+		return m.NotAfter
+	}
+	return 0
+}
+
+func (m *CertExpiry) GetDaysRemaining() int32 {
+	if m != nil {
+		return m.DaysRemaining
+	}
+	return 0
+}
+
+type RespListCertExpiry struct {
+	Code                 Code          `protobuf:"varint,1,opt,name=code,proto3,enum=generate.Code" json:"code,omitempty"`
+	ErrMsg               string        `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
+	Certs                []*CertExpiry `protobuf:"bytes,3,rep,name=certs,proto3" json:"certs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *RespListCertExpiry) Reset()         { *m = RespListCertExpiry{} }
+func (m *RespListCertExpiry) String() string { return proto.CompactTextString(m) }
+func (*RespListCertExpiry) ProtoMessage()    {}
+func (*RespListCertExpiry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4a6d3a83fb7b1ea9, []int{22}
+}
+
+func (m *RespListCertExpiry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RespListCertExpiry.Unmarshal(m, b)
+}
+func (m *RespListCertExpiry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RespListCertExpiry.Marshal(b, m, deterministic)
+}
+func (m *RespListCertExpiry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespListCertExpiry.Merge(m, src)
+}
+func (m *RespListCertExpiry) XXX_Size() int {
+	return xxx_messageInfo_RespListCertExpiry.Size(m)
+}
+func (m *RespListCertExpiry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespListCertExpiry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespListCertExpiry proto.InternalMessageInfo
+
+func (m *RespListCertExpiry) GetCode() Code {
+	if m != nil {
+		return m.Code
+	}
+	return Code_Success
+}
+
+func (m *RespListCertExpiry) GetErrMsg() string {
+	if m != nil {
+		return m.ErrMsg
+	}
+	return ""
+}
+
+func (m *RespListCertExpiry) GetCerts() []*CertExpiry {
+	if m != nil {
+		return m.Certs
+	}
+	return nil
+}
+
 type OrgChild struct {
 	LeagueDomain         string        `protobuf:"bytes,2,opt,name=leagueDomain,proto3" json:"leagueDomain,omitempty"`
 	OrgName              string        `protobuf:"bytes,3,opt,name=orgName,proto3" json:"orgName,omitempty"`
@@ -785,6 +1550,7 @@ type OrgChild struct {
 	PubTlsData           []byte        `protobuf:"bytes,7,opt,name=pubTlsData,proto3" json:"pubTlsData,omitempty"`
 	SignAlgorithm        SignAlgorithm `protobuf:"varint,8,opt,name=signAlgorithm,proto3,enum=generate.SignAlgorithm" json:"signAlgorithm,omitempty"`
 	EnrollInfo           *EnrollInfo   `protobuf:"bytes,9,opt,name=enrollInfo,proto3" json:"enrollInfo,omitempty"`
+	LocalSign            bool          `protobuf:"varint,10,opt,name=localSign,proto3" json:"localSign,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -794,7 +1560,7 @@ func (m *OrgChild) Reset()         { *m = OrgChild{} }
 func (m *OrgChild) String() string { return proto.CompactTextString(m) }
 func (*OrgChild) ProtoMessage()    {}
 func (*OrgChild) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a6d3a83fb7b1ea9, []int{12}
+	return fileDescriptor_4a6d3a83fb7b1ea9, []int{23}
 }
 
 func (m *OrgChild) XXX_Unmarshal(b []byte) error {
@@ -864,6 +1630,13 @@ func (m *OrgChild) GetEnrollInfo() *EnrollInfo {
 	return nil
 }
 
+func (m *OrgChild) GetLocalSign() bool {
+	if m != nil {
+		return m.LocalSign
+	}
+	return false
+}
+
 type EnrollInfo struct {
 	CsrPem               []byte         `protobuf:"bytes,1,opt,name=csrPem,proto3" json:"csrPem,omitempty"`
 	FabricCaServerURL    string         `protobuf:"bytes,2,opt,name=fabricCaServerURL,proto3" json:"fabricCaServerURL,omitempty"`
@@ -879,7 +1652,7 @@ func (m *EnrollInfo) Reset()         { *m = EnrollInfo{} }
 func (m *EnrollInfo) String() string { return proto.CompactTextString(m) }
 func (*EnrollInfo) ProtoMessage()    {}
 func (*EnrollInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a6d3a83fb7b1ea9, []int{13}
+	return fileDescriptor_4a6d3a83fb7b1ea9, []int{24}
 }
 
 func (m *EnrollInfo) XXX_Unmarshal(b []byte) error {
@@ -953,7 +1726,7 @@ func (m *EnrollRequest) Reset()         { *m = EnrollRequest{} }
 func (m *EnrollRequest) String() string { return proto.CompactTextString(m) }
 func (*EnrollRequest) ProtoMessage()    {}
 func (*EnrollRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a6d3a83fb7b1ea9, []int{14}
+	return fileDescriptor_4a6d3a83fb7b1ea9, []int{25}
 }
 
 func (m *EnrollRequest) XXX_Unmarshal(b []byte) error {
@@ -1042,7 +1815,7 @@ func (m *EnrollAttribute) Reset()         { *m = EnrollAttribute{} }
 func (m *EnrollAttribute) String() string { return proto.CompactTextString(m) }
 func (*EnrollAttribute) ProtoMessage()    {}
 func (*EnrollAttribute) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a6d3a83fb7b1ea9, []int{15}
+	return fileDescriptor_4a6d3a83fb7b1ea9, []int{26}
 }
 
 func (m *EnrollAttribute) XXX_Unmarshal(b []byte) error {
@@ -1097,7 +1870,7 @@ func (m *CSR) Reset()         { *m = CSR{} }
 func (m *CSR) String() string { return proto.CompactTextString(m) }
 func (*CSR) ProtoMessage()    {}
 func (*CSR) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4a6d3a83fb7b1ea9, []int{16}
+	return fileDescriptor_4a6d3a83fb7b1ea9, []int{27}
 }
 
 func (m *CSR) XXX_Unmarshal(b []byte) error {
@@ -1187,6 +1960,7 @@ func init() {
 	proto.RegisterType((*ReqCreateLeague)(nil), "generate.ReqCreateLeague")
 	proto.RegisterType((*RespCreateLeague)(nil), "generate.RespCreateLeague")
 	proto.RegisterType((*ReqCreateOrg)(nil), "generate.ReqCreateOrg")
+	proto.RegisterType((*IntermediateCA)(nil), "generate.IntermediateCA")
 	proto.RegisterType((*RespCreateOrg)(nil), "generate.RespCreateOrg")
 	proto.RegisterType((*ReqCreateCsr)(nil), "generate.ReqCreateCsr")
 	proto.RegisterType((*RespCreateCsr)(nil), "generate.RespCreateCsr")
@@ -1194,6 +1968,16 @@ func init() {
 	proto.RegisterType((*RespCreateOrgNode)(nil), "generate.RespCreateOrgNode")
 	proto.RegisterType((*ReqCreateOrgUser)(nil), "generate.ReqCreateOrgUser")
 	proto.RegisterType((*RespCreateOrgUser)(nil), "generate.RespCreateOrgUser")
+	proto.RegisterType((*ReqRevokeCert)(nil), "generate.ReqRevokeCert")
+	proto.RegisterType((*RevokeChannelUpdate)(nil), "generate.RevokeChannelUpdate")
+	proto.RegisterType((*RevokeSigner)(nil), "generate.RevokeSigner")
+	proto.RegisterType((*RespRevokeCert)(nil), "generate.RespRevokeCert")
+	proto.RegisterType((*ReqVerifyMSP)(nil), "generate.ReqVerifyMSP")
+	proto.RegisterType((*MSPProblem)(nil), "generate.MSPProblem")
+	proto.RegisterType((*RespVerifyMSP)(nil), "generate.RespVerifyMSP")
+	proto.RegisterType((*ReqListCertExpiry)(nil), "generate.ReqListCertExpiry")
+	proto.RegisterType((*CertExpiry)(nil), "generate.CertExpiry")
+	proto.RegisterType((*RespListCertExpiry)(nil), "generate.RespListCertExpiry")
 	proto.RegisterType((*OrgChild)(nil), "generate.OrgChild")
 	proto.RegisterType((*EnrollInfo)(nil), "generate.EnrollInfo")
 	proto.RegisterType((*EnrollRequest)(nil), "generate.EnrollRequest")
@@ -1204,67 +1988,100 @@ func init() {
 func init() { proto.RegisterFile("grpc/proto/generate/cert.proto", fileDescriptor_4a6d3a83fb7b1ea9) }
 
 var fileDescriptor_4a6d3a83fb7b1ea9 = []byte{
-	// 992 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcb, 0x6e, 0x24, 0x35,
-	0x14, 0xa5, 0x52, 0x79, 0x74, 0x39, 0x9d, 0x0c, 0x31, 0xa3, 0xa1, 0x88, 0xd0, 0x4c, 0x28, 0x21,
-	0x34, 0xe2, 0xd1, 0x91, 0x02, 0x4b, 0x58, 0x74, 0x3a, 0x41, 0xa0, 0x84, 0x4e, 0xe4, 0x4c, 0x36,
-	0xec, 0xdc, 0xd5, 0xb7, 0x2b, 0x96, 0xaa, 0xed, 0x8a, 0xed, 0x1e, 0xa9, 0xf9, 0x03, 0xc4, 0x82,
-	0x0f, 0xe0, 0x3b, 0xf8, 0x02, 0x96, 0x88, 0xbf, 0xe0, 0x17, 0xd8, 0x23, 0xdf, 0x7a, 0xb9, 0x92,
-	0x46, 0x22, 0x13, 0xcd, 0xae, 0xcf, 0x3d, 0xc7, 0xd5, 0xf7, 0x1c, 0x5f, 0xbb, 0x8a, 0x3c, 0xcf,
-	0x74, 0x91, 0x1e, 0x16, 0x5a, 0x59, 0x75, 0x98, 0x81, 0x04, 0xcd, 0x2d, 0x1c, 0xa6, 0xa0, 0xed,
-	0x00, 0x6b, 0xb4, 0x57, 0x17, 0xf7, 0x0f, 0x56, 0x29, 0x35, 0x98, 0x45, 0x5e, 0x69, 0xf7, 0x5f,
-	0xac, 0x52, 0x80, 0x5c, 0xcc, 0x4d, 0x29, 0x48, 0xfe, 0x0a, 0x48, 0x9f, 0xc1, 0xed, 0x19, 0x2c,
-	0x47, 0x4a, 0xce, 0x44, 0x46, 0xbf, 0x22, 0x24, 0xd5, 0xcb, 0xc2, 0xaa, 0x57, 0xcb, 0x02, 0xe2,
-	0xe0, 0x20, 0x78, 0xb9, 0x7b, 0xf4, 0x74, 0x50, 0xaf, 0x1d, 0x8c, 0x1a, 0x8e, 0x79, 0x3a, 0xfa,
-	0x35, 0xe9, 0x43, 0x9a, 0x0e, 0xf3, 0x4c, 0x69, 0x61, 0x6f, 0xe6, 0xf1, 0x1a, 0xae, 0x7b, 0xd6,
-	0xae, 0x3b, 0xf5, 0xd8, 0xef, 0xde, 0x61, 0x1d, 0xb5, 0x5b, 0xad, 0x0d, 0x6f, 0x57, 0x87, 0x77,
-	0x57, 0x33, 0x8f, 0x75, 0xab, 0x7d, 0xf5, 0xf1, 0x36, 0x89, 0x1a, 0x90, 0xfc, 0x16, 0x90, 0x1d,
-	0x06, 0xa6, 0x68, 0x0d, 0x25, 0x64, 0x3d, 0x55, 0xd3, 0xda, 0xca, 0xae, 0x67, 0x45, 0x4d, 0x81,
-	0x21, 0x47, 0x9f, 0x91, 0x4d, 0xd0, 0xfa, 0x07, 0x93, 0x61, 0xe3, 0x11, 0xab, 0x10, 0xfd, 0x84,
-	0xec, 0x16, 0x5a, 0x9c, 0xc1, 0xf2, 0x5b, 0x91, 0xc3, 0x25, 0xb7, 0x37, 0xd8, 0x5a, 0xc4, 0xee,
-	0x54, 0x51, 0xb7, 0x98, 0xf8, 0xba, 0xf5, 0x4a, 0xd7, 0xa9, 0x26, 0x7f, 0x04, 0xe4, 0x09, 0x83,
-	0xdb, 0x91, 0x06, 0x6e, 0xe1, 0x1c, 0x78, 0xb6, 0xc0, 0xff, 0x9e, 0xaa, 0x39, 0x17, 0x12, 0x3b,
-	0x8c, 0x58, 0x85, 0x68, 0x4c, 0xb6, 0x0a, 0x2d, 0x4e, 0xb8, 0xe5, 0xd8, 0x54, 0x9f, 0xd5, 0x90,
-	0x3e, 0x27, 0xa4, 0xd0, 0xe2, 0x55, 0x6e, 0x90, 0x0c, 0x91, 0xf4, 0x2a, 0xf4, 0x05, 0x09, 0x53,
-	0xa3, 0xb1, 0x85, 0xed, 0xa3, 0x1d, 0xcf, 0xf0, 0x15, 0x63, 0x8e, 0xa1, 0xdf, 0x90, 0x1d, 0x23,
-	0x32, 0xd9, 0x06, 0xbe, 0x81, 0xd9, 0xbc, 0xdf, 0x4a, 0xaf, 0x7c, 0x9a, 0x75, 0xd5, 0xc9, 0x98,
-	0xbc, 0xeb, 0x22, 0xee, 0xb8, 0x78, 0x44, 0xca, 0xc9, 0x2f, 0xe5, 0x0c, 0x96, 0xcf, 0xbb, 0xd0,
-	0x19, 0xfd, 0x8c, 0x6c, 0x29, 0x9d, 0x79, 0x03, 0xb8, 0xd7, 0x3e, 0xef, 0xa2, 0x24, 0x58, 0xad,
-	0xa0, 0x09, 0xe9, 0xe7, 0xd8, 0xc3, 0x49, 0x99, 0x62, 0xf9, 0xec, 0x4e, 0x8d, 0x52, 0xb2, 0x2e,
-	0xf9, 0x1c, 0xaa, 0xdd, 0xc3, 0xdf, 0x5e, 0xee, 0xeb, 0x7e, 0xee, 0xc9, 0x59, 0x39, 0x40, 0x6d,
-	0x37, 0x8f, 0xb1, 0xf6, 0xb7, 0x6f, 0x6d, 0x64, 0xf4, 0xff, 0xea, 0x36, 0x46, 0xfb, 0xe3, 0xb6,
-	0xe1, 0x1a, 0xd2, 0x0f, 0x49, 0xa4, 0x74, 0x76, 0xe2, 0xb7, 0xdd, 0x16, 0x5c, 0x13, 0xe5, 0x5c,
-	0xe2, 0x7e, 0xf6, 0x59, 0x85, 0xe8, 0x47, 0x95, 0xfb, 0xcd, 0x55, 0x03, 0x51, 0x86, 0x71, 0x6f,
-	0x22, 0xb6, 0x1e, 0x34, 0x11, 0x9d, 0xcc, 0x4a, 0x9b, 0x6f, 0x9e, 0x99, 0x72, 0xe3, 0xd5, 0x4e,
-	0xc3, 0xd8, 0x69, 0x1f, 0x34, 0x11, 0x03, 0xd2, 0x53, 0x3a, 0x1b, 0xdd, 0x88, 0x7c, 0x8a, 0x8f,
-	0xde, 0x3e, 0xa2, 0x1d, 0x35, 0x32, 0xac, 0xd1, 0x24, 0x17, 0x64, 0xaf, 0xb3, 0xe3, 0xf8, 0x8f,
-	0x8f, 0x71, 0xf0, 0x73, 0xd0, 0xb5, 0x70, 0x6d, 0x40, 0xbf, 0x55, 0x0b, 0x6e, 0x64, 0x84, 0x19,
-	0x4e, 0xe7, 0x42, 0xe2, 0xc8, 0xf4, 0x58, 0x0d, 0xef, 0x99, 0xc3, 0x5e, 0x1e, 0x63, 0xee, 0xd7,
-	0x35, 0xd2, 0xab, 0x3b, 0x78, 0xab, 0xe3, 0x5c, 0x1f, 0xda, 0x0d, 0xef, 0xd0, 0xba, 0xab, 0x6f,
-	0x31, 0xa9, 0xaf, 0xbe, 0xad, 0xea, 0xea, 0x6b, 0x2a, 0xf7, 0xe7, 0xb8, 0xf7, 0x90, 0x39, 0x76,
-	0x2f, 0x3f, 0x90, 0x5a, 0xe5, 0xf9, 0xf7, 0x72, 0xa6, 0xe2, 0x08, 0x83, 0xf7, 0x5e, 0x7e, 0xa7,
-	0x0d, 0xc7, 0x3c, 0x5d, 0xf2, 0x67, 0x40, 0x48, 0x4b, 0xb9, 0xe0, 0x52, 0xa3, 0x2f, 0x61, 0x8e,
-	0xf1, 0xf6, 0x59, 0x85, 0xe8, 0xe7, 0x64, 0x6f, 0xc6, 0x27, 0x5a, 0xa4, 0x23, 0x7e, 0x05, 0xfa,
-	0x35, 0xe8, 0x6b, 0x76, 0x5e, 0x05, 0x76, 0x9f, 0x70, 0xd9, 0x48, 0x65, 0x8f, 0x61, 0xa6, 0x74,
-	0x99, 0x5b, 0xc8, 0xda, 0x02, 0xdd, 0x27, 0x3d, 0xa9, 0xec, 0x70, 0x66, 0xa1, 0xbc, 0xe7, 0x43,
-	0xd6, 0x60, 0x97, 0x41, 0xd9, 0x1c, 0x83, 0xdb, 0x05, 0x18, 0x8b, 0x01, 0x6e, 0xfb, 0x19, 0x9c,
-	0xfa, 0x34, 0xeb, 0xaa, 0x93, 0x7f, 0x02, 0xb2, 0xd3, 0x11, 0xb8, 0x3f, 0xab, 0xdc, 0x9e, 0x54,
-	0xef, 0xa8, 0x06, 0x3b, 0xb3, 0x06, 0x52, 0x0d, 0xb6, 0x9e, 0x92, 0x12, 0x35, 0x77, 0x4e, 0xf8,
-	0xdf, 0x77, 0x0e, 0xbe, 0xe0, 0xd4, 0x4c, 0xe4, 0x50, 0xed, 0x7d, 0x0d, 0xe9, 0x53, 0xb2, 0x91,
-	0xf3, 0x09, 0xe4, 0xd5, 0xd6, 0x97, 0x00, 0x73, 0xe5, 0xe3, 0xfa, 0x22, 0x8b, 0x58, 0x85, 0x9c,
-	0xfa, 0x46, 0x19, 0x6b, 0xe2, 0xad, 0x83, 0xd0, 0xa9, 0x11, 0xd0, 0x43, 0xb2, 0xc1, 0xad, 0xd5,
-	0x26, 0xee, 0x1d, 0x84, 0x2f, 0xb7, 0x8f, 0x3e, 0xb8, 0xeb, 0x7e, 0x68, 0xad, 0x16, 0x93, 0x85,
-	0x05, 0x56, 0xea, 0x92, 0x21, 0x79, 0x72, 0x87, 0x69, 0x26, 0x30, 0xf0, 0x26, 0x70, 0x9f, 0xf4,
-	0x54, 0x61, 0x85, 0x92, 0x3c, 0x47, 0xcb, 0x3d, 0xd6, 0xe0, 0xe4, 0xf7, 0x35, 0x12, 0x8e, 0xae,
-	0x98, 0x73, 0x96, 0xaa, 0x85, 0xb4, 0x7a, 0x19, 0x07, 0xd8, 0x53, 0x0d, 0xdd, 0x79, 0x51, 0x3a,
-	0xe3, 0x52, 0xfc, 0xc4, 0xdd, 0x9a, 0x78, 0x0d, 0xe9, 0x4e, 0x8d, 0x0e, 0x08, 0xf5, 0x31, 0xcf,
-	0xaf, 0xa5, 0xb0, 0x71, 0x88, 0xca, 0x15, 0x8c, 0xeb, 0x28, 0x57, 0x29, 0xcf, 0x85, 0x5d, 0xc6,
-	0xeb, 0xa8, 0x6a, 0xb0, 0xe3, 0x0a, 0xad, 0x5e, 0x0b, 0x99, 0xba, 0x73, 0x84, 0x5c, 0x8d, 0xe9,
-	0xc7, 0x64, 0xc7, 0x58, 0x0d, 0x60, 0x87, 0xd3, 0xa9, 0x06, 0x63, 0xe2, 0x4d, 0x14, 0x74, 0x8b,
-	0x78, 0xe2, 0x94, 0xb1, 0x3c, 0x77, 0x57, 0x43, 0x15, 0xb1, 0x57, 0x71, 0x8e, 0x0c, 0x68, 0xc1,
-	0xf3, 0xf1, 0x62, 0x3e, 0x01, 0x8d, 0x07, 0x2e, 0x62, 0x9d, 0x9a, 0x7b, 0x46, 0xaa, 0xe6, 0x73,
-	0x25, 0x71, 0xf7, 0x22, 0x54, 0x78, 0x95, 0xe3, 0x73, 0xf2, 0xa9, 0x50, 0x03, 0x90, 0x52, 0xa9,
-	0x41, 0x79, 0x12, 0xbe, 0x48, 0x73, 0x01, 0xd2, 0x0e, 0xdc, 0xe7, 0x6b, 0xf9, 0xa5, 0xda, 0xec,
-	0xdf, 0x71, 0x34, 0x02, 0x6d, 0x2f, 0x5d, 0xed, 0xc7, 0xf7, 0x56, 0x7c, 0xde, 0x4e, 0x36, 0x11,
-	0x7f, 0xf9, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x73, 0x0d, 0xf8, 0x48, 0x48, 0x0b, 0x00, 0x00,
+	// 1520 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6e, 0x1b, 0xc9,
+	0x11, 0xce, 0x90, 0x22, 0x45, 0x96, 0x7e, 0x6c, 0x8f, 0x0d, 0x85, 0x11, 0x12, 0x59, 0x19, 0x04,
+	0x81, 0x11, 0x27, 0x94, 0xe1, 0xf8, 0x18, 0x03, 0x91, 0x28, 0x05, 0x11, 0xac, 0x3f, 0xb4, 0xac,
+	0x04, 0xc8, 0xad, 0x39, 0x2c, 0x51, 0x9d, 0x0c, 0xa7, 0xa9, 0xee, 0xa6, 0x2c, 0x06, 0x79, 0x81,
+	0x9c, 0x73, 0xcc, 0x33, 0xe4, 0x98, 0xc3, 0x9e, 0xf7, 0xb6, 0x8b, 0x05, 0x76, 0xdf, 0x61, 0x81,
+	0x3d, 0xed, 0x71, 0xef, 0x8b, 0xae, 0xe9, 0x99, 0xe9, 0xa1, 0x68, 0xc3, 0xb2, 0xd6, 0xc0, 0xde,
+	0xf8, 0x55, 0x55, 0x4f, 0x57, 0x7d, 0xf5, 0x33, 0xc5, 0x81, 0x8d, 0xa1, 0x1a, 0xc7, 0x5b, 0x63,
+	0x25, 0x8d, 0xdc, 0x1a, 0x62, 0x8a, 0x8a, 0x1b, 0xdc, 0x8a, 0x51, 0x99, 0x2e, 0xc9, 0xc2, 0x56,
+	0x2e, 0x5c, 0xdf, 0x9c, 0x67, 0xa9, 0x50, 0x4f, 0x12, 0x67, 0xbb, 0xfe, 0x78, 0x9e, 0x05, 0xa6,
+	0x93, 0x91, 0xce, 0x0c, 0xa2, 0x2f, 0x02, 0x58, 0x66, 0x78, 0xf9, 0x0a, 0xa7, 0x3d, 0x99, 0x9e,
+	0x8b, 0x61, 0xf8, 0x02, 0x20, 0x56, 0xd3, 0xb1, 0x91, 0xaf, 0xa7, 0x63, 0xec, 0x04, 0x9b, 0xc1,
+	0x93, 0xd5, 0xe7, 0x8f, 0xba, 0xf9, 0xd9, 0x6e, 0xaf, 0xd0, 0x31, 0xcf, 0x2e, 0xfc, 0x03, 0x2c,
+	0x63, 0x1c, 0x6f, 0x27, 0x43, 0xa9, 0x84, 0xb9, 0x18, 0x75, 0x6a, 0x74, 0x6e, 0xad, 0x3c, 0xb7,
+	0xe7, 0x69, 0xff, 0xfc, 0x13, 0x56, 0xb1, 0xb6, 0xa7, 0x95, 0xe6, 0xe5, 0xe9, 0xfa, 0xec, 0x69,
+	0xa6, 0x79, 0xe5, 0xb4, 0x6f, 0xbd, 0xb3, 0x04, 0xed, 0x02, 0x44, 0xff, 0x0d, 0x60, 0x85, 0xa1,
+	0x1e, 0x97, 0x01, 0x45, 0xb0, 0x10, 0xcb, 0x41, 0x1e, 0xca, 0xaa, 0x17, 0x8a, 0x1c, 0x20, 0x23,
+	0x5d, 0xb8, 0x06, 0x4d, 0x54, 0xea, 0x50, 0x0f, 0xc9, 0xf1, 0x36, 0x73, 0x28, 0xfc, 0x35, 0xac,
+	0x8e, 0x95, 0x78, 0x85, 0xd3, 0x3f, 0x89, 0x04, 0x4f, 0xb8, 0xb9, 0x20, 0xd7, 0xda, 0x6c, 0x46,
+	0x4a, 0x76, 0x93, 0xbe, 0x6f, 0xb7, 0xe0, 0xec, 0x2a, 0xd2, 0xe8, 0xd3, 0x00, 0xee, 0x31, 0xbc,
+	0xec, 0x29, 0xe4, 0x06, 0x0f, 0x90, 0x0f, 0x27, 0x74, 0xf7, 0x40, 0x8e, 0xb8, 0x48, 0xc9, 0xc3,
+	0x36, 0x73, 0x28, 0xec, 0xc0, 0xe2, 0x58, 0x89, 0x5d, 0x6e, 0x38, 0x39, 0xb5, 0xcc, 0x72, 0x18,
+	0x6e, 0x00, 0x8c, 0x95, 0x78, 0x9d, 0x68, 0x52, 0xd6, 0x49, 0xe9, 0x49, 0xc2, 0xc7, 0x50, 0x8f,
+	0xb5, 0x22, 0x17, 0x96, 0x9e, 0xaf, 0x78, 0x01, 0x9f, 0x32, 0x66, 0x35, 0xe1, 0x4b, 0x58, 0xd1,
+	0x62, 0x98, 0x96, 0x84, 0x37, 0x88, 0x9b, 0x9f, 0x96, 0xa6, 0xa7, 0xbe, 0x9a, 0x55, 0xad, 0xa3,
+	0x23, 0xb8, 0x6f, 0x29, 0xae, 0x44, 0x71, 0x07, 0x96, 0xa3, 0x6f, 0xb2, 0x1a, 0xcc, 0x9e, 0x77,
+	0xac, 0x86, 0xe1, 0x53, 0x58, 0x94, 0x6a, 0xe8, 0x15, 0xe0, 0x83, 0xf2, 0x79, 0xc7, 0x99, 0x82,
+	0xe5, 0x16, 0x61, 0x04, 0xcb, 0x09, 0xf9, 0xb0, 0x9b, 0xb1, 0x98, 0x3d, 0xbb, 0x22, 0x0b, 0x43,
+	0x58, 0x48, 0xf9, 0x08, 0x5d, 0xf6, 0xe8, 0xb7, 0xc7, 0xfb, 0xc2, 0x2c, 0xef, 0xa9, 0x1c, 0xe0,
+	0xf1, 0x99, 0x26, 0x5a, 0x5a, 0x2c, 0x87, 0xe1, 0x1f, 0x61, 0x55, 0xa4, 0x06, 0xd5, 0x08, 0x07,
+	0x82, 0x1b, 0xec, 0x6d, 0x77, 0x9a, 0x44, 0x71, 0xa7, 0xf4, 0x6e, 0xbf, 0xa2, 0x67, 0x33, 0xf6,
+	0xd1, 0x67, 0x01, 0xac, 0x56, 0x4d, 0xfc, 0x34, 0x07, 0xef, 0x4a, 0x73, 0xed, 0x6d, 0x69, 0xae,
+	0xbf, 0x7f, 0x9a, 0x17, 0x6e, 0x93, 0x66, 0x7b, 0xff, 0x88, 0x5f, 0xdb, 0xba, 0x3d, 0xc0, 0x94,
+	0xb8, 0x68, 0x30, 0x4f, 0x12, 0xbd, 0xca, 0x3a, 0xad, 0x4c, 0xdb, 0x5d, 0x6a, 0xe0, 0x6b, 0xbf,
+	0x06, 0x7a, 0x5a, 0xbd, 0x57, 0x5a, 0x3b, 0x54, 0x27, 0x47, 0x65, 0x66, 0x73, 0x18, 0xfe, 0x1c,
+	0xda, 0x52, 0x0d, 0x77, 0xfd, 0xfc, 0x96, 0x02, 0xeb, 0x44, 0xd6, 0xc0, 0x14, 0xd5, 0x32, 0x73,
+	0x28, 0xfc, 0xa5, 0x2b, 0x93, 0xe6, 0x3c, 0x4a, 0x49, 0x75, 0x93, 0xd3, 0xc5, 0x5b, 0xb5, 0x4e,
+	0x85, 0xb3, 0x2c, 0xcc, 0x0f, 0xe7, 0x4c, 0xc2, 0xfd, 0x82, 0xb2, 0x63, 0x35, 0x3c, 0xb2, 0xb6,
+	0xb7, 0x6a, 0x9d, 0x2e, 0xb4, 0xa4, 0x1a, 0xf6, 0x2e, 0x44, 0x32, 0xa0, 0x47, 0x2f, 0x3d, 0x0f,
+	0x2b, 0xd6, 0xa4, 0x61, 0x85, 0x4d, 0x74, 0x0c, 0x0f, 0x2a, 0x19, 0xa7, 0x1b, 0xef, 0x12, 0xc1,
+	0xbf, 0x83, 0x6a, 0x08, 0x67, 0x1a, 0xd5, 0x47, 0x0d, 0xc1, 0x96, 0x8c, 0xd0, 0xdb, 0x83, 0x91,
+	0x48, 0xa9, 0x64, 0x5a, 0x2c, 0x87, 0x37, 0x82, 0x23, 0x5f, 0xee, 0x12, 0xdc, 0x27, 0x35, 0x9b,
+	0xec, 0x4b, 0x86, 0x57, 0xf2, 0x1f, 0xd8, 0x43, 0x65, 0x7e, 0xf8, 0xb9, 0xf6, 0xa1, 0x0d, 0x10,
+	0xc1, 0xb2, 0x46, 0x25, 0x78, 0x72, 0x34, 0x19, 0xf5, 0x51, 0x51, 0x1b, 0xb4, 0x59, 0x45, 0x66,
+	0xc3, 0x52, 0xc8, 0xb5, 0x4c, 0xa9, 0x1d, 0x1a, 0xcc, 0x21, 0x3b, 0x16, 0x52, 0xbc, 0x36, 0x67,
+	0xe3, 0x01, 0x37, 0x48, 0xe5, 0x5f, 0x67, 0x9e, 0x24, 0xec, 0xc1, 0x4a, 0x7c, 0xc1, 0xd3, 0x14,
+	0x13, 0x67, 0xd2, 0xa2, 0xb4, 0xfc, 0xc2, 0x7b, 0x9b, 0x67, 0x8c, 0xf8, 0x46, 0xac, 0x7a, 0x26,
+	0xfa, 0x2a, 0x80, 0x87, 0x73, 0xcc, 0xc2, 0x75, 0x68, 0xc5, 0xf4, 0x5a, 0xdf, 0xdf, 0x75, 0xaf,
+	0xcb, 0x02, 0xdb, 0x90, 0xdd, 0x43, 0xf6, 0x77, 0x1d, 0x5b, 0xa5, 0xe0, 0x1d, 0x54, 0x65, 0x1a,
+	0x9b, 0x6e, 0x47, 0x54, 0x0e, 0xed, 0x6d, 0x63, 0x44, 0x45, 0x87, 0x32, 0x8a, 0x0a, 0x1c, 0x3e,
+	0x83, 0x45, 0xdb, 0xda, 0xa8, 0x74, 0xa7, 0xb9, 0x59, 0x7f, 0xb2, 0x54, 0x59, 0x57, 0xc8, 0xf3,
+	0x53, 0x52, 0xb3, 0xdc, 0x2c, 0xda, 0x81, 0x65, 0x5f, 0xe1, 0x7b, 0x14, 0xbc, 0xd5, 0xa3, 0x5a,
+	0xc5, 0xa3, 0xe8, 0x1a, 0x56, 0x6d, 0x91, 0x7a, 0x35, 0x75, 0x97, 0xf5, 0x66, 0x0d, 0x9a, 0xb1,
+	0x4a, 0x4e, 0x70, 0xe4, 0x96, 0x08, 0x87, 0xec, 0xeb, 0xd2, 0x5c, 0xef, 0xef, 0x3a, 0x3a, 0xe8,
+	0x77, 0xf4, 0x6d, 0x36, 0xa0, 0xff, 0x82, 0x4a, 0x9c, 0x4f, 0x0f, 0x4f, 0x4f, 0x7e, 0x3c, 0xc5,
+	0x4c, 0x79, 0x17, 0xc9, 0xc0, 0x4b, 0x53, 0x29, 0xb0, 0x31, 0x0a, 0x4d, 0x54, 0x36, 0xa9, 0xdf,
+	0x1d, 0xb2, 0x65, 0x8c, 0xd7, 0x63, 0xa1, 0x70, 0x97, 0x4f, 0x35, 0x95, 0x71, 0x83, 0x79, 0x92,
+	0xe8, 0x35, 0xc0, 0xe1, 0xe9, 0xc9, 0x89, 0x92, 0xfd, 0x24, 0x63, 0x64, 0x6c, 0xd7, 0xba, 0x2c,
+	0x51, 0xf4, 0xdb, 0xfa, 0x3b, 0x42, 0xad, 0xf9, 0x10, 0xf3, 0x2c, 0x39, 0x68, 0x35, 0x6f, 0xb8,
+	0x4a, 0x45, 0x3a, 0xcc, 0x87, 0x8c, 0x83, 0xd1, 0x7f, 0xdc, 0x7a, 0x5a, 0xd2, 0x78, 0x97, 0xfc,
+	0x3d, 0x82, 0xc6, 0x15, 0x4f, 0xc4, 0xc0, 0xdd, 0x92, 0x81, 0xf0, 0x19, 0xb4, 0xc6, 0x99, 0xdb,
+	0xba, 0xb3, 0x40, 0xa5, 0xe9, 0xed, 0xef, 0x65, 0x4c, 0xac, 0xb0, 0x8a, 0xfe, 0x6a, 0x47, 0xdf,
+	0xe5, 0x81, 0xd0, 0xc6, 0x96, 0xd4, 0x9e, 0x25, 0x61, 0x7a, 0x23, 0x65, 0xc1, 0x9c, 0x94, 0x6d,
+	0x00, 0xbc, 0x11, 0xe6, 0x42, 0xa4, 0x44, 0x62, 0x2d, 0x23, 0xb1, 0x94, 0x44, 0x5f, 0x06, 0x00,
+	0xde, 0x23, 0xe7, 0xb1, 0xb8, 0x06, 0x4d, 0x2d, 0x27, 0x2a, 0xce, 0x49, 0x74, 0xc8, 0x72, 0xa8,
+	0x27, 0xfd, 0xbf, 0x63, 0x6c, 0xf2, 0x6a, 0x70, 0x30, 0xcb, 0xa8, 0x9e, 0x14, 0xed, 0xea, 0xd0,
+	0x7b, 0x0d, 0xb5, 0x75, 0x68, 0xa5, 0xd2, 0x6c, 0x9f, 0x1b, 0x57, 0x0f, 0x75, 0x56, 0xe0, 0xf0,
+	0x57, 0xb0, 0x32, 0xe0, 0x53, 0xcd, 0xd0, 0x86, 0x66, 0x73, 0x97, 0x15, 0x45, 0x55, 0x18, 0xfd,
+	0x0b, 0x42, 0x9b, 0xc0, 0x1b, 0x64, 0x7d, 0x78, 0x16, 0x7f, 0x03, 0x0d, 0xfb, 0xef, 0x4e, 0x77,
+	0xea, 0xb3, 0xc9, 0x2a, 0x2f, 0x60, 0x99, 0x49, 0xf4, 0xbf, 0x1a, 0xb4, 0xf2, 0xb7, 0xda, 0x47,
+	0x6d, 0xaa, 0x7c, 0x63, 0x6e, 0x78, 0x1b, 0xb3, 0x5d, 0x48, 0x27, 0xfd, 0x7c, 0x21, 0x5d, 0x74,
+	0x0b, 0x69, 0x21, 0xb9, 0xb9, 0x1b, 0xb5, 0x6e, 0xb5, 0x6f, 0xbe, 0x00, 0xc0, 0x54, 0xc9, 0x24,
+	0xd9, 0x4f, 0xcf, 0x65, 0xa7, 0xbd, 0x19, 0x54, 0xc9, 0xd8, 0x2b, 0x74, 0xcc, 0xb3, 0xb3, 0x61,
+	0x24, 0x32, 0xe6, 0x89, 0x7d, 0x74, 0x07, 0xa8, 0x0f, 0x4a, 0x41, 0xf4, 0x79, 0x00, 0x50, 0x1e,
+	0xa4, 0x81, 0xa7, 0x95, 0x1d, 0x78, 0x81, 0x1b, 0x78, 0x84, 0xc2, 0xdf, 0xc2, 0x83, 0x73, 0xde,
+	0x57, 0x22, 0xee, 0xf1, 0x53, 0x54, 0x57, 0xa8, 0xce, 0xd8, 0x81, 0xa3, 0xf3, 0xa6, 0xc2, 0x5e,
+	0x99, 0x4a, 0xb3, 0x83, 0xe7, 0x52, 0x65, 0xac, 0xd6, 0x59, 0x29, 0xa8, 0x94, 0xd8, 0xc2, 0x4c,
+	0x89, 0xbd, 0x84, 0x95, 0xcc, 0x75, 0x86, 0x97, 0x13, 0xd4, 0x86, 0xe8, 0x5d, 0xf2, 0x19, 0xda,
+	0xf3, 0xd5, 0xac, 0x6a, 0x1d, 0x7d, 0x17, 0xc0, 0x4a, 0xc5, 0xc0, 0x5e, 0xe6, 0xb8, 0x28, 0xde,
+	0x87, 0x39, 0xa6, 0xce, 0xc2, 0x58, 0xa1, 0x29, 0x3a, 0x8b, 0x50, 0xb1, 0xe5, 0xd6, 0xdf, 0xbe,
+	0xe5, 0xd2, 0x9f, 0x12, 0x79, 0x2e, 0x12, 0xcc, 0x5f, 0x89, 0x0e, 0xda, 0x91, 0x93, 0xf0, 0x3e,
+	0x26, 0xae, 0x30, 0x32, 0x40, 0xbc, 0xf2, 0xa3, 0x7c, 0x75, 0x6e, 0x33, 0x87, 0xac, 0xf5, 0x85,
+	0xd4, 0xc6, 0xce, 0xd7, 0xba, 0xb5, 0x26, 0x10, 0x6e, 0x41, 0x83, 0x1b, 0xa3, 0x74, 0xa7, 0x45,
+	0x05, 0xff, 0xb3, 0xd9, 0xe8, 0xb7, 0x8d, 0x51, 0xa2, 0x3f, 0x31, 0xc8, 0x32, 0xbb, 0x68, 0x1b,
+	0xee, 0xcd, 0x68, 0x8a, 0xfa, 0x0c, 0xbc, 0xfa, 0x5c, 0x87, 0x96, 0x1c, 0x1b, 0x21, 0x53, 0x9e,
+	0x50, 0xc8, 0x2d, 0x56, 0xe0, 0xe8, 0xff, 0x35, 0xa8, 0xf7, 0x4e, 0x99, 0x8d, 0x2c, 0x96, 0x93,
+	0xd4, 0xa8, 0x69, 0x27, 0x20, 0x9f, 0x72, 0x68, 0xbb, 0x49, 0xaa, 0x21, 0x4f, 0xc5, 0x3f, 0xb9,
+	0x3d, 0xd3, 0xa9, 0x91, 0xba, 0x22, 0x0b, 0xbb, 0x10, 0xfa, 0x98, 0x27, 0x67, 0xa9, 0x30, 0xd4,
+	0xb7, 0x6d, 0x36, 0x47, 0x63, 0x3d, 0xa2, 0x5a, 0x14, 0x66, 0x4a, 0xa3, 0xb8, 0xcd, 0x0a, 0x6c,
+	0x75, 0x63, 0x25, 0xaf, 0x44, 0x1a, 0xdb, 0x2e, 0x23, 0x5d, 0x8e, 0xed, 0x28, 0xd2, 0x46, 0x21,
+	0x9a, 0xed, 0xc1, 0x40, 0xa1, 0xce, 0x56, 0x8c, 0x36, 0xab, 0x0a, 0xa9, 0x1f, 0xa5, 0x36, 0x3c,
+	0xb1, 0x43, 0xc6, 0x51, 0xec, 0x49, 0x6e, 0x0c, 0xc4, 0xd6, 0x9c, 0x81, 0xb8, 0x01, 0x10, 0xcb,
+	0xd1, 0x48, 0xa6, 0x94, 0xbd, 0x36, 0x59, 0x78, 0x92, 0x9d, 0x43, 0x78, 0x1a, 0xa7, 0x5d, 0xde,
+	0x47, 0x25, 0xe2, 0x6e, 0xd6, 0x0a, 0xbf, 0x8b, 0x13, 0x81, 0xa9, 0xe9, 0xda, 0x4f, 0x4b, 0xd9,
+	0x57, 0xa4, 0x22, 0x81, 0x3b, 0x6d, 0x3b, 0xb2, 0x4e, 0xac, 0xec, 0x6f, 0x0f, 0xe7, 0x7c, 0x7a,
+	0xea, 0x37, 0x09, 0xff, 0xfe, 0xfb, 0x01, 0x00, 0x8f, 0x1d, 0xd3, 0x88, 0xe4, 0x12, 0x00, 0x00,
 }
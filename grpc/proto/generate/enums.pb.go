@@ -157,34 +157,94 @@ func (SignAlgorithm) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_d76e7aea8c852478, []int{4}
 }
 
+type PolicyType int32
+
+const (
+	PolicyType_Signature    PolicyType = 0
+	PolicyType_ImplicitMeta PolicyType = 1
+)
+
+var PolicyType_name = map[int32]string{
+	0: "Signature",
+	1: "ImplicitMeta",
+}
+
+var PolicyType_value = map[string]int32{
+	"Signature":    0,
+	"ImplicitMeta": 1,
+}
+
+func (x PolicyType) String() string {
+	return proto.EnumName(PolicyType_name, int32(x))
+}
+
+func (PolicyType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d76e7aea8c852478, []int{5}
+}
+
+// ConsensusType 排序服务共识类型
+type ConsensusType int32
+
+const (
+	ConsensusType_kafka    ConsensusType = 0
+	ConsensusType_etcdraft ConsensusType = 1
+	ConsensusType_solo     ConsensusType = 2
+)
+
+var ConsensusType_name = map[int32]string{
+	0: "kafka",
+	1: "etcdraft",
+	2: "solo",
+}
+
+var ConsensusType_value = map[string]int32{
+	"kafka":    0,
+	"etcdraft": 1,
+	"solo":     2,
+}
+
+func (x ConsensusType) String() string {
+	return proto.EnumName(ConsensusType_name, int32(x))
+}
+
+func (ConsensusType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d76e7aea8c852478, []int{6}
+}
+
 func init() {
 	proto.RegisterEnum("generate.OrgType", OrgType_name, OrgType_value)
 	proto.RegisterEnum("generate.CryptoType", CryptoType_name, CryptoType_value)
 	proto.RegisterEnum("generate.EccAlgorithm", EccAlgorithm_name, EccAlgorithm_value)
 	proto.RegisterEnum("generate.RsaAlgorithm", RsaAlgorithm_name, RsaAlgorithm_value)
 	proto.RegisterEnum("generate.SignAlgorithm", SignAlgorithm_name, SignAlgorithm_value)
+	proto.RegisterEnum("generate.PolicyType", PolicyType_name, PolicyType_value)
+	proto.RegisterEnum("generate.ConsensusType", ConsensusType_name, ConsensusType_value)
 }
 
 func init() { proto.RegisterFile("grpc/proto/generate/enums.proto", fileDescriptor_d76e7aea8c852478) }
 
 var fileDescriptor_d76e7aea8c852478 = []byte{
-	// 279 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xcf, 0x6b, 0xc2, 0x30,
-	0x18, 0x86, 0x5b, 0x7f, 0x4c, 0xfd, 0x50, 0x96, 0xd5, 0xfb, 0xb6, 0xc3, 0x4e, 0x61, 0x6b, 0x6d,
-	0x6c, 0x8b, 0x3b, 0x56, 0x57, 0xd8, 0x65, 0x28, 0xed, 0x60, 0xb0, 0x5b, 0xed, 0xb2, 0x1a, 0xd0,
-	0x24, 0xc4, 0xec, 0xe0, 0x7f, 0x3f, 0x92, 0x52, 0x04, 0xf1, 0xf6, 0xe6, 0xe1, 0xcd, 0xc7, 0xcb,
-	0x03, 0x0f, 0xb5, 0x92, 0x55, 0x20, 0x95, 0xd0, 0x22, 0xa8, 0x29, 0xa7, 0xaa, 0xd4, 0x34, 0xa0,
-	0xfc, 0xef, 0x70, 0xf4, 0x2d, 0xf4, 0x86, 0x2d, 0xc5, 0xf7, 0x30, 0x58, 0xab, 0xfa, 0xf3, 0x24,
-	0xa9, 0x37, 0x82, 0xfe, 0x5a, 0xfd, 0x50, 0x85, 0x1c, 0x6f, 0x08, 0xbd, 0x0d, 0xa5, 0x0a, 0xb9,
-	0xf8, 0x11, 0x60, 0xa5, 0x4e, 0x52, 0x8b, 0xb6, 0x92, 0xad, 0xde, 0x8a, 0x14, 0x39, 0xde, 0x00,
-	0xba, 0x79, 0x91, 0x22, 0x17, 0x3f, 0xc3, 0x38, 0xab, 0xaa, 0x74, 0x5f, 0x0b, 0xc5, 0xf4, 0xee,
-	0x60, 0xfe, 0x4a, 0x12, 0x27, 0xcd, 0x15, 0x39, 0x5f, 0x44, 0xc8, 0xb5, 0x29, 0x26, 0x21, 0xea,
-	0xe0, 0x27, 0x18, 0xe7, 0xc7, 0xf2, 0xdc, 0x1e, 0x41, 0x5f, 0x91, 0x59, 0xb4, 0x40, 0x8e, 0x8d,
-	0xd1, 0xec, 0x35, 0x41, 0x2e, 0xd6, 0x30, 0x29, 0x58, 0xcd, 0xcf, 0xb5, 0x3b, 0x98, 0x14, 0xef,
-	0x29, 0x89, 0x93, 0x2f, 0xa6, 0x77, 0xb9, 0x1d, 0xd0, 0xa0, 0x38, 0x24, 0x2d, 0x72, 0xbd, 0x29,
-	0xdc, 0xda, 0x79, 0x86, 0x34, 0x75, 0xd4, 0xb9, 0x84, 0x66, 0x50, 0xf7, 0x12, 0xc6, 0x21, 0x41,
-	0xbd, 0xe5, 0x07, 0x60, 0x26, 0x7c, 0xca, 0xb9, 0x10, 0xfe, 0x6f, 0xb9, 0x55, 0xac, 0x7a, 0xa9,
-	0xf6, 0x8c, 0x72, 0xed, 0x1b, 0x9f, 0x8d, 0x3a, 0xbf, 0x35, 0xb7, 0x84, 0xcc, 0x08, 0xdd, 0x18,
-	0xf8, 0x3d, 0xbd, 0x22, 0x7c, 0x7b, 0x63, 0xdf, 0xf3, 0xff, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6b,
-	0x54, 0x01, 0x5b, 0x8e, 0x01, 0x00, 0x00,
+	// 342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xcf, 0x4f, 0xc2, 0x30,
+	0x14, 0xc7, 0x37, 0x7e, 0x08, 0xbc, 0x6c, 0xf1, 0x39, 0xee, 0xea, 0xc1, 0xd3, 0x94, 0x01, 0x83,
+	0x11, 0x3c, 0x0e, 0x24, 0xd1, 0x83, 0x42, 0x98, 0x89, 0x89, 0xb7, 0x52, 0xca, 0x68, 0x18, 0xeb,
+	0xd2, 0x95, 0x03, 0xff, 0xbd, 0x59, 0x97, 0x85, 0x84, 0x78, 0x7b, 0xfd, 0xe4, 0xdb, 0xe6, 0xfb,
+	0xfa, 0x81, 0x87, 0x58, 0x66, 0xb4, 0x9f, 0x49, 0xa1, 0x44, 0x3f, 0x66, 0x29, 0x93, 0x44, 0xb1,
+	0x3e, 0x4b, 0x4f, 0xc7, 0xdc, 0xd3, 0xd0, 0x69, 0x57, 0xd4, 0xbd, 0x87, 0xd6, 0x52, 0xc6, 0xdf,
+	0xe7, 0x8c, 0x39, 0x1d, 0x68, 0x2e, 0xe5, 0x96, 0x49, 0x34, 0x9c, 0x36, 0x34, 0x56, 0x8c, 0x49,
+	0x34, 0xdd, 0x47, 0x80, 0xb9, 0x3c, 0x67, 0x4a, 0x54, 0x91, 0xc5, 0xfc, 0x2d, 0x0a, 0xd1, 0x70,
+	0x5a, 0x50, 0x5f, 0x47, 0x21, 0x9a, 0xee, 0x0b, 0x58, 0x0b, 0x4a, 0xc3, 0x24, 0x16, 0x92, 0xab,
+	0xfd, 0xb1, 0xb8, 0x9b, 0xf9, 0xc1, 0xa4, 0x7c, 0x25, 0x1b, 0x4d, 0xc7, 0x68, 0xea, 0x29, 0xf0,
+	0x87, 0x58, 0x73, 0x9f, 0xc0, 0x5a, 0xe7, 0xe4, 0x92, 0xee, 0x40, 0x53, 0xfa, 0x83, 0xf1, 0x14,
+	0x0d, 0x3d, 0x8e, 0x07, 0xaf, 0x13, 0x34, 0x5d, 0x05, 0x76, 0xc4, 0xe3, 0xf4, 0x12, 0xbb, 0x03,
+	0x3b, 0x7a, 0x0f, 0xfd, 0x60, 0xf2, 0xc3, 0xd5, 0x7e, 0xad, 0x0b, 0x94, 0x28, 0x18, 0xfa, 0x15,
+	0x32, 0x9d, 0x2e, 0xdc, 0xea, 0x7a, 0x05, 0x29, 0xe3, 0x58, 0xbb, 0x86, 0x45, 0xa1, 0xfa, 0x35,
+	0x0c, 0x86, 0x3e, 0x36, 0xdc, 0x1e, 0xc0, 0x4a, 0x24, 0x9c, 0x9e, 0xf5, 0xae, 0x36, 0x74, 0x8a,
+	0x0e, 0x44, 0x9d, 0x24, 0x43, 0xc3, 0x41, 0xb0, 0x3e, 0x8e, 0x59, 0xc2, 0x29, 0x57, 0x9f, 0x4c,
+	0x11, 0x34, 0x5d, 0x1f, 0xec, 0xb9, 0x48, 0x73, 0x96, 0xe6, 0xa7, 0xbc, 0xfa, 0x9d, 0x03, 0xd9,
+	0x1d, 0x08, 0x1a, 0x8e, 0x05, 0x6d, 0xa6, 0xe8, 0x56, 0x92, 0x9d, 0x2a, 0xd7, 0xcf, 0x45, 0x22,
+	0xb0, 0x36, 0xfb, 0x82, 0x67, 0x9a, 0x7a, 0x64, 0xc3, 0x24, 0xa7, 0xde, 0x8e, 0x6c, 0x24, 0xa7,
+	0x3d, 0x9a, 0x70, 0x96, 0x2a, 0xaf, 0x70, 0x56, 0xea, 0xf1, 0x2a, 0x3b, 0x33, 0x58, 0x14, 0xd2,
+	0x56, 0x05, 0xfc, 0xed, 0xfe, 0x23, 0x75, 0x73, 0xa3, 0xcf, 0xa3, 0xbf, 0x01, 0x00, 0x44, 0xfd,
+	0xdf, 0xee, 0xf2, 0x01, 0x00, 0x00,
 }
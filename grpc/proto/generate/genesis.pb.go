@@ -21,11 +21,15 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type ReqGenesis struct {
-	League               *LeagueInBlock `protobuf:"bytes,1,opt,name=league,proto3" json:"league,omitempty"`
-	Orgs                 []*OrgInBlock  `protobuf:"bytes,2,rep,name=orgs,proto3" json:"orgs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+	League               *LeagueInBlock     `protobuf:"bytes,1,opt,name=league,proto3" json:"league,omitempty"`
+	Orgs                 []*OrgInBlock      `protobuf:"bytes,2,rep,name=orgs,proto3" json:"orgs,omitempty"`
+	ApplicationPolicies  map[string]*Policy `protobuf:"bytes,3,rep,name=applicationPolicies,proto3" json:"applicationPolicies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	OrdererPolicies      map[string]*Policy `protobuf:"bytes,4,rep,name=ordererPolicies,proto3" json:"ordererPolicies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	ChannelPolicies      map[string]*Policy `protobuf:"bytes,5,rep,name=channelPolicies,proto3" json:"channelPolicies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Consortiums          []*Consortium      `protobuf:"bytes,6,rep,name=consortiums,proto3" json:"consortiums,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *ReqGenesis) Reset()         { *m = ReqGenesis{} }
@@ -67,6 +71,82 @@ func (m *ReqGenesis) GetOrgs() []*OrgInBlock {
 	return nil
 }
 
+func (m *ReqGenesis) GetApplicationPolicies() map[string]*Policy {
+	if m != nil {
+		return m.ApplicationPolicies
+	}
+	return nil
+}
+
+func (m *ReqGenesis) GetOrdererPolicies() map[string]*Policy {
+	if m != nil {
+		return m.OrdererPolicies
+	}
+	return nil
+}
+
+func (m *ReqGenesis) GetChannelPolicies() map[string]*Policy {
+	if m != nil {
+		return m.ChannelPolicies
+	}
+	return nil
+}
+
+func (m *ReqGenesis) GetConsortiums() []*Consortium {
+	if m != nil {
+		return m.Consortiums
+	}
+	return nil
+}
+
+// Consortium 联合体及其成员组织
+type Consortium struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Orgs                 []string `protobuf:"bytes,2,rep,name=orgs,proto3" json:"orgs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Consortium) Reset()         { *m = Consortium{} }
+func (m *Consortium) String() string { return proto.CompactTextString(m) }
+func (*Consortium) ProtoMessage()    {}
+func (*Consortium) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a37cb49bec1cbcbc, []int{1}
+}
+
+func (m *Consortium) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Consortium.Unmarshal(m, b)
+}
+func (m *Consortium) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Consortium.Marshal(b, m, deterministic)
+}
+func (m *Consortium) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Consortium.Merge(m, src)
+}
+func (m *Consortium) XXX_Size() int {
+	return xxx_messageInfo_Consortium.Size(m)
+}
+func (m *Consortium) XXX_DiscardUnknown() {
+	xxx_messageInfo_Consortium.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Consortium proto.InternalMessageInfo
+
+func (m *Consortium) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Consortium) GetOrgs() []string {
+	if m != nil {
+		return m.Orgs
+	}
+	return nil
+}
+
 type RespGenesis struct {
 	Code                 Code     `protobuf:"varint,1,opt,name=code,proto3,enum=generate.Code" json:"code,omitempty"`
 	ErrMsg               string   `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
@@ -79,7 +159,7 @@ func (m *RespGenesis) Reset()         { *m = RespGenesis{} }
 func (m *RespGenesis) String() string { return proto.CompactTextString(m) }
 func (*RespGenesis) ProtoMessage()    {}
 func (*RespGenesis) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a37cb49bec1cbcbc, []int{1}
+	return fileDescriptor_a37cb49bec1cbcbc, []int{2}
 }
 
 func (m *RespGenesis) XXX_Unmarshal(b []byte) error {
@@ -115,18 +195,20 @@ func (m *RespGenesis) GetErrMsg() string {
 }
 
 type ReqChannelTx struct {
-	ChannelID            string      `protobuf:"bytes,1,opt,name=channelID,proto3" json:"channelID,omitempty"`
-	Genesis              *ReqGenesis `protobuf:"bytes,2,opt,name=genesis,proto3" json:"genesis,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+	ChannelID            string        `protobuf:"bytes,1,opt,name=channelID,proto3" json:"channelID,omitempty"`
+	Genesis              *ReqGenesis   `protobuf:"bytes,2,opt,name=genesis,proto3" json:"genesis,omitempty"`
+	Consortium           string        `protobuf:"bytes,3,opt,name=consortium,proto3" json:"consortium,omitempty"`
+	Orgs                 []*ChannelOrg `protobuf:"bytes,4,rep,name=orgs,proto3" json:"orgs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *ReqChannelTx) Reset()         { *m = ReqChannelTx{} }
 func (m *ReqChannelTx) String() string { return proto.CompactTextString(m) }
 func (*ReqChannelTx) ProtoMessage()    {}
 func (*ReqChannelTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a37cb49bec1cbcbc, []int{2}
+	return fileDescriptor_a37cb49bec1cbcbc, []int{3}
 }
 
 func (m *ReqChannelTx) XXX_Unmarshal(b []byte) error {
@@ -161,6 +243,68 @@ func (m *ReqChannelTx) GetGenesis() *ReqGenesis {
 	return nil
 }
 
+func (m *ReqChannelTx) GetConsortium() string {
+	if m != nil {
+		return m.Consortium
+	}
+	return ""
+}
+
+func (m *ReqChannelTx) GetOrgs() []*ChannelOrg {
+	if m != nil {
+		return m.Orgs
+	}
+	return nil
+}
+
+// ChannelOrg 加入通道的组织
+type ChannelOrg struct {
+	Name                 string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	AnchorPeers          []*AnchorPeer `protobuf:"bytes,2,rep,name=anchorPeers,proto3" json:"anchorPeers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ChannelOrg) Reset()         { *m = ChannelOrg{} }
+func (m *ChannelOrg) String() string { return proto.CompactTextString(m) }
+func (*ChannelOrg) ProtoMessage()    {}
+func (*ChannelOrg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a37cb49bec1cbcbc, []int{4}
+}
+
+func (m *ChannelOrg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChannelOrg.Unmarshal(m, b)
+}
+func (m *ChannelOrg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChannelOrg.Marshal(b, m, deterministic)
+}
+func (m *ChannelOrg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelOrg.Merge(m, src)
+}
+func (m *ChannelOrg) XXX_Size() int {
+	return xxx_messageInfo_ChannelOrg.Size(m)
+}
+func (m *ChannelOrg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelOrg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelOrg proto.InternalMessageInfo
+
+func (m *ChannelOrg) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ChannelOrg) GetAnchorPeers() []*AnchorPeer {
+	if m != nil {
+		return m.AnchorPeers
+	}
+	return nil
+}
+
 type RespChannelTx struct {
 	Code                 Code     `protobuf:"varint,1,opt,name=code,proto3,enum=generate.Code" json:"code,omitempty"`
 	ErrMsg               string   `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
@@ -173,7 +317,7 @@ func (m *RespChannelTx) Reset()         { *m = RespChannelTx{} }
 func (m *RespChannelTx) String() string { return proto.CompactTextString(m) }
 func (*RespChannelTx) ProtoMessage()    {}
 func (*RespChannelTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a37cb49bec1cbcbc, []int{3}
+	return fileDescriptor_a37cb49bec1cbcbc, []int{5}
 }
 
 func (m *RespChannelTx) XXX_Unmarshal(b []byte) error {
@@ -208,23 +352,135 @@ func (m *RespChannelTx) GetErrMsg() string {
 	return ""
 }
 
+type ReqPrintOrg struct {
+	LeagueDomain         string      `protobuf:"bytes,1,opt,name=leagueDomain,proto3" json:"leagueDomain,omitempty"`
+	Org                  *OrgInBlock `protobuf:"bytes,2,opt,name=org,proto3" json:"org,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ReqPrintOrg) Reset()         { *m = ReqPrintOrg{} }
+func (m *ReqPrintOrg) String() string { return proto.CompactTextString(m) }
+func (*ReqPrintOrg) ProtoMessage()    {}
+func (*ReqPrintOrg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a37cb49bec1cbcbc, []int{6}
+}
+
+func (m *ReqPrintOrg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqPrintOrg.Unmarshal(m, b)
+}
+func (m *ReqPrintOrg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqPrintOrg.Marshal(b, m, deterministic)
+}
+func (m *ReqPrintOrg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqPrintOrg.Merge(m, src)
+}
+func (m *ReqPrintOrg) XXX_Size() int {
+	return xxx_messageInfo_ReqPrintOrg.Size(m)
+}
+func (m *ReqPrintOrg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqPrintOrg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqPrintOrg proto.InternalMessageInfo
+
+func (m *ReqPrintOrg) GetLeagueDomain() string {
+	if m != nil {
+		return m.LeagueDomain
+	}
+	return ""
+}
+
+func (m *ReqPrintOrg) GetOrg() *OrgInBlock {
+	if m != nil {
+		return m.Org
+	}
+	return nil
+}
+
+type RespPrintOrg struct {
+	Code                 Code     `protobuf:"varint,1,opt,name=code,proto3,enum=generate.Code" json:"code,omitempty"`
+	ErrMsg               string   `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
+	ConfigGroup          []byte   `protobuf:"bytes,3,opt,name=configGroup,proto3" json:"configGroup,omitempty"`
+	Json                 string   `protobuf:"bytes,4,opt,name=json,proto3" json:"json,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RespPrintOrg) Reset()         { *m = RespPrintOrg{} }
+func (m *RespPrintOrg) String() string { return proto.CompactTextString(m) }
+func (*RespPrintOrg) ProtoMessage()    {}
+func (*RespPrintOrg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a37cb49bec1cbcbc, []int{7}
+}
+
+func (m *RespPrintOrg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RespPrintOrg.Unmarshal(m, b)
+}
+func (m *RespPrintOrg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RespPrintOrg.Marshal(b, m, deterministic)
+}
+func (m *RespPrintOrg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RespPrintOrg.Merge(m, src)
+}
+func (m *RespPrintOrg) XXX_Size() int {
+	return xxx_messageInfo_RespPrintOrg.Size(m)
+}
+func (m *RespPrintOrg) XXX_DiscardUnknown() {
+	xxx_messageInfo_RespPrintOrg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RespPrintOrg proto.InternalMessageInfo
+
+func (m *RespPrintOrg) GetCode() Code {
+	if m != nil {
+		return m.Code
+	}
+	return Code_Success
+}
+
+func (m *RespPrintOrg) GetErrMsg() string {
+	if m != nil {
+		return m.ErrMsg
+	}
+	return ""
+}
+
+func (m *RespPrintOrg) GetConfigGroup() []byte {
+	if m != nil {
+		return m.ConfigGroup
+	}
+	return nil
+}
+
+func (m *RespPrintOrg) GetJson() string {
+	if m != nil {
+		return m.Json
+	}
+	return ""
+}
+
 type LeagueInBlock struct {
-	Domain               string     `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	Addresses            []string   `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	BatchTimeout         int64      `protobuf:"varint,3,opt,name=BatchTimeout,proto3" json:"BatchTimeout,omitempty"`
-	BatchSize            *BatchSize `protobuf:"bytes,4,opt,name=batchSize,proto3" json:"batchSize,omitempty"`
-	Kafka                *Kafka     `protobuf:"bytes,5,opt,name=kafka,proto3" json:"kafka,omitempty"`
-	MaxChannels          uint64     `protobuf:"varint,6,opt,name=MaxChannels,proto3" json:"MaxChannels,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+	Domain               string        `protobuf:"bytes,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	Addresses            []string      `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	BatchTimeout         int64         `protobuf:"varint,3,opt,name=BatchTimeout,proto3" json:"BatchTimeout,omitempty"`
+	BatchSize            *BatchSize    `protobuf:"bytes,4,opt,name=batchSize,proto3" json:"batchSize,omitempty"`
+	Kafka                *Kafka        `protobuf:"bytes,5,opt,name=kafka,proto3" json:"kafka,omitempty"`
+	MaxChannels          uint64        `protobuf:"varint,6,opt,name=MaxChannels,proto3" json:"MaxChannels,omitempty"`
+	ConsensusType        ConsensusType `protobuf:"varint,7,opt,name=consensusType,proto3,enum=generate.ConsensusType" json:"consensusType,omitempty"`
+	EtcdRaft             *EtcdRaft     `protobuf:"bytes,8,opt,name=etcdRaft,proto3" json:"etcdRaft,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
 func (m *LeagueInBlock) Reset()         { *m = LeagueInBlock{} }
 func (m *LeagueInBlock) String() string { return proto.CompactTextString(m) }
 func (*LeagueInBlock) ProtoMessage()    {}
 func (*LeagueInBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a37cb49bec1cbcbc, []int{4}
+	return fileDescriptor_a37cb49bec1cbcbc, []int{8}
 }
 
 func (m *LeagueInBlock) XXX_Unmarshal(b []byte) error {
@@ -287,6 +543,20 @@ func (m *LeagueInBlock) GetMaxChannels() uint64 {
 	return 0
 }
 
+func (m *LeagueInBlock) GetConsensusType() ConsensusType {
+	if m != nil {
+		return m.ConsensusType
+	}
+	return ConsensusType_kafka
+}
+
+func (m *LeagueInBlock) GetEtcdRaft() *EtcdRaft {
+	if m != nil {
+		return m.EtcdRaft
+	}
+	return nil
+}
+
 type BatchSize struct {
 	MaxMessageCount      uint32   `protobuf:"varint,1,opt,name=maxMessageCount,proto3" json:"maxMessageCount,omitempty"`
 	AbsoluteMaxBytes     uint32   `protobuf:"varint,2,opt,name=absoluteMaxBytes,proto3" json:"absoluteMaxBytes,omitempty"`
@@ -300,7 +570,7 @@ func (m *BatchSize) Reset()         { *m = BatchSize{} }
 func (m *BatchSize) String() string { return proto.CompactTextString(m) }
 func (*BatchSize) ProtoMessage()    {}
 func (*BatchSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a37cb49bec1cbcbc, []int{5}
+	return fileDescriptor_a37cb49bec1cbcbc, []int{9}
 }
 
 func (m *BatchSize) XXX_Unmarshal(b []byte) error {
@@ -353,7 +623,7 @@ func (m *Kafka) Reset()         { *m = Kafka{} }
 func (m *Kafka) String() string { return proto.CompactTextString(m) }
 func (*Kafka) ProtoMessage()    {}
 func (*Kafka) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a37cb49bec1cbcbc, []int{6}
+	return fileDescriptor_a37cb49bec1cbcbc, []int{10}
 }
 
 func (m *Kafka) XXX_Unmarshal(b []byte) error {
@@ -381,22 +651,230 @@ func (m *Kafka) GetBrokers() []string {
 	return nil
 }
 
+type EtcdRaft struct {
+	Consenters           []*Consenter     `protobuf:"bytes,1,rep,name=consenters,proto3" json:"consenters,omitempty"`
+	Options              *EtcdRaftOptions `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *EtcdRaft) Reset()         { *m = EtcdRaft{} }
+func (m *EtcdRaft) String() string { return proto.CompactTextString(m) }
+func (*EtcdRaft) ProtoMessage()    {}
+func (*EtcdRaft) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a37cb49bec1cbcbc, []int{11}
+}
+
+func (m *EtcdRaft) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EtcdRaft.Unmarshal(m, b)
+}
+func (m *EtcdRaft) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EtcdRaft.Marshal(b, m, deterministic)
+}
+func (m *EtcdRaft) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EtcdRaft.Merge(m, src)
+}
+func (m *EtcdRaft) XXX_Size() int {
+	return xxx_messageInfo_EtcdRaft.Size(m)
+}
+func (m *EtcdRaft) XXX_DiscardUnknown() {
+	xxx_messageInfo_EtcdRaft.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EtcdRaft proto.InternalMessageInfo
+
+func (m *EtcdRaft) GetConsenters() []*Consenter {
+	if m != nil {
+		return m.Consenters
+	}
+	return nil
+}
+
+func (m *EtcdRaft) GetOptions() *EtcdRaftOptions {
+	if m != nil {
+		return m.Options
+	}
+	return nil
+}
+
+// Consenter raft共识节点，证书路径为空时使用crypto-config中该排序节点的tls/server.crt
+type Consenter struct {
+	Host                 string   `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Port                 uint32   `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	OrgName              string   `protobuf:"bytes,3,opt,name=orgName,proto3" json:"orgName,omitempty"`
+	OrgDomain            string   `protobuf:"bytes,4,opt,name=orgDomain,proto3" json:"orgDomain,omitempty"`
+	NodeName             string   `protobuf:"bytes,5,opt,name=nodeName,proto3" json:"nodeName,omitempty"`
+	ClientTlsCertPath    string   `protobuf:"bytes,6,opt,name=clientTlsCertPath,proto3" json:"clientTlsCertPath,omitempty"`
+	ServerTlsCertPath    string   `protobuf:"bytes,7,opt,name=serverTlsCertPath,proto3" json:"serverTlsCertPath,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Consenter) Reset()         { *m = Consenter{} }
+func (m *Consenter) String() string { return proto.CompactTextString(m) }
+func (*Consenter) ProtoMessage()    {}
+func (*Consenter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a37cb49bec1cbcbc, []int{12}
+}
+
+func (m *Consenter) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Consenter.Unmarshal(m, b)
+}
+func (m *Consenter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Consenter.Marshal(b, m, deterministic)
+}
+func (m *Consenter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Consenter.Merge(m, src)
+}
+func (m *Consenter) XXX_Size() int {
+	return xxx_messageInfo_Consenter.Size(m)
+}
+func (m *Consenter) XXX_DiscardUnknown() {
+	xxx_messageInfo_Consenter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Consenter proto.InternalMessageInfo
+
+func (m *Consenter) GetHost() string {
+	if m != nil {
+		return m.Host
+	}
+	return ""
+}
+
+func (m *Consenter) GetPort() uint32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *Consenter) GetOrgName() string {
+	if m != nil {
+		return m.OrgName
+	}
+	return ""
+}
+
+func (m *Consenter) GetOrgDomain() string {
+	if m != nil {
+		return m.OrgDomain
+	}
+	return ""
+}
+
+func (m *Consenter) GetNodeName() string {
+	if m != nil {
+		return m.NodeName
+	}
+	return ""
+}
+
+func (m *Consenter) GetClientTlsCertPath() string {
+	if m != nil {
+		return m.ClientTlsCertPath
+	}
+	return ""
+}
+
+func (m *Consenter) GetServerTlsCertPath() string {
+	if m != nil {
+		return m.ServerTlsCertPath
+	}
+	return ""
+}
+
+// EtcdRaftOptions raft共识参数，未设置时使用默认值
+type EtcdRaftOptions struct {
+	TickInterval         string   `protobuf:"bytes,1,opt,name=tickInterval,proto3" json:"tickInterval,omitempty"`
+	ElectionTick         uint32   `protobuf:"varint,2,opt,name=electionTick,proto3" json:"electionTick,omitempty"`
+	HeartbeatTick        uint32   `protobuf:"varint,3,opt,name=heartbeatTick,proto3" json:"heartbeatTick,omitempty"`
+	MaxInflightBlocks    uint32   `protobuf:"varint,4,opt,name=maxInflightBlocks,proto3" json:"maxInflightBlocks,omitempty"`
+	SnapshotIntervalSize uint32   `protobuf:"varint,5,opt,name=snapshotIntervalSize,proto3" json:"snapshotIntervalSize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EtcdRaftOptions) Reset()         { *m = EtcdRaftOptions{} }
+func (m *EtcdRaftOptions) String() string { return proto.CompactTextString(m) }
+func (*EtcdRaftOptions) ProtoMessage()    {}
+func (*EtcdRaftOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a37cb49bec1cbcbc, []int{13}
+}
+
+func (m *EtcdRaftOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EtcdRaftOptions.Unmarshal(m, b)
+}
+func (m *EtcdRaftOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EtcdRaftOptions.Marshal(b, m, deterministic)
+}
+func (m *EtcdRaftOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EtcdRaftOptions.Merge(m, src)
+}
+func (m *EtcdRaftOptions) XXX_Size() int {
+	return xxx_messageInfo_EtcdRaftOptions.Size(m)
+}
+func (m *EtcdRaftOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_EtcdRaftOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EtcdRaftOptions proto.InternalMessageInfo
+
+func (m *EtcdRaftOptions) GetTickInterval() string {
+	if m != nil {
+		return m.TickInterval
+	}
+	return ""
+}
+
+func (m *EtcdRaftOptions) GetElectionTick() uint32 {
+	if m != nil {
+		return m.ElectionTick
+	}
+	return 0
+}
+
+func (m *EtcdRaftOptions) GetHeartbeatTick() uint32 {
+	if m != nil {
+		return m.HeartbeatTick
+	}
+	return 0
+}
+
+func (m *EtcdRaftOptions) GetMaxInflightBlocks() uint32 {
+	if m != nil {
+		return m.MaxInflightBlocks
+	}
+	return 0
+}
+
+func (m *EtcdRaftOptions) GetSnapshotIntervalSize() uint32 {
+	if m != nil {
+		return m.SnapshotIntervalSize
+	}
+	return 0
+}
+
 // ReqOrderers 请求生成指定联盟默认orderer服务集合
 type OrgInBlock struct {
-	Domain               string        `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
-	Name                 string        `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Type                 OrgType       `protobuf:"varint,4,opt,name=type,proto3,enum=generate.OrgType" json:"type,omitempty"`
-	AnchorPeers          []*AnchorPeer `protobuf:"bytes,5,rep,name=anchorPeers,proto3" json:"anchorPeers,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+	Domain               string             `protobuf:"bytes,2,opt,name=domain,proto3" json:"domain,omitempty"`
+	Name                 string             `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type                 OrgType            `protobuf:"varint,4,opt,name=type,proto3,enum=generate.OrgType" json:"type,omitempty"`
+	AnchorPeers          []*AnchorPeer      `protobuf:"bytes,5,rep,name=anchorPeers,proto3" json:"anchorPeers,omitempty"`
+	Policies             map[string]*Policy `protobuf:"bytes,6,rep,name=policies,proto3" json:"policies,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *OrgInBlock) Reset()         { *m = OrgInBlock{} }
 func (m *OrgInBlock) String() string { return proto.CompactTextString(m) }
 func (*OrgInBlock) ProtoMessage()    {}
 func (*OrgInBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a37cb49bec1cbcbc, []int{7}
+	return fileDescriptor_a37cb49bec1cbcbc, []int{14}
 }
 
 func (m *OrgInBlock) XXX_Unmarshal(b []byte) error {
@@ -445,6 +923,13 @@ func (m *OrgInBlock) GetAnchorPeers() []*AnchorPeer {
 	return nil
 }
 
+func (m *OrgInBlock) GetPolicies() map[string]*Policy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
 type AnchorPeer struct {
 	Host                 string   `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
 	Port                 int32    `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
@@ -457,7 +942,7 @@ func (m *AnchorPeer) Reset()         { *m = AnchorPeer{} }
 func (m *AnchorPeer) String() string { return proto.CompactTextString(m) }
 func (*AnchorPeer) ProtoMessage()    {}
 func (*AnchorPeer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a37cb49bec1cbcbc, []int{8}
+	return fileDescriptor_a37cb49bec1cbcbc, []int{15}
 }
 
 func (m *AnchorPeer) XXX_Unmarshal(b []byte) error {
@@ -492,56 +977,151 @@ func (m *AnchorPeer) GetPort() int32 {
 	return 0
 }
 
+// Policy 配置策略，Signature类型规则如OR('Org1MSP.member')，ImplicitMeta类型规则如MAJORITY Admins
+type Policy struct {
+	Type                 PolicyType `protobuf:"varint,1,opt,name=type,proto3,enum=generate.PolicyType" json:"type,omitempty"`
+	Rule                 string     `protobuf:"bytes,2,opt,name=rule,proto3" json:"rule,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *Policy) Reset()         { *m = Policy{} }
+func (m *Policy) String() string { return proto.CompactTextString(m) }
+func (*Policy) ProtoMessage()    {}
+func (*Policy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a37cb49bec1cbcbc, []int{16}
+}
+
+func (m *Policy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Policy.Unmarshal(m, b)
+}
+func (m *Policy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Policy.Marshal(b, m, deterministic)
+}
+func (m *Policy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Policy.Merge(m, src)
+}
+func (m *Policy) XXX_Size() int {
+	return xxx_messageInfo_Policy.Size(m)
+}
+func (m *Policy) XXX_DiscardUnknown() {
+	xxx_messageInfo_Policy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Policy proto.InternalMessageInfo
+
+func (m *Policy) GetType() PolicyType {
+	if m != nil {
+		return m.Type
+	}
+	return PolicyType_Signature
+}
+
+func (m *Policy) GetRule() string {
+	if m != nil {
+		return m.Rule
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*ReqGenesis)(nil), "generate.ReqGenesis")
+	proto.RegisterMapType((map[string]*Policy)(nil), "generate.ReqGenesis.ApplicationPoliciesEntry")
+	proto.RegisterMapType((map[string]*Policy)(nil), "generate.ReqGenesis.ChannelPoliciesEntry")
+	proto.RegisterMapType((map[string]*Policy)(nil), "generate.ReqGenesis.OrdererPoliciesEntry")
+	proto.RegisterType((*Consortium)(nil), "generate.Consortium")
 	proto.RegisterType((*RespGenesis)(nil), "generate.RespGenesis")
 	proto.RegisterType((*ReqChannelTx)(nil), "generate.ReqChannelTx")
+	proto.RegisterType((*ChannelOrg)(nil), "generate.ChannelOrg")
 	proto.RegisterType((*RespChannelTx)(nil), "generate.RespChannelTx")
+	proto.RegisterType((*ReqPrintOrg)(nil), "generate.ReqPrintOrg")
+	proto.RegisterType((*RespPrintOrg)(nil), "generate.RespPrintOrg")
 	proto.RegisterType((*LeagueInBlock)(nil), "generate.LeagueInBlock")
 	proto.RegisterType((*BatchSize)(nil), "generate.BatchSize")
 	proto.RegisterType((*Kafka)(nil), "generate.Kafka")
+	proto.RegisterType((*EtcdRaft)(nil), "generate.EtcdRaft")
+	proto.RegisterType((*Consenter)(nil), "generate.Consenter")
+	proto.RegisterType((*EtcdRaftOptions)(nil), "generate.EtcdRaftOptions")
 	proto.RegisterType((*OrgInBlock)(nil), "generate.OrgInBlock")
+	proto.RegisterMapType((map[string]*Policy)(nil), "generate.OrgInBlock.PoliciesEntry")
 	proto.RegisterType((*AnchorPeer)(nil), "generate.AnchorPeer")
+	proto.RegisterType((*Policy)(nil), "generate.Policy")
 }
 
 func init() { proto.RegisterFile("grpc/proto/generate/genesis.proto", fileDescriptor_a37cb49bec1cbcbc) }
 
 var fileDescriptor_a37cb49bec1cbcbc = []byte{
-	// 569 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xc7, 0xe5, 0xc6, 0x49, 0xf1, 0xa4, 0x69, 0xe9, 0x16, 0x81, 0x55, 0x21, 0xe1, 0x5a, 0xaa,
-	0x64, 0x55, 0xe0, 0x8a, 0x80, 0xb8, 0xe3, 0x22, 0xa1, 0xaa, 0x44, 0xad, 0x96, 0x9e, 0x10, 0x97,
-	0x8d, 0x3d, 0x75, 0xac, 0x38, 0xbb, 0xee, 0xee, 0x5a, 0x4a, 0x79, 0x09, 0x8e, 0x3c, 0x21, 0xef,
-	0x81, 0xbc, 0xb6, 0xe3, 0xa4, 0xcd, 0x8d, 0xdb, 0xcc, 0x7f, 0x7e, 0xde, 0xf9, 0xd8, 0xf1, 0xc2,
-	0x49, 0x2a, 0x8b, 0xf8, 0xbc, 0x90, 0x42, 0x8b, 0xf3, 0x14, 0x39, 0x4a, 0xa6, 0xd1, 0x18, 0x2a,
-	0x53, 0xa1, 0x91, 0xc9, 0xb3, 0x56, 0x3f, 0x7e, 0xb3, 0x0d, 0x46, 0x5e, 0x2e, 0x1a, 0xf4, 0xd8,
-	0xdb, 0x06, 0x48, 0x54, 0x65, 0xae, 0x6b, 0xc2, 0x4f, 0x01, 0x28, 0xde, 0x7f, 0xad, 0x13, 0x90,
-	0x73, 0x18, 0xe4, 0xc8, 0xd2, 0x12, 0x5d, 0xcb, 0xb3, 0x82, 0xe1, 0xf8, 0x55, 0xd8, 0x7e, 0x15,
-	0x7e, 0x33, 0xfa, 0x25, 0x8f, 0x72, 0x11, 0xcf, 0x69, 0x83, 0x91, 0x00, 0x6c, 0x21, 0x53, 0xe5,
-	0xee, 0x78, 0xbd, 0x60, 0x38, 0x7e, 0xd1, 0xe1, 0xd7, 0x32, 0x6d, 0x59, 0x43, 0xf8, 0x97, 0x30,
-	0xa4, 0xa8, 0x8a, 0x36, 0x93, 0x0f, 0x76, 0x2c, 0x92, 0x3a, 0xcf, 0xfe, 0x78, 0xbf, 0xfb, 0xf0,
-	0x42, 0x24, 0x48, 0x4d, 0x8c, 0xbc, 0x84, 0x01, 0x4a, 0x39, 0x51, 0xa9, 0xbb, 0xe3, 0x59, 0x81,
-	0x43, 0x1b, 0xcf, 0xff, 0x09, 0x7b, 0x14, 0xef, 0x2f, 0x66, 0x8c, 0x73, 0xcc, 0x6f, 0x97, 0xe4,
-	0x35, 0x38, 0x71, 0xed, 0x5c, 0x7e, 0x31, 0x07, 0x3a, 0xb4, 0x13, 0x48, 0x08, 0xbb, 0xcd, 0xfc,
-	0xcc, 0x31, 0x1b, 0x55, 0x76, 0xad, 0xd3, 0x16, 0xf2, 0xaf, 0x60, 0x54, 0x15, 0xda, 0x1d, 0xff,
-	0x3f, 0xa5, 0xfe, 0xb5, 0x60, 0xb4, 0x31, 0xb9, 0x8a, 0x4c, 0xc4, 0x82, 0x65, 0xbc, 0xa9, 0xb4,
-	0xf1, 0xaa, 0x26, 0x58, 0x92, 0x48, 0x54, 0x0a, 0xeb, 0x71, 0x3a, 0xb4, 0x13, 0x88, 0x0f, 0x7b,
-	0x11, 0xd3, 0xf1, 0xec, 0x36, 0x5b, 0xa0, 0x28, 0xb5, 0xdb, 0xf3, 0xac, 0xa0, 0x47, 0x37, 0x34,
-	0xf2, 0x1e, 0x9c, 0x69, 0xe5, 0x7f, 0xcf, 0x7e, 0xa1, 0x6b, 0x9b, 0x56, 0x8f, 0xba, 0x62, 0xa3,
-	0x36, 0x44, 0x3b, 0x8a, 0x9c, 0x42, 0x7f, 0xce, 0xee, 0xe6, 0xcc, 0xed, 0x1b, 0xfc, 0xa0, 0xc3,
-	0xaf, 0x2a, 0x99, 0xd6, 0x51, 0xe2, 0xc1, 0x70, 0xc2, 0x96, 0xcd, 0x44, 0x94, 0x3b, 0xf0, 0xac,
-	0xc0, 0xa6, 0xeb, 0x92, 0xff, 0xdb, 0x02, 0x67, 0x95, 0x81, 0x04, 0x70, 0xb0, 0x60, 0xcb, 0x09,
-	0x2a, 0xc5, 0x52, 0xbc, 0x10, 0x25, 0xd7, 0xa6, 0xd9, 0x11, 0x7d, 0x2c, 0x93, 0x33, 0x78, 0xce,
-	0xa6, 0x4a, 0xe4, 0xa5, 0xc6, 0x09, 0x5b, 0x46, 0x0f, 0x1a, 0xeb, 0x5b, 0x1a, 0xd1, 0x27, 0x3a,
-	0x79, 0x0b, 0x87, 0x85, 0xc4, 0x3b, 0x94, 0x12, 0x93, 0x15, 0xdc, 0x33, 0xf0, 0xd3, 0x80, 0x7f,
-	0x02, 0x7d, 0xd3, 0x03, 0x71, 0x61, 0x77, 0x2a, 0xc5, 0x1c, 0xa5, 0x72, 0x2d, 0x33, 0xd6, 0xd6,
-	0xf5, 0xff, 0x58, 0x00, 0xdd, 0x9e, 0xae, 0xdd, 0xcc, 0xce, 0xc6, 0xcd, 0x10, 0xb0, 0x39, 0x5b,
-	0xa0, 0x49, 0xe5, 0x50, 0x63, 0x93, 0x53, 0xb0, 0xf5, 0x43, 0x51, 0x8f, 0x79, 0x7f, 0x7c, 0xb8,
-	0xb1, 0xf7, 0xb7, 0x0f, 0x05, 0x52, 0x13, 0x26, 0x9f, 0x60, 0xc8, 0x78, 0x3c, 0x13, 0xf2, 0x06,
-	0xab, 0xfc, 0xfd, 0xc7, 0x7f, 0xc9, 0xe7, 0x55, 0x90, 0xae, 0x83, 0xfe, 0x47, 0x80, 0x2e, 0x54,
-	0x15, 0x30, 0x13, 0x4a, 0x37, 0x0b, 0x63, 0xec, 0x4a, 0x2b, 0x84, 0xd4, 0xa6, 0xd4, 0x3e, 0x35,
-	0x76, 0x74, 0x0d, 0x67, 0x99, 0x08, 0x91, 0x73, 0x21, 0xc2, 0x3b, 0x36, 0x95, 0x59, 0xfc, 0x2e,
-	0xce, 0x33, 0xe4, 0x3a, 0xac, 0x9e, 0x81, 0xfa, 0x87, 0x5f, 0xe5, 0x8e, 0xf6, 0x9a, 0xcd, 0xbf,
-	0xa9, 0xe4, 0x1f, 0x47, 0x5b, 0x5e, 0x8a, 0xe9, 0xc0, 0xf8, 0x1f, 0xfe, 0x05, 0x00, 0x00, 0xff,
-	0xff, 0xa5, 0x3e, 0xdd, 0x14, 0x95, 0x04, 0x00, 0x00,
+	// 1129 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdb, 0x6e, 0x1b, 0x37,
+	0x13, 0x86, 0x4e, 0xb6, 0x34, 0xb2, 0x62, 0x87, 0x36, 0xfe, 0xec, 0x2f, 0x14, 0xad, 0xb3, 0x68,
+	0x02, 0xf7, 0x10, 0x19, 0xb5, 0x8b, 0xa0, 0x28, 0xd0, 0x02, 0x91, 0x93, 0x06, 0x46, 0xea, 0x5a,
+	0x60, 0x74, 0x91, 0xf6, 0xa6, 0xa0, 0x56, 0xa3, 0xd5, 0x56, 0xab, 0xe5, 0x9a, 0xa4, 0x0c, 0xbb,
+	0x57, 0x7d, 0x83, 0xbe, 0x45, 0xae, 0xfb, 0x30, 0x7d, 0x81, 0xa2, 0x2f, 0x52, 0x90, 0xdc, 0xa3,
+	0xb4, 0x3d, 0xa0, 0xee, 0x1d, 0x39, 0xf3, 0xf1, 0xdb, 0xe1, 0x37, 0x87, 0x25, 0x3c, 0xf4, 0x45,
+	0xec, 0x1d, 0xc7, 0x82, 0x2b, 0x7e, 0xec, 0x63, 0x84, 0x82, 0x29, 0x34, 0x0b, 0x19, 0xc8, 0x81,
+	0x31, 0x93, 0x76, 0x6a, 0xef, 0xbf, 0x57, 0x05, 0xc6, 0x68, 0xb5, 0x4c, 0xa0, 0xfd, 0xc3, 0x2a,
+	0x80, 0x40, 0xb9, 0x0a, 0x95, 0x45, 0xb8, 0xbf, 0xb4, 0x00, 0x28, 0x5e, 0xbd, 0xb4, 0x5f, 0x20,
+	0xc7, 0xb0, 0x15, 0x22, 0xf3, 0x57, 0xe8, 0xd4, 0x0e, 0x6b, 0x47, 0xdd, 0x93, 0x07, 0x83, 0xf4,
+	0xd8, 0xe0, 0x6b, 0x63, 0x3f, 0x8f, 0x86, 0x21, 0xf7, 0x16, 0x34, 0x81, 0x91, 0x23, 0x68, 0x72,
+	0xe1, 0x4b, 0xa7, 0x7e, 0xd8, 0x38, 0xea, 0x9e, 0x1c, 0xe4, 0xf0, 0x4b, 0xe1, 0xa7, 0x58, 0x83,
+	0x20, 0xdf, 0xc3, 0x3e, 0x8b, 0xe3, 0x30, 0xf0, 0x98, 0x0a, 0x78, 0x34, 0xe2, 0x61, 0xe0, 0x05,
+	0x28, 0x9d, 0x86, 0x39, 0xf8, 0x24, 0x3f, 0x98, 0x47, 0x33, 0x78, 0xb6, 0x89, 0x7f, 0x11, 0x29,
+	0x71, 0x4b, 0xab, 0x98, 0xc8, 0x6b, 0xd8, 0xe5, 0x62, 0x8a, 0x02, 0x45, 0x46, 0xde, 0x34, 0xe4,
+	0x1f, 0x54, 0x92, 0x5f, 0x96, 0xb1, 0x96, 0x78, 0x9d, 0x41, 0x93, 0x7a, 0x73, 0x16, 0x45, 0x18,
+	0x66, 0xa4, 0xad, 0xbf, 0x20, 0x3d, 0x2b, 0x63, 0x13, 0xd2, 0x35, 0x06, 0xf2, 0x14, 0xba, 0x1e,
+	0x8f, 0x24, 0x17, 0x2a, 0x58, 0x2d, 0xa5, 0xb3, 0xb5, 0xae, 0xdd, 0x59, 0xe6, 0xa4, 0x45, 0x60,
+	0xff, 0x0d, 0x38, 0x7f, 0x26, 0x09, 0xd9, 0x83, 0xc6, 0x02, 0x6f, 0x4d, 0xda, 0x3a, 0x54, 0x2f,
+	0xc9, 0x63, 0x68, 0x5d, 0xb3, 0x70, 0x85, 0x4e, 0xdd, 0xa4, 0x72, 0x2f, 0xe7, 0x37, 0x27, 0x6f,
+	0xa9, 0x75, 0x7f, 0x5e, 0xff, 0xac, 0xd6, 0x1f, 0xc3, 0x41, 0x95, 0x1e, 0x77, 0x67, 0xad, 0x12,
+	0xe4, 0x6e, 0xac, 0xee, 0xa7, 0x00, 0xb9, 0x40, 0x84, 0x40, 0x33, 0x62, 0x4b, 0x4c, 0xc8, 0xcc,
+	0x5a, 0xdb, 0xb2, 0xa2, 0xec, 0xd8, 0xf2, 0x73, 0xcf, 0xa1, 0x4b, 0x51, 0xc6, 0x69, 0xa1, 0xbb,
+	0xd0, 0xf4, 0xf8, 0xd4, 0x1e, 0xbb, 0x77, 0x72, 0xaf, 0xa8, 0xfd, 0x14, 0xa9, 0xf1, 0x91, 0xff,
+	0xc1, 0x16, 0x0a, 0x71, 0x21, 0x7d, 0x13, 0x55, 0x87, 0x26, 0x3b, 0xf7, 0x6d, 0x0d, 0x76, 0x28,
+	0x5e, 0x25, 0x57, 0x1b, 0xdf, 0x90, 0x77, 0xa0, 0x93, 0xa4, 0xf8, 0xfc, 0x79, 0x12, 0x48, 0x6e,
+	0x20, 0x03, 0xd8, 0x4e, 0x1a, 0x38, 0xb9, 0xdd, 0x41, 0x55, 0xe9, 0xd0, 0x14, 0x44, 0xde, 0x05,
+	0xc8, 0x93, 0xee, 0x34, 0x0c, 0x5d, 0xc1, 0x92, 0xb5, 0x5c, 0x73, 0xa3, 0x6c, 0xec, 0x27, 0x2f,
+	0x85, 0x9f, 0xdc, 0xf9, 0x0d, 0x40, 0x6e, 0xab, 0x54, 0xea, 0x29, 0x74, 0x59, 0xe4, 0xcd, 0xb9,
+	0x18, 0x21, 0x8a, 0x8a, 0x2e, 0x7e, 0x96, 0x39, 0x69, 0x11, 0xe8, 0xbe, 0x82, 0x9e, 0x56, 0x33,
+	0x97, 0xe0, 0x2e, 0x7a, 0x7e, 0xab, 0x53, 0x73, 0x35, 0x12, 0x41, 0xa4, 0x74, 0x9c, 0x2e, 0xec,
+	0xd8, 0xe1, 0xf2, 0x9c, 0x2f, 0x59, 0x10, 0x25, 0xf1, 0x96, 0x6c, 0xe4, 0x31, 0x34, 0xb8, 0xf0,
+	0x37, 0xf5, 0x2c, 0x4c, 0x1d, 0x0d, 0x70, 0x7f, 0x32, 0xa9, 0x92, 0x71, 0x81, 0xfc, 0x5f, 0xc7,
+	0x49, 0x0e, 0x4d, 0xdb, 0xce, 0x02, 0xff, 0xa5, 0xe0, 0xab, 0xd8, 0x64, 0x66, 0x87, 0x16, 0x4d,
+	0x5a, 0xe2, 0x1f, 0x24, 0x8f, 0x9c, 0xa6, 0x95, 0x58, 0xaf, 0xdd, 0x5f, 0xeb, 0xd0, 0x2b, 0xcd,
+	0x4e, 0xcd, 0x3f, 0x2d, 0x5e, 0x2d, 0xd9, 0xe9, 0x32, 0x62, 0xd3, 0xa9, 0x40, 0x29, 0x31, 0xad,
+	0xdd, 0xdc, 0xa0, 0x65, 0x19, 0x32, 0xe5, 0xcd, 0xc7, 0xc1, 0x12, 0xf9, 0x4a, 0x99, 0xcf, 0x37,
+	0x68, 0xc9, 0x46, 0x3e, 0x81, 0xce, 0x44, 0xef, 0x5f, 0x07, 0x3f, 0xa2, 0x09, 0xa2, 0x7b, 0xb2,
+	0x9f, 0x5f, 0x71, 0x98, 0xba, 0x68, 0x8e, 0x22, 0x8f, 0xa0, 0xb5, 0x60, 0xb3, 0x05, 0x73, 0x5a,
+	0x06, 0xbe, 0x9b, 0xc3, 0x5f, 0x69, 0x33, 0xb5, 0x5e, 0x7d, 0xf7, 0x0b, 0x76, 0x93, 0xe4, 0x5b,
+	0x8f, 0xac, 0xda, 0x51, 0x93, 0x16, 0x4d, 0xe4, 0x0b, 0xe8, 0xe9, 0x22, 0xc5, 0x48, 0xae, 0xe4,
+	0xf8, 0x36, 0x46, 0x67, 0xdb, 0x48, 0xfc, 0xa0, 0x3c, 0xd6, 0x32, 0x37, 0x2d, 0xa3, 0xc9, 0x00,
+	0xda, 0xa8, 0xbc, 0x29, 0x65, 0x33, 0xe5, 0xb4, 0x4d, 0x28, 0x24, 0x3f, 0xf9, 0x22, 0xf1, 0xd0,
+	0x0c, 0xe3, 0xfe, 0x5c, 0x83, 0x4e, 0x76, 0x21, 0x72, 0x04, 0xbb, 0x4b, 0x76, 0x73, 0x81, 0x52,
+	0x32, 0x1f, 0xcf, 0xf8, 0x2a, 0x52, 0x46, 0xdb, 0x1e, 0x5d, 0x37, 0x93, 0x0f, 0x61, 0x8f, 0x4d,
+	0x24, 0x0f, 0x57, 0x0a, 0x2f, 0xd8, 0xcd, 0xf0, 0x56, 0xa1, 0x6d, 0xcb, 0x1e, 0xdd, 0xb0, 0x93,
+	0x8f, 0xe1, 0x7e, 0x2c, 0x70, 0x86, 0x42, 0xe0, 0x34, 0x03, 0x37, 0x0c, 0x78, 0xd3, 0xe1, 0x3e,
+	0x84, 0x96, 0x91, 0x8c, 0x38, 0xb0, 0x3d, 0x11, 0x7c, 0xa1, 0x1b, 0xaa, 0x66, 0xb2, 0x98, 0x6e,
+	0x5d, 0x05, 0xed, 0xf4, 0x2a, 0xe4, 0xd4, 0xb6, 0x39, 0x46, 0x2a, 0x05, 0x96, 0x92, 0x75, 0x96,
+	0xfa, 0x68, 0x01, 0x46, 0x4e, 0x61, 0x9b, 0xc7, 0x7a, 0xf8, 0xa7, 0xb3, 0xe4, 0xff, 0x9b, 0x22,
+	0x5d, 0x5a, 0x00, 0x4d, 0x91, 0xee, 0xef, 0x35, 0xe8, 0x64, 0x74, 0xba, 0x46, 0xe7, 0x5c, 0xaa,
+	0x74, 0x0c, 0xe8, 0xb5, 0xb6, 0xc5, 0x5c, 0xa8, 0x44, 0x08, 0xb3, 0xd6, 0xb7, 0xe0, 0xc2, 0xff,
+	0x46, 0x4f, 0x0c, 0x3b, 0x83, 0xd2, 0xad, 0xae, 0x53, 0x2e, 0xfc, 0xa4, 0x3b, 0x6d, 0xa9, 0xe7,
+	0x06, 0xd2, 0x87, 0x76, 0xc4, 0xa7, 0x68, 0x0e, 0xb6, 0x8c, 0x33, 0xdb, 0x6b, 0x41, 0xbd, 0x30,
+	0xc0, 0x48, 0x8d, 0x43, 0x79, 0x86, 0x42, 0x8d, 0x98, 0x9a, 0x9b, 0x5a, 0xea, 0xd0, 0x4d, 0x87,
+	0x46, 0x4b, 0x14, 0xd7, 0x28, 0x8a, 0xe8, 0x6d, 0x8b, 0xde, 0x70, 0xb8, 0xbf, 0xd5, 0x60, 0x77,
+	0x4d, 0x02, 0xdd, 0x33, 0x2a, 0xf0, 0x16, 0xe7, 0xfa, 0xe2, 0xd7, 0x2c, 0x4c, 0x47, 0x49, 0xd1,
+	0xa6, 0x31, 0x18, 0xa2, 0xa7, 0x0f, 0x8c, 0x03, 0x6f, 0x91, 0x68, 0x50, 0xb2, 0x91, 0xf7, 0xa1,
+	0x37, 0x47, 0x26, 0xd4, 0x04, 0x99, 0x32, 0x20, 0x5b, 0x04, 0x65, 0xa3, 0x8e, 0x77, 0xc9, 0x6e,
+	0xce, 0xa3, 0x59, 0x18, 0xf8, 0x73, 0x65, 0x7a, 0x5d, 0x1a, 0x7d, 0x7a, 0x74, 0xd3, 0x41, 0x4e,
+	0xe0, 0x40, 0x46, 0x2c, 0x96, 0x73, 0xae, 0xd2, 0x58, 0x4c, 0xdb, 0xb6, 0xcc, 0x81, 0x4a, 0x9f,
+	0xfb, 0xb6, 0x0e, 0x90, 0x8f, 0xb8, 0xc2, 0x20, 0xa9, 0x97, 0x06, 0x49, 0x3a, 0xe9, 0x1b, 0x85,
+	0x49, 0xff, 0x08, 0x9a, 0x4a, 0x77, 0x65, 0xd3, 0x74, 0xe5, 0xfd, 0xd2, 0xc8, 0x34, 0xfd, 0x68,
+	0xdc, 0xeb, 0x3f, 0x84, 0xd6, 0x3f, 0xfc, 0x21, 0x90, 0x2f, 0xa1, 0x1d, 0xa7, 0x0f, 0x24, 0xfb,
+	0x9e, 0x71, 0xab, 0xa6, 0xf2, 0xa0, 0xfc, 0x32, 0xca, 0xce, 0xf4, 0x2f, 0xa0, 0xf7, 0x1f, 0xbf,
+	0x11, 0xf2, 0x48, 0xff, 0xb6, 0xe4, 0x5b, 0xb6, 0xe4, 0xdd, 0xaf, 0x60, 0xcb, 0x52, 0xe9, 0x7f,
+	0xac, 0x51, 0xcb, 0xfe, 0x26, 0x0e, 0xd6, 0x3f, 0x55, 0x10, 0x8c, 0x40, 0x53, 0xac, 0x42, 0x4c,
+	0x32, 0x60, 0xd6, 0xc3, 0x11, 0x7c, 0xe4, 0x45, 0x03, 0x36, 0x41, 0x11, 0x78, 0x83, 0x19, 0x9b,
+	0x88, 0xc0, 0x7b, 0x62, 0xcb, 0x7b, 0xa0, 0x1f, 0xe4, 0xf6, 0xe9, 0x9d, 0x71, 0x0e, 0x77, 0x92,
+	0x27, 0xc0, 0x48, 0x9b, 0xbf, 0xdb, 0xaf, 0x78, 0xb3, 0x4f, 0xb6, 0xcc, 0xfe, 0xf4, 0x8f, 0x01,
+	0x00, 0xbc, 0xbb, 0xb3, 0xcd, 0x1f, 0x0c, 0x00, 0x00,
 }
@@ -25,28 +25,39 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 func init() { proto.RegisterFile("grpc/proto/generate/server.proto", fileDescriptor_4a0d3d885d36b862) }
 
 var fileDescriptor_4a0d3d885d36b862 = []byte{
-	// 321 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x5f, 0x4b, 0xf3, 0x30,
-	0x14, 0xc6, 0x73, 0xf1, 0xf2, 0x32, 0x32, 0x50, 0x8c, 0x53, 0x31, 0x82, 0x68, 0x2e, 0x05, 0x33,
-	0xd0, 0x2f, 0x20, 0x0b, 0x3a, 0xc4, 0xb1, 0x89, 0x7f, 0x6e, 0xbc, 0xeb, 0xea, 0x59, 0x2d, 0x8e,
-	0xa4, 0x9e, 0x54, 0x71, 0x1f, 0xd1, 0x6f, 0x25, 0x59, 0x9b, 0xda, 0xae, 0xe9, 0x5d, 0xfa, 0xfc,
-	0x4e, 0x7e, 0x3c, 0xa4, 0x87, 0x9e, 0x24, 0x98, 0xc5, 0xc3, 0x0c, 0x4d, 0x6e, 0x86, 0x09, 0x68,
-	0xc0, 0x28, 0x87, 0xa1, 0x05, 0xfc, 0x02, 0x94, 0xeb, 0x94, 0xf5, 0x7c, 0xcc, 0x8f, 0x43, 0xb3,
-	0x31, 0x60, 0x5e, 0x4c, 0xf2, 0xd3, 0x10, 0x77, 0x07, 0x9b, 0xda, 0x62, 0xe4, 0xe2, 0xe7, 0x1f,
-	0xed, 0x8d, 0x4b, 0xc4, 0x14, 0xdd, 0xf2, 0x67, 0x85, 0xab, 0x2c, 0x37, 0x6c, 0x5f, 0xfa, 0x7b,
-	0xf2, 0x01, 0x3e, 0xee, 0x60, 0xa5, 0x8c, 0x5e, 0xa4, 0x09, 0x3f, 0xa8, 0xe7, 0x36, 0xab, 0x80,
-	0x20, 0xec, 0xf6, 0x4f, 0x32, 0x81, 0x28, 0xf9, 0x04, 0x76, 0xd8, 0x90, 0x28, 0x84, 0x0a, 0x71,
-	0xde, 0xf4, 0xd4, 0x99, 0x20, 0xec, 0x8a, 0xf6, 0xbd, 0x6a, 0x86, 0xc9, 0x46, 0x99, 0x62, 0x76,
-	0x86, 0xad, 0x32, 0x15, 0x68, 0x1a, 0x94, 0xc5, 0xa0, 0x41, 0x59, 0x0c, 0x1b, 0x94, 0x45, 0x41,
-	0xd8, 0x84, 0x6e, 0xd7, 0x3a, 0x4c, 0xcd, 0x2b, 0x30, 0x1e, 0xee, 0xe1, 0x18, 0x3f, 0xea, 0xe8,
-	0xe2, 0x60, 0xcb, 0xf6, 0x6c, 0x01, 0xbb, 0x6c, 0x8e, 0x75, 0xda, 0x1c, 0x14, 0x84, 0x5d, 0xd3,
-	0x81, 0xb7, 0x8d, 0x8b, 0xbf, 0x3a, 0x5a, 0x9a, 0xf8, 0x9d, 0x0d, 0x1a, 0xca, 0x12, 0xf1, 0xbd,
-	0xa6, 0xac, 0x8c, 0x05, 0x61, 0x37, 0x74, 0xa7, 0x7a, 0xa4, 0xb7, 0x48, 0x6b, 0x58, 0x3e, 0x7d,
-	0x6f, 0x3e, 0x95, 0xcf, 0x5b, 0x4f, 0xe5, 0x81, 0x20, 0xa3, 0x29, 0x3d, 0x4b, 0x8d, 0x04, 0xad,
-	0x8d, 0x91, 0x8b, 0x68, 0x8e, 0x69, 0x7c, 0x1e, 0x2f, 0x53, 0xd0, 0xb9, 0x74, 0x7b, 0x58, 0x2c,
-	0x5c, 0x75, 0x7b, 0xd4, 0x7f, 0x5c, 0x2f, 0xf5, 0xbd, 0x4b, 0x5f, 0x76, 0x03, 0x9b, 0x3a, 0xff,
-	0xbf, 0xfe, 0xbe, 0xfc, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xa8, 0x31, 0x9b, 0xab, 0x13, 0x03, 0x00,
-	0x00,
+	// 504 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x41, 0x6f, 0xd3, 0x4c,
+	0x10, 0x86, 0xb7, 0x97, 0x4f, 0xe9, 0xb6, 0xea, 0x47, 0x87, 0x52, 0x60, 0x81, 0x02, 0x7b, 0x42,
+	0x42, 0x38, 0x08, 0x8e, 0x48, 0x88, 0xc6, 0x94, 0x2a, 0x90, 0x92, 0xa8, 0x05, 0x84, 0xb8, 0x39,
+	0xee, 0x24, 0xac, 0x1a, 0xbc, 0xce, 0xec, 0x52, 0x9a, 0x1f, 0xc1, 0x7f, 0x46, 0x8e, 0xbd, 0xb6,
+	0xd7, 0x76, 0x6e, 0xf6, 0xfb, 0x8c, 0x9f, 0x4c, 0xc6, 0xde, 0xe1, 0x4f, 0xe6, 0x94, 0xc6, 0xfd,
+	0x94, 0xb4, 0xd5, 0xfd, 0x39, 0x26, 0x48, 0x91, 0xc5, 0xbe, 0x41, 0xba, 0x46, 0x0a, 0xd6, 0x29,
+	0xf4, 0x5c, 0x2c, 0x64, 0x57, 0x6d, 0x44, 0x56, 0xcd, 0xa2, 0xd8, 0xe6, 0xd5, 0xe2, 0xa8, 0xab,
+	0x26, 0x46, 0x72, 0xfc, 0x69, 0x17, 0xcf, 0x2e, 0x8c, 0x32, 0x79, 0xc9, 0xab, 0xbf, 0xdb, 0xbc,
+	0x77, 0x5a, 0x20, 0x08, 0xf9, 0x9e, 0xbb, 0x0e, 0x69, 0x95, 0x5a, 0x0d, 0x87, 0x81, 0x7b, 0x2e,
+	0x38, 0xc7, 0xe5, 0x27, 0x5c, 0x85, 0x3a, 0x99, 0xa9, 0xb9, 0xb8, 0x5b, 0xcf, 0x4d, 0x5a, 0x02,
+	0xc9, 0x60, 0x58, 0x49, 0x46, 0x18, 0xcd, 0x7f, 0x23, 0xdc, 0xf7, 0x24, 0x21, 0x61, 0x89, 0x84,
+	0xf0, 0x3d, 0x75, 0x26, 0x19, 0xbc, 0xe3, 0x3b, 0x4e, 0x35, 0xa6, 0x79, 0xa3, 0x99, 0xbc, 0x76,
+	0x4c, 0xad, 0x66, 0x4a, 0xe0, 0x1b, 0x42, 0x43, 0x9d, 0x86, 0xd0, 0x50, 0xb7, 0x21, 0x34, 0x24,
+	0x19, 0x8c, 0xf8, 0xff, 0xb5, 0x1e, 0x3e, 0xeb, 0x4b, 0x04, 0xd1, 0xdd, 0x47, 0xc6, 0xc4, 0x83,
+	0x0d, 0xbd, 0x64, 0xb0, 0x65, 0xfb, 0x6a, 0x90, 0x36, 0xd9, 0x32, 0xb6, 0xd1, 0x96, 0x41, 0xc9,
+	0xe0, 0x98, 0xf3, 0x73, 0xbc, 0xd6, 0x57, 0x18, 0x22, 0x59, 0xf0, 0xfe, 0xc4, 0xb2, 0x02, 0xe2,
+	0x9e, 0x6f, 0xa9, 0x88, 0x64, 0xf0, 0x96, 0x6f, 0x7f, 0x43, 0x52, 0xb3, 0xd5, 0xd9, 0xc5, 0xa4,
+	0x31, 0x9e, 0x32, 0x6f, 0x8e, 0xa7, 0x04, 0x92, 0xc1, 0x19, 0xdf, 0x1b, 0x29, 0x63, 0x33, 0xdb,
+	0xc9, 0x4d, 0xaa, 0x68, 0x05, 0x5e, 0xcf, 0x4b, 0x1f, 0x8a, 0x87, 0xbe, 0xc9, 0xa7, 0x92, 0xc1,
+	0x09, 0x3f, 0x70, 0xf3, 0x39, 0xcd, 0xbf, 0xd3, 0xc1, 0x42, 0xc7, 0x57, 0x70, 0xe0, 0x49, 0x0b,
+	0x24, 0xee, 0xf8, 0xb6, 0x22, 0x96, 0x0c, 0x3e, 0xf0, 0xfd, 0xf2, 0xb5, 0xff, 0x8c, 0x92, 0x04,
+	0x17, 0x5f, 0x6e, 0x9a, 0x2f, 0xdf, 0xe5, 0xad, 0x97, 0xef, 0x80, 0x64, 0xf0, 0x86, 0xf7, 0x26,
+	0xa4, 0x12, 0x9b, 0x7d, 0x7d, 0xde, 0x8f, 0x2d, 0x5d, 0x2c, 0x0e, 0xfd, 0xa7, 0x5d, 0x2e, 0x19,
+	0x7c, 0xe7, 0xfb, 0xef, 0xf5, 0x9f, 0x64, 0xa1, 0xa3, 0xcb, 0xe3, 0xe2, 0xdc, 0x1a, 0x38, 0xf2,
+	0x2c, 0x2d, 0x2e, 0x1e, 0xfb, 0xba, 0x56, 0x81, 0x64, 0x2f, 0xb7, 0x60, 0xc0, 0x77, 0x87, 0x89,
+	0x49, 0x31, 0xb6, 0xf9, 0x74, 0xfc, 0x03, 0x56, 0x47, 0xcd, 0x11, 0x15, 0x4c, 0x32, 0xf8, 0xc8,
+	0x6f, 0x15, 0x37, 0xd5, 0x84, 0x1e, 0x75, 0x79, 0xaa, 0x41, 0x6d, 0x74, 0x0d, 0xf9, 0xee, 0xf0,
+	0x57, 0xaa, 0xc9, 0x16, 0x5b, 0xa3, 0xd1, 0x4f, 0x0d, 0x35, 0x0f, 0x7c, 0x9d, 0x49, 0xf6, 0x6c,
+	0x6b, 0x30, 0xe6, 0xcf, 0xe3, 0x24, 0x88, 0xa6, 0x48, 0x2a, 0x0e, 0x66, 0xd1, 0x94, 0x54, 0xfc,
+	0x22, 0x5e, 0x28, 0x4c, 0x6c, 0x90, 0x2d, 0xb3, 0x7c, 0x6b, 0x95, 0x8e, 0xc1, 0xce, 0xc5, 0x7a,
+	0x7b, 0x4e, 0xb2, 0xf4, 0xc7, 0xed, 0x8e, 0x75, 0x37, 0xfd, 0x6f, 0x7d, 0xff, 0xfa, 0xdf, 0x00,
+	0x39, 0x0d, 0xc5, 0x7e, 0x7c, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -67,8 +78,16 @@ type GenerateClient interface {
 	GenerateCsr(ctx context.Context, in *ReqCreateCsr, opts ...grpc.CallOption) (*RespCreateCsr, error)
 	GenerateOrgNode(ctx context.Context, in *ReqCreateOrgNode, opts ...grpc.CallOption) (*RespCreateOrgNode, error)
 	GenerateOrgUser(ctx context.Context, in *ReqCreateOrgUser, opts ...grpc.CallOption) (*RespCreateOrgUser, error)
+	RevokeCert(ctx context.Context, in *ReqRevokeCert, opts ...grpc.CallOption) (*RespRevokeCert, error)
+	VerifyMSP(ctx context.Context, in *ReqVerifyMSP, opts ...grpc.CallOption) (*RespVerifyMSP, error)
+	ListCertExpiry(ctx context.Context, in *ReqListCertExpiry, opts ...grpc.CallOption) (*RespListCertExpiry, error)
 	GenerateGenesisBlock(ctx context.Context, in *ReqGenesis, opts ...grpc.CallOption) (*RespGenesis, error)
 	GenerateChannelTx(ctx context.Context, in *ReqChannelTx, opts ...grpc.CallOption) (*RespChannelTx, error)
+	PrintOrg(ctx context.Context, in *ReqPrintOrg, opts ...grpc.CallOption) (*RespPrintOrg, error)
+	DownloadArtifacts(ctx context.Context, in *ReqDownloadArtifacts, opts ...grpc.CallOption) (Generate_DownloadArtifactsClient, error)
+	InspectBlock(ctx context.Context, in *ReqInspectBlock, opts ...grpc.CallOption) (*RespInspect, error)
+	InspectChannelTx(ctx context.Context, in *ReqInspectChannelTx, opts ...grpc.CallOption) (*RespInspect, error)
+	ImportCrypto(ctx context.Context, opts ...grpc.CallOption) (Generate_ImportCryptoClient, error)
 }
 
 type generateClient struct {
@@ -133,6 +152,33 @@ func (c *generateClient) GenerateOrgUser(ctx context.Context, in *ReqCreateOrgUs
 	return out, nil
 }
 
+func (c *generateClient) RevokeCert(ctx context.Context, in *ReqRevokeCert, opts ...grpc.CallOption) (*RespRevokeCert, error) {
+	out := new(RespRevokeCert)
+	err := c.cc.Invoke(ctx, "/generate.Generate/RevokeCert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *generateClient) VerifyMSP(ctx context.Context, in *ReqVerifyMSP, opts ...grpc.CallOption) (*RespVerifyMSP, error) {
+	out := new(RespVerifyMSP)
+	err := c.cc.Invoke(ctx, "/generate.Generate/VerifyMSP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *generateClient) ListCertExpiry(ctx context.Context, in *ReqListCertExpiry, opts ...grpc.CallOption) (*RespListCertExpiry, error) {
+	out := new(RespListCertExpiry)
+	err := c.cc.Invoke(ctx, "/generate.Generate/ListCertExpiry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *generateClient) GenerateGenesisBlock(ctx context.Context, in *ReqGenesis, opts ...grpc.CallOption) (*RespGenesis, error) {
 	out := new(RespGenesis)
 	err := c.cc.Invoke(ctx, "/generate.Generate/GenerateGenesisBlock", in, out, opts...)
@@ -151,6 +197,99 @@ func (c *generateClient) GenerateChannelTx(ctx context.Context, in *ReqChannelTx
 	return out, nil
 }
 
+func (c *generateClient) PrintOrg(ctx context.Context, in *ReqPrintOrg, opts ...grpc.CallOption) (*RespPrintOrg, error) {
+	out := new(RespPrintOrg)
+	err := c.cc.Invoke(ctx, "/generate.Generate/PrintOrg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *generateClient) DownloadArtifacts(ctx context.Context, in *ReqDownloadArtifacts, opts ...grpc.CallOption) (Generate_DownloadArtifactsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Generate_serviceDesc.Streams[0], "/generate.Generate/DownloadArtifacts", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &generateDownloadArtifactsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Generate_DownloadArtifactsClient interface {
+	Recv() (*RespDownloadArtifacts, error)
+	grpc.ClientStream
+}
+
+type generateDownloadArtifactsClient struct {
+	grpc.ClientStream
+}
+
+func (x *generateDownloadArtifactsClient) Recv() (*RespDownloadArtifacts, error) {
+	m := new(RespDownloadArtifacts)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *generateClient) InspectBlock(ctx context.Context, in *ReqInspectBlock, opts ...grpc.CallOption) (*RespInspect, error) {
+	out := new(RespInspect)
+	err := c.cc.Invoke(ctx, "/generate.Generate/InspectBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *generateClient) InspectChannelTx(ctx context.Context, in *ReqInspectChannelTx, opts ...grpc.CallOption) (*RespInspect, error) {
+	out := new(RespInspect)
+	err := c.cc.Invoke(ctx, "/generate.Generate/InspectChannelTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *generateClient) ImportCrypto(ctx context.Context, opts ...grpc.CallOption) (Generate_ImportCryptoClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Generate_serviceDesc.Streams[1], "/generate.Generate/ImportCrypto", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &generateImportCryptoClient{stream}
+	return x, nil
+}
+
+type Generate_ImportCryptoClient interface {
+	Send(*ReqImportCrypto) error
+	CloseAndRecv() (*RespImportCrypto, error)
+	grpc.ClientStream
+}
+
+type generateImportCryptoClient struct {
+	grpc.ClientStream
+}
+
+func (x *generateImportCryptoClient) Send(m *ReqImportCrypto) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *generateImportCryptoClient) CloseAndRecv() (*RespImportCrypto, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(RespImportCrypto)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // GenerateServer is the server API for Generate service.
 type GenerateServer interface {
 	GenerateCrypto(context.Context, *ReqKeyConfig) (*RespKeyConfig, error)
@@ -159,8 +298,16 @@ type GenerateServer interface {
 	GenerateCsr(context.Context, *ReqCreateCsr) (*RespCreateCsr, error)
 	GenerateOrgNode(context.Context, *ReqCreateOrgNode) (*RespCreateOrgNode, error)
 	GenerateOrgUser(context.Context, *ReqCreateOrgUser) (*RespCreateOrgUser, error)
+	RevokeCert(context.Context, *ReqRevokeCert) (*RespRevokeCert, error)
+	VerifyMSP(context.Context, *ReqVerifyMSP) (*RespVerifyMSP, error)
+	ListCertExpiry(context.Context, *ReqListCertExpiry) (*RespListCertExpiry, error)
 	GenerateGenesisBlock(context.Context, *ReqGenesis) (*RespGenesis, error)
 	GenerateChannelTx(context.Context, *ReqChannelTx) (*RespChannelTx, error)
+	PrintOrg(context.Context, *ReqPrintOrg) (*RespPrintOrg, error)
+	DownloadArtifacts(*ReqDownloadArtifacts, Generate_DownloadArtifactsServer) error
+	InspectBlock(context.Context, *ReqInspectBlock) (*RespInspect, error)
+	InspectChannelTx(context.Context, *ReqInspectChannelTx) (*RespInspect, error)
+	ImportCrypto(Generate_ImportCryptoServer) error
 }
 
 func RegisterGenerateServer(s *grpc.Server, srv GenerateServer) {
@@ -275,6 +422,60 @@ func _Generate_GenerateOrgUser_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Generate_RevokeCert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqRevokeCert)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenerateServer).RevokeCert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generate.Generate/RevokeCert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenerateServer).RevokeCert(ctx, req.(*ReqRevokeCert))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Generate_VerifyMSP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqVerifyMSP)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenerateServer).VerifyMSP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generate.Generate/VerifyMSP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenerateServer).VerifyMSP(ctx, req.(*ReqVerifyMSP))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Generate_ListCertExpiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqListCertExpiry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenerateServer).ListCertExpiry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generate.Generate/ListCertExpiry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenerateServer).ListCertExpiry(ctx, req.(*ReqListCertExpiry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Generate_GenerateGenesisBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ReqGenesis)
 	if err := dec(in); err != nil {
@@ -311,6 +512,107 @@ func _Generate_GenerateChannelTx_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Generate_PrintOrg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqPrintOrg)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenerateServer).PrintOrg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generate.Generate/PrintOrg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenerateServer).PrintOrg(ctx, req.(*ReqPrintOrg))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Generate_DownloadArtifacts_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReqDownloadArtifacts)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GenerateServer).DownloadArtifacts(m, &generateDownloadArtifactsServer{stream})
+}
+
+type Generate_DownloadArtifactsServer interface {
+	Send(*RespDownloadArtifacts) error
+	grpc.ServerStream
+}
+
+type generateDownloadArtifactsServer struct {
+	grpc.ServerStream
+}
+
+func (x *generateDownloadArtifactsServer) Send(m *RespDownloadArtifacts) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Generate_InspectBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqInspectBlock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenerateServer).InspectBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generate.Generate/InspectBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenerateServer).InspectBlock(ctx, req.(*ReqInspectBlock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Generate_InspectChannelTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqInspectChannelTx)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GenerateServer).InspectChannelTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/generate.Generate/InspectChannelTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GenerateServer).InspectChannelTx(ctx, req.(*ReqInspectChannelTx))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Generate_ImportCrypto_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GenerateServer).ImportCrypto(&generateImportCryptoServer{stream})
+}
+
+type Generate_ImportCryptoServer interface {
+	SendAndClose(*RespImportCrypto) error
+	Recv() (*ReqImportCrypto, error)
+	grpc.ServerStream
+}
+
+type generateImportCryptoServer struct {
+	grpc.ServerStream
+}
+
+func (x *generateImportCryptoServer) SendAndClose(m *RespImportCrypto) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *generateImportCryptoServer) Recv() (*ReqImportCrypto, error) {
+	m := new(ReqImportCrypto)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _Generate_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "generate.Generate",
 	HandlerType: (*GenerateServer)(nil),
@@ -339,6 +641,18 @@ var _Generate_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateOrgUser",
 			Handler:    _Generate_GenerateOrgUser_Handler,
 		},
+		{
+			MethodName: "RevokeCert",
+			Handler:    _Generate_RevokeCert_Handler,
+		},
+		{
+			MethodName: "VerifyMSP",
+			Handler:    _Generate_VerifyMSP_Handler,
+		},
+		{
+			MethodName: "ListCertExpiry",
+			Handler:    _Generate_ListCertExpiry_Handler,
+		},
 		{
 			MethodName: "GenerateGenesisBlock",
 			Handler:    _Generate_GenerateGenesisBlock_Handler,
@@ -347,7 +661,30 @@ var _Generate_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GenerateChannelTx",
 			Handler:    _Generate_GenerateChannelTx_Handler,
 		},
+		{
+			MethodName: "PrintOrg",
+			Handler:    _Generate_PrintOrg_Handler,
+		},
+		{
+			MethodName: "InspectBlock",
+			Handler:    _Generate_InspectBlock_Handler,
+		},
+		{
+			MethodName: "InspectChannelTx",
+			Handler:    _Generate_InspectChannelTx_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DownloadArtifacts",
+			Handler:       _Generate_DownloadArtifacts_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ImportCrypto",
+			Handler:       _Generate_ImportCrypto_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "grpc/proto/generate/server.proto",
 }
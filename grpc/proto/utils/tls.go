@@ -0,0 +1,113 @@
+package utils
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"github.com/aberic/gnomon"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"io/ioutil"
+	"sync"
+)
+
+// 节点间通信mTLS环境变量，三者均配置后节点间通信及请求转发启用mTLS
+const (
+	RaftTLSCA   = "RAFT_TLS_CA"   // RAFT_TLS_CA=/home/tls/ca.crt 签发节点证书的CA证书
+	RaftTLSCert = "RAFT_TLS_CERT" // RAFT_TLS_CERT=/home/tls/server.crt 节点证书，同时作为服务端及客户端证书，SAN须包含节点地址的主机名或IP
+	RaftTLSKey  = "RAFT_TLS_KEY"  // RAFT_TLS_KEY=/home/tls/server.key 节点证书私钥
+)
+
+var (
+	tlsOnce   sync.Once
+	tlsConfig *tls.Config
+	tlsErr    error
+)
+
+// loadTLS 加载节点间通信mTLS配置，未配置时返回nil
+func loadTLS() (*tls.Config, error) {
+	tlsOnce.Do(func() {
+		caPath, certPath, keyPath := gnomon.Env().Get(RaftTLSCA), gnomon.Env().Get(RaftTLSCert), gnomon.Env().Get(RaftTLSKey)
+		if caPath == "" && certPath == "" && keyPath == "" {
+			return
+		}
+		if caPath == "" || certPath == "" || keyPath == "" {
+			tlsErr = fmt.Errorf("%s, %s and %s must be configured together", RaftTLSCA, RaftTLSCert, RaftTLSKey)
+			return
+		}
+		caData, err := ioutil.ReadFile(caPath)
+		if nil != err {
+			tlsErr = err
+			return
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caData) {
+			tlsErr = fmt.Errorf("ca cert %s is invalid", caPath)
+			return
+		}
+		cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+		if nil != err {
+			tlsErr = err
+			return
+		}
+		tlsConfig = &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			RootCAs:      pool,
+			ClientCAs:    pool,
+			// 外部客户端无需证书，节点间请求由服务端校验证书
+			ClientAuth: tls.VerifyClientCertIfGiven,
+			MinVersion: tls.VersionTLS12,
+		}
+		gnomon.Log().Info("rpc", gnomon.Log().Field("tls", "mutual tls enabled"), gnomon.Log().Field("cert", certPath))
+	})
+	return tlsConfig, tlsErr
+}
+
+// TLSEnabled 节点间通信是否启用mTLS
+func TLSEnabled() bool {
+	conf, err := loadTLS()
+	return nil != conf || nil != err
+}
+
+// ServerOptions grpc服务端选项，启用mTLS时服务端使用节点证书并校验客户端提供的证书
+func ServerOptions() ([]grpc.ServerOption, error) {
+	conf, err := loadTLS()
+	if nil != err {
+		return nil, err
+	}
+	if nil == conf {
+		return nil, nil
+	}
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(conf))}, nil
+}
+
+// dialOption grpc客户端选项，启用mTLS时校验服务端证书并提供节点证书
+func dialOption() (grpc.DialOption, error) {
+	conf, err := loadTLS()
+	if nil != err {
+		return nil, err
+	}
+	if nil == conf {
+		return grpc.WithInsecure(), nil
+	}
+	return grpc.WithTransportCredentials(credentials.NewTLS(conf)), nil
+}
+
+// PeerCertificate 获取请求方提供并已通过CA校验的证书
+func PeerCertificate(ctx context.Context) (*x509.Certificate, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, errors.New("peer is unknown")
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return nil, errors.New("peer is not using tls")
+	}
+	if len(info.State.VerifiedChains) == 0 || len(info.State.VerifiedChains[0]) == 0 {
+		return nil, errors.New("peer certificate is not provided")
+	}
+	return info.State.VerifiedChains[0][0], nil
+}
@@ -99,3 +99,79 @@ func (c *ChannelServer) List(ctx context.Context, in *pb.ChannelList) (*pb.Resul
 	}
 	return &pb.ResultArr{Code: pb.Code_Success, Data: data}, nil
 }
+
+func (c *ChannelServer) Capabilities(ctx context.Context, in *pb.ChannelCapabilities) (*pb.ResultCapabilities, error) {
+	var (
+		res  *sdk.Result
+		conf *config.Config
+	)
+	if conf = service.Configs[in.ConfigID]; nil == conf {
+		return &pb.ResultCapabilities{Code: pb.Code_Fail, ErrMsg: "config client is not exist"}, nil
+	}
+	if res = sdk.Capabilities(in.ChannelID, in.OrgName, in.OrgUser, in.PeerName, service.GetBytes(in.ConfigID)); res.ResultCode == sdk.Success {
+		return &pb.ResultCapabilities{Code: pb.Code_Success, Capabilities: res.Data.(*pb.Capabilities)}, nil
+	}
+	return &pb.ResultCapabilities{Code: pb.Code_Fail, ErrMsg: res.Msg}, nil
+}
+
+func (c *ChannelServer) UpgradeCapability(ctx context.Context, in *pb.ChannelCapabilityUpgrade) (*pb.Result, error) {
+	var (
+		conf        *config.Config
+		orderOrgURL string
+		txID        string
+		err         error
+	)
+	if conf = service.Configs[in.ConfigID]; nil == conf {
+		return &pb.Result{Code: pb.Code_Fail, ErrMsg: "config client is not exist"}, nil
+	}
+	for _, order := range conf.Orderers {
+		orderOrgURL = order.URL
+	}
+	if txID, err = sdk.UpgradeCapability(orderOrgURL, in.OrgName, in.OrgUser, in.ChannelID, in.PeerName, in.Group,
+		in.Capability, in.PeerVersion, in.OrdererVersion, configSigners(in.Signers), service.GetBytes(in.ConfigID)); nil != err {
+		return &pb.Result{Code: pb.Code_Fail, ErrMsg: err.Error()}, nil
+	}
+	return &pb.Result{Code: pb.Code_Success, Data: txID}, nil
+}
+
+func (c *ChannelServer) ACLs(ctx context.Context, in *pb.ChannelACLs) (*pb.ResultACLs, error) {
+	var (
+		res  *sdk.Result
+		conf *config.Config
+	)
+	if conf = service.Configs[in.ConfigID]; nil == conf {
+		return &pb.ResultACLs{Code: pb.Code_Fail, ErrMsg: "config client is not exist"}, nil
+	}
+	if res = sdk.ACLs(in.ChannelID, in.OrgName, in.OrgUser, in.PeerName, service.GetBytes(in.ConfigID)); res.ResultCode == sdk.Success {
+		return &pb.ResultACLs{Code: pb.Code_Success, Acls: res.Data.(map[string]string)}, nil
+	}
+	return &pb.ResultACLs{Code: pb.Code_Fail, ErrMsg: res.Msg}, nil
+}
+
+func (c *ChannelServer) UpdateACLs(ctx context.Context, in *pb.ChannelACLUpdate) (*pb.Result, error) {
+	var (
+		conf        *config.Config
+		orderOrgURL string
+		txID        string
+		err         error
+	)
+	if conf = service.Configs[in.ConfigID]; nil == conf {
+		return &pb.Result{Code: pb.Code_Fail, ErrMsg: "config client is not exist"}, nil
+	}
+	for _, order := range conf.Orderers {
+		orderOrgURL = order.URL
+	}
+	if txID, err = sdk.UpdateACLs(orderOrgURL, in.OrgName, in.OrgUser, in.ChannelID, in.PeerName, in.Acls,
+		configSigners(in.Signers), service.GetBytes(in.ConfigID)); nil != err {
+		return &pb.Result{Code: pb.Code_Fail, ErrMsg: err.Error()}, nil
+	}
+	return &pb.Result{Code: pb.Code_Success, Data: txID}, nil
+}
+
+func configSigners(signers []*pb.ChannelSigner) []*sdk.ConfigSigner {
+	configSigners := make([]*sdk.ConfigSigner, len(signers))
+	for index, signer := range signers {
+		configSigners[index] = &sdk.ConfigSigner{OrgName: signer.OrgName, OrgUser: signer.OrgUser}
+	}
+	return configSigners
+}
@@ -22,23 +22,31 @@ import (
 	"github.com/aberic/fabric-client/service"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
-	"time"
 )
 
 type ConfigServer struct {
 }
 
+// ListConfig 获取区块链配置信息ID集合，stale为false时线性一致读
 func (c *ConfigServer) ListConfig(ctx context.Context, in *pb.ReqConfigList) (*pb.ResultConfigList, error) {
+	if !in.Stale {
+		if err := rafts.ReadIndex(); nil != err {
+			return &pb.ResultConfigList{Code: pb.Code_Fail, ErrMsg: err.Error()}, err
+		}
+	}
 	configList := &pb.ResultConfigList{}
 	configList.Code = pb.Code_Success
-	configList.ConfigIDs = []string{}
-	for id := range service.Configs {
-		configList.ConfigIDs = append(configList.ConfigIDs, id)
-	}
+	configList.ConfigIDs = service.ConfigIDs()
 	return configList, nil
 }
 
+// GetConfig 获取区块链配置信息，stale为false时线性一致读
 func (c *ConfigServer) GetConfig(ctx context.Context, in *pb.ReqConfig) (*pb.ResultConfig, error) {
+	if !in.Stale {
+		if err := rafts.ReadIndex(); nil != err {
+			return &pb.ResultConfig{Code: pb.Code_Fail, ErrMsg: err.Error()}, err
+		}
+	}
 	config := service.Get(in.ConfigID)
 	if nil == config {
 		errStr := "config is nil"
@@ -53,30 +61,62 @@ func (c *ConfigServer) GetConfig(ctx context.Context, in *pb.ReqConfig) (*pb.Res
 	}
 }
 
+// RecoverConfig 仅保留指定的区块链配置信息，由Leader节点通过日志提交，Follower节点收到的请求由Forward转发
 func (c *ConfigServer) RecoverConfig(ctx context.Context, in *pb.ReqConfigRecover) (*pb.Result, error) {
-	service.Recover(in.ConfigIDs)
+	if err := rafts.ProposeRecover(in.ConfigIDs); nil != err {
+		return &pb.Result{Code: pb.Code_Fail, ErrMsg: err.Error()}, err
+	}
+	return &pb.Result{Code: pb.Code_Success}, nil
+}
+
+// DeleteConfig 删除区块链配置信息，由Leader节点通过日志提交，Follower节点收到的请求由Forward转发
+func (c *ConfigServer) DeleteConfig(ctx context.Context, in *pb.ReqConfig) (*pb.Result, error) {
+	if err := rafts.ProposeDelete(in.ConfigID); nil != err {
+		return &pb.Result{Code: pb.Code_Fail, ErrMsg: err.Error()}, err
+	}
 	return &pb.Result{Code: pb.Code_Success}, nil
 }
 
+// InitConfig 初始化区块链配置信息，由Leader节点通过日志提交，Follower节点收到的请求由Forward转发
 func (c *ConfigServer) InitConfig(ctx context.Context, in *pb.ReqInit) (*pb.Result, error) {
-	if i, err := c.proxy(
-		true,
-		func() (i interface{}, e error) {
-			service.InitConfig(in)
-			return &pb.Result{Code: pb.Code_Success, Data: "success"}, nil
-		},
-		func() (i interface{}, e error) {
-			if pbStr, err := InitConfig(rafts.LeaderURL(), in); nil != err {
-				return &pb.Result{Code: pb.Code_Fail, ErrMsg: err.Error()}, err
-			} else {
-				return pbStr.(*pb.Result), nil
-			}
-		},
-	); nil != err {
-		return nil, err
-	} else {
-		return i.(*pb.Result), nil
+	if err := rafts.ProposeConfig(in.Client.ConfigID, service.NewConfig(in)); nil != err {
+		return &pb.Result{Code: pb.Code_Fail, ErrMsg: err.Error()}, err
+	}
+	return &pb.Result{Code: pb.Code_Success, Data: "success"}, nil
+}
+
+// RaftStatus 获取自身节点的raft集群状态，自身为Leader时包含各Follower节点的可达状态
+func (c *ConfigServer) RaftStatus(ctx context.Context, in *pb.ReqRaftStatus) (*pb.ResultRaftStatus, error) {
+	status, err := rafts.Status()
+	if nil != err {
+		return &pb.ResultRaftStatus{Code: pb.Code_Fail, ErrMsg: err.Error()}, err
 	}
+	result := &pb.ResultRaftStatus{
+		Code:          pb.Code_Success,
+		Id:            status.Id,
+		Url:           status.Url,
+		Role:          status.Role,
+		Term:          status.Term,
+		LeaderId:      status.LeaderId,
+		LeaderUrl:     status.LeaderUrl,
+		Version:       status.Version,
+		CommitIndex:   status.CommitIndex,
+		LastLogIndex:  status.LastLogIndex,
+		LastHeartbeat: status.LastHeartbeat,
+		Member:        status.Member,
+	}
+	for _, peer := range status.Peers {
+		result.Peers = append(result.Peers, &pb.RaftPeerStatus{
+			Id:         peer.Id,
+			Url:        peer.Url,
+			Reachable:  peer.Reachable,
+			LastAck:    peer.LastAck,
+			MatchIndex: peer.MatchIndex,
+			NextIndex:  peer.NextIndex,
+			ErrMsg:     peer.ErrMsg,
+		})
+	}
+	return result, nil
 }
 
 // ListConfig 获取区块链配置信息ID集合
@@ -113,6 +153,40 @@ func GetConfig(url string, req *pb.ReqConfig) (interface{}, error) {
 	})
 }
 
+// RecoverConfig 仅保留指定的区块链配置信息
+func RecoverConfig(url string, req *pb.ReqConfigRecover) (interface{}, error) {
+	return utils.RPC(url, func(conn *grpc.ClientConn) (interface{}, error) {
+		var (
+			result *pb.Result
+			err    error
+		)
+		// 创建grpc客户端
+		c := pb.NewLedgerConfigClient(conn)
+		// 客户端向grpc服务端发起请求
+		if result, err = c.RecoverConfig(context.Background(), req); nil != err {
+			return nil, err
+		}
+		return result, nil
+	})
+}
+
+// DeleteConfig 删除区块链配置信息
+func DeleteConfig(url string, req *pb.ReqConfig) (interface{}, error) {
+	return utils.RPC(url, func(conn *grpc.ClientConn) (interface{}, error) {
+		var (
+			result *pb.Result
+			err    error
+		)
+		// 创建grpc客户端
+		c := pb.NewLedgerConfigClient(conn)
+		// 客户端向grpc服务端发起请求
+		if result, err = c.DeleteConfig(context.Background(), req); nil != err {
+			return nil, err
+		}
+		return result, nil
+	})
+}
+
 // InitConfig 初始化区块链配置信息
 func InitConfig(url string, req *pb.ReqInit) (interface{}, error) {
 	return utils.RPC(url, func(conn *grpc.ClientConn) (interface{}, error) {
@@ -130,23 +204,19 @@ func InitConfig(url string, req *pb.ReqInit) (interface{}, error) {
 	})
 }
 
-func (c *ConfigServer) proxy(sleep bool, exec func() (interface{}, error), trans func() (interface{}, error)) (interface{}, error) {
-	switch rafts.Character() {
-	case rafts.RoleLeader: // 自身即为 Leader 节点
-		i, err := exec()
-		if nil == err {
-			rafts.VersionAdd()
-		}
-		return i, err
-	case rafts.RoleCandidate: // 等待选举结果，如果超时则返回
-		if sleep {
-			time.Sleep(1000 * time.Millisecond)
-			return c.proxy(false, exec, trans)
-		} else {
-			return nil, errors.New("leader is nil")
+// RaftStatus 获取节点的raft集群状态
+func RaftStatus(url string, req *pb.ReqRaftStatus) (interface{}, error) {
+	return utils.RPC(url, func(conn *grpc.ClientConn) (interface{}, error) {
+		var (
+			result *pb.ResultRaftStatus
+			err    error
+		)
+		// 创建grpc客户端
+		c := pb.NewLedgerConfigClient(conn)
+		// 客户端向grpc服务端发起请求
+		if result, err = c.RaftStatus(context.Background(), req); nil != err {
+			return nil, err
 		}
-	case rafts.RoleFollower: // 将该请求转发给Leader节点处理
-		return trans()
-	}
-	return nil, errors.New("unknown err")
+		return result, nil
+	})
 }
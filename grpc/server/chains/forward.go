@@ -0,0 +1,248 @@
+/*
+ * Copyright (c) 2019. Aberic - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package chains
+
+import (
+	"github.com/aberic/fabric-client/geneses"
+	pb "github.com/aberic/fabric-client/grpc/proto/chain"
+	gr "github.com/aberic/fabric-client/grpc/proto/generate"
+	"github.com/aberic/fabric-client/grpc/proto/utils"
+	"github.com/aberic/fabric-client/rafts"
+	"github.com/aberic/gnomon"
+	"github.com/golang/protobuf/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"io"
+	"time"
+)
+
+const (
+	// forwardedKey 标记由其他节点转发的请求
+	forwardedKey = "raft-forwarded"
+	// forwardTimeout 等待Leader处理变更类请求的超时时间
+	forwardTimeout = 10 * time.Second
+	// forwardRetryInterval 暂无Leader或Leader变更时的重试间隔
+	forwardRetryInterval = 200 * time.Millisecond
+)
+
+// forwardReplies 须由Leader节点处理的变更类请求及其响应类型
+var forwardReplies = map[string]func() interface{}{
+	"/chain.LedgerConfig/InitConfig":    func() interface{} { return &pb.Result{} },
+	"/chain.LedgerConfig/RecoverConfig": func() interface{} { return &pb.Result{} },
+	"/chain.LedgerConfig/DeleteConfig":  func() interface{} { return &pb.Result{} },
+}
+
+// forwardStreams 须由Leader节点处理的客户端流式变更类请求及其请求、响应类型和请求总大小上限
+var forwardStreams = map[string]struct {
+	newReq, newReply func() interface{}
+	maxSize          int
+}{
+	"/generate.Generate/ImportCrypto": {
+		newReq:   func() interface{} { return &gr.ReqImportCrypto{} },
+		newReply: func() interface{} { return &gr.RespImportCrypto{} },
+		maxSize:  geneses.ImportArchiveMaxSize,
+	},
+}
+
+// Forward 变更类请求拦截器，Leader节点直接处理，Follower节点转发至Leader节点
+//
+// 暂无Leader或Leader变更时等待并重试，超过forwardTimeout或请求的截止时间后返回rafts.ErrNoLeader
+func Forward(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	newReply, ok := forwardReplies[info.FullMethod]
+	if !ok {
+		return handler(ctx, req)
+	}
+	// 已由其他节点转发的请求不再转发，避免Leader信息过期时循环转发
+	if forwarded(ctx) {
+		if rafts.Character() != rafts.RoleLeader {
+			return nil, rafts.ErrNotLeader
+		}
+		return handler(ctx, req)
+	}
+	return forwardLoop(ctx, info.FullMethod, func() (interface{}, bool, error) {
+		resp, err := handler(ctx, req)
+		return resp, rafts.LeaderChanged(err), err
+	}, func(url string, deadline time.Time) (interface{}, error) {
+		return forward(url, deadline, info.FullMethod, req, newReply())
+	})
+}
+
+// ForwardStream 客户端流式变更类请求拦截器，接收完整请求后由Leader节点处理，Follower节点转发至Leader节点
+//
+// Leader节点处理请求时可能已写入本地文件，提交期间失去Leader身份时不再重试，直接返回错误
+func ForwardStream(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	types, ok := forwardStreams[info.FullMethod]
+	if !ok {
+		return handler(srv, ss)
+	}
+	var (
+		reqs []interface{}
+		size int
+	)
+	for {
+		req := types.newReq()
+		if err := ss.RecvMsg(req); err == io.EOF {
+			break
+		} else if nil != err {
+			return err
+		}
+		// 接收完整请求前限制缓存的请求大小
+		if size += proto.Size(req.(proto.Message)); size > types.maxSize {
+			return status.Errorf(codes.ResourceExhausted, "request of %s exceeds %d bytes", info.FullMethod, types.maxSize)
+		}
+		reqs = append(reqs, req)
+	}
+	lead := func() (interface{}, bool, error) {
+		rs := &replayStream{ServerStream: ss, reqs: reqs}
+		err := handler(srv, rs)
+		return rs.reply, false, err
+	}
+	var (
+		reply interface{}
+		err   error
+	)
+	// 已由其他节点转发的请求不再转发，避免Leader信息过期时循环转发
+	if forwarded(ss.Context()) {
+		if rafts.Character() != rafts.RoleLeader {
+			return rafts.ErrNotLeader
+		}
+		reply, _, err = lead()
+	} else {
+		reply, err = forwardLoop(ss.Context(), info.FullMethod, lead, func(url string, deadline time.Time) (interface{}, error) {
+			return forwardStream(url, deadline, info.FullMethod, reqs, types.newReply())
+		})
+	}
+	if nil != reply {
+		if sendErr := ss.SendMsg(reply); nil == err {
+			err = sendErr
+		}
+	}
+	return err
+}
+
+// forwardLoop 自身为Leader节点时由lead处理，Follower节点由follow转发至Leader节点
+//
+// 暂无Leader或Leader变更时等待并重试，超过forwardTimeout或请求的截止时间后返回rafts.ErrNoLeader
+func forwardLoop(ctx context.Context, method string, lead func() (resp interface{}, retry bool, err error),
+	follow func(url string, deadline time.Time) (interface{}, error)) (interface{}, error) {
+	deadline := time.Now().Add(forwardTimeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	for {
+		var (
+			resp  interface{}
+			err   error
+			retry bool
+		)
+		switch rafts.Character() {
+		case rafts.RoleLeader: // 自身即为 Leader 节点，提交期间失去Leader身份时由lead决定是否重试
+			resp, retry, err = lead()
+		case rafts.RoleFollower: // 将该请求转发给Leader节点处理，Leader不可达或已不是Leader时重试
+			if url := rafts.LeaderURL(); url != "" {
+				resp, err = follow(url, deadline)
+				retry = rafts.LeaderChanged(err) || status.Code(err) == codes.Unavailable
+			} else {
+				retry = true
+			}
+		default: // 等待选举结果
+			retry = true
+		}
+		if !retry {
+			return resp, err
+		}
+		gnomon.Log().Debug("forward", gnomon.Log().Field("method", method), gnomon.Log().Field("retry", err))
+		if time.Now().Add(forwardRetryInterval).After(deadline) {
+			gnomon.Log().Warn("forward", gnomon.Log().Field("method", method), gnomon.Log().Err(rafts.ErrNoLeader))
+			return nil, rafts.ErrNoLeader
+		}
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(forwardRetryInterval):
+		}
+	}
+}
+
+// forwarded 请求是否由其他节点转发
+func forwarded(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	return ok && len(md.Get(forwardedKey)) > 0
+}
+
+// forward 将请求转发至Leader节点
+func forward(url string, deadline time.Time, method string, req, reply interface{}) (interface{}, error) {
+	return utils.RPC(url, func(conn *grpc.ClientConn) (interface{}, error) {
+		ctx, cancel := context.WithDeadline(metadata.AppendToOutgoingContext(context.Background(), forwardedKey, "true"), deadline)
+		defer cancel()
+		if err := conn.Invoke(ctx, method, req, reply); nil != err {
+			return nil, err
+		}
+		return reply, nil
+	})
+}
+
+// forwardStream 将客户端流式请求转发至Leader节点
+func forwardStream(url string, deadline time.Time, method string, reqs []interface{}, reply interface{}) (interface{}, error) {
+	return utils.RPC(url, func(conn *grpc.ClientConn) (interface{}, error) {
+		ctx, cancel := context.WithDeadline(metadata.AppendToOutgoingContext(context.Background(), forwardedKey, "true"), deadline)
+		defer cancel()
+		stream, err := conn.NewStream(ctx, &grpc.StreamDesc{ClientStreams: true}, method)
+		if nil != err {
+			return nil, err
+		}
+		for _, req := range reqs {
+			// 发送失败时流已结束，由RecvMsg返回实际错误
+			if err = stream.SendMsg(req); nil != err {
+				break
+			}
+		}
+		if nil == err {
+			if err = stream.CloseSend(); nil != err {
+				return nil, err
+			}
+		}
+		if err = stream.RecvMsg(reply); nil != err {
+			return nil, err
+		}
+		return reply, nil
+	})
+}
+
+// replayStream 向处理方法重放已接收的请求，并暂存处理方法的响应
+type replayStream struct {
+	grpc.ServerStream
+	reqs  []interface{}
+	reply interface{}
+}
+
+func (rs *replayStream) RecvMsg(m interface{}) error {
+	if len(rs.reqs) == 0 {
+		return io.EOF
+	}
+	msg := m.(proto.Message)
+	msg.Reset()
+	proto.Merge(msg, rs.reqs[0].(proto.Message))
+	rs.reqs = rs.reqs[1:]
+	return nil
+}
+
+func (rs *replayStream) SendMsg(m interface{}) error {
+	rs.reply = m
+	return nil
+}
@@ -15,12 +15,24 @@
 package generate
 
 import (
+	"bufio"
 	"context"
 	"errors"
+	"github.com/aberic/fabric-client/config"
+	"github.com/aberic/fabric-client/core"
 	"github.com/aberic/fabric-client/geneses"
 	"github.com/aberic/fabric-client/grpc/proto/generate"
+	"github.com/aberic/fabric-client/rafts"
+	"github.com/aberic/fabric-client/service"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+	"io"
 )
 
+// artifactsChunkSize 下载归档时单条消息的数据大小
+const artifactsChunkSize = 64 * 1024
+
 type CreationServer struct{}
 
 func (cs *CreationServer) GenerateCrypto(ctx context.Context, in *generate.ReqKeyConfig) (*generate.RespKeyConfig, error) {
@@ -72,10 +84,68 @@ func (cs *CreationServer) GenerateOrgUser(ctx context.Context, in *generate.ReqC
 	return &generate.RespCreateOrgUser{Code: generate.Code_Success}, nil
 }
 
+func (cs *CreationServer) RevokeCert(ctx context.Context, in *generate.ReqRevokeCert) (*generate.RespRevokeCert, error) {
+	gc := &geneses.GenerateConfig{}
+	crlPem, err := gc.RevokeCert(in)
+	if nil != err {
+		return &generate.RespRevokeCert{Code: generate.Code_Fail, ErrMsg: err.Error()}, err
+	}
+	if nil == in.ChannelUpdate {
+		return &generate.RespRevokeCert{Code: generate.Code_Success, CrlPem: crlPem}, nil
+	}
+	var (
+		conf        *config.Config
+		orderOrgURL string
+		txID        string
+		cu          = in.ChannelUpdate
+	)
+	if conf = service.Get(cu.ConfigID); nil == conf {
+		err = errors.New("config client is not exist")
+		return &generate.RespRevokeCert{Code: generate.Code_Fail, ErrMsg: err.Error(), CrlPem: crlPem}, err
+	}
+	for _, order := range conf.Orderers {
+		orderOrgURL = order.URL
+	}
+	signers := make([]*sdk.ConfigSigner, len(cu.Signers))
+	for index, signer := range cu.Signers {
+		signers[index] = &sdk.ConfigSigner{OrgName: signer.OrgName, OrgUser: signer.OrgUser}
+	}
+	if txID, err = sdk.AddCRL(orderOrgURL, cu.OrgName, cu.OrgUser, cu.ChannelID, cu.PeerName, in.OrgName, crlPem, signers,
+		service.GetBytes(cu.ConfigID)); nil != err {
+		return &generate.RespRevokeCert{Code: generate.Code_Fail, ErrMsg: err.Error(), CrlPem: crlPem}, err
+	}
+	return &generate.RespRevokeCert{Code: generate.Code_Success, CrlPem: crlPem, TxID: txID}, nil
+}
+
+func (cs *CreationServer) VerifyMSP(ctx context.Context, in *generate.ReqVerifyMSP) (*generate.RespVerifyMSP, error) {
+	gc := &geneses.GenerateConfig{}
+	problems, err := gc.VerifyMSP(in)
+	if nil != err {
+		return &generate.RespVerifyMSP{Code: generate.Code_Fail, ErrMsg: err.Error()}, err
+	}
+	valid := true
+	for _, problem := range problems {
+		if !problem.Warning {
+			valid = false
+		}
+	}
+	return &generate.RespVerifyMSP{Code: generate.Code_Success, Valid: valid, Problems: problems}, nil
+}
+
+func (cs *CreationServer) ListCertExpiry(ctx context.Context, in *generate.ReqListCertExpiry) (*generate.RespListCertExpiry, error) {
+	certs, err := service.CertExpiries(in.LeagueDomain, in.WithinDays)
+	if nil != err {
+		return &generate.RespListCertExpiry{Code: generate.Code_Fail, ErrMsg: err.Error()}, err
+	}
+	return &generate.RespListCertExpiry{Code: generate.Code_Success, Certs: certs}, nil
+}
+
 func (cs *CreationServer) GenerateGenesisBlock(ctx context.Context, in *generate.ReqGenesis) (*generate.RespGenesis, error) {
 	genesis := geneses.Genesis{Info: in}
-	genesis.Init()
-	if err := genesis.CreateGenesisBlock("default"); nil != err {
+	if err := genesis.Init(); nil != err {
+		return &generate.RespGenesis{Code: generate.Code_Fail, ErrMsg: err.Error()}, err
+	}
+	if err := genesis.CreateGenesisBlock(geneses.DefaultConsortium); nil != err {
 		return &generate.RespGenesis{Code: generate.Code_Fail, ErrMsg: err.Error()}, err
 	}
 	return &generate.RespGenesis{Code: generate.Code_Success}, nil
@@ -83,9 +153,125 @@ func (cs *CreationServer) GenerateGenesisBlock(ctx context.Context, in *generate
 
 func (cs *CreationServer) GenerateChannelTx(ctx context.Context, in *generate.ReqChannelTx) (*generate.RespChannelTx, error) {
 	genesis := geneses.Genesis{Info: in.Genesis}
-	genesis.Init()
-	if err := genesis.CreateChannelCreateTx("default", in.ChannelID); nil != err {
+	if err := genesis.Init(); nil != err {
+		return &generate.RespChannelTx{Code: generate.Code_Fail, ErrMsg: err.Error()}, err
+	}
+	consortium := in.Consortium
+	if consortium == "" {
+		consortium = geneses.DefaultConsortium
+	}
+	if err := genesis.CreateChannelCreateTx(consortium, in.ChannelID, in.Orgs...); nil != err {
 		return &generate.RespChannelTx{Code: generate.Code_Fail, ErrMsg: err.Error()}, err
 	}
 	return &generate.RespChannelTx{Code: generate.Code_Success}, nil
 }
+
+func (cs *CreationServer) PrintOrg(ctx context.Context, in *generate.ReqPrintOrg) (*generate.RespPrintOrg, error) {
+	data, json, err := geneses.PrintOrg(in.LeagueDomain, in.Org)
+	if nil != err {
+		return &generate.RespPrintOrg{Code: generate.Code_Fail, ErrMsg: err.Error()}, err
+	}
+	return &generate.RespPrintOrg{Code: generate.Code_Success, ConfigGroup: data, Json: json}, nil
+}
+
+func (cs *CreationServer) InspectBlock(ctx context.Context, in *generate.ReqInspectBlock) (*generate.RespInspect, error) {
+	json, err := geneses.InspectBlock(in.LeagueDomain, in.Data)
+	if nil != err {
+		return &generate.RespInspect{Code: generate.Code_Fail, ErrMsg: err.Error()}, err
+	}
+	return &generate.RespInspect{Code: generate.Code_Success, Json: json}, nil
+}
+
+func (cs *CreationServer) InspectChannelTx(ctx context.Context, in *generate.ReqInspectChannelTx) (*generate.RespInspect, error) {
+	json, err := geneses.InspectChannelTx(in.LeagueDomain, in.ChannelID, in.Data)
+	if nil != err {
+		return &generate.RespInspect{Code: generate.Code_Fail, ErrMsg: err.Error()}, err
+	}
+	return &generate.RespInspect{Code: generate.Code_Success, Json: json}, nil
+}
+
+func (cs *CreationServer) ImportCrypto(stream generate.Generate_ImportCryptoServer) error {
+	var (
+		in   = &generate.ReqImportCrypto{}
+		data []byte
+	)
+	for {
+		recv, err := stream.Recv()
+		if err == io.EOF {
+			break
+		}
+		if nil != err {
+			return err
+		}
+		if len(data)+len(recv.Data) > geneses.ImportArchiveMaxSize {
+			return status.Errorf(codes.ResourceExhausted, "crypto-config archive exceeds %d bytes", geneses.ImportArchiveMaxSize)
+		}
+		data = append(data, recv.Data...)
+		if recv.LeagueDomain != "" {
+			in.LeagueDomain, in.Orgs, in.Connection = recv.LeagueDomain, recv.Orgs, recv.Connection
+		}
+	}
+	resp, err := cs.importCrypto(in, data)
+	if nil != err {
+		_ = stream.SendAndClose(&generate.RespImportCrypto{Code: generate.Code_Fail, ErrMsg: err.Error(), Orgs: resp.Orgs})
+		return err
+	}
+	return stream.SendAndClose(resp)
+}
+
+// importCrypto 导入crypto-config并按需生成连接配置，设置configID时注册至服务配置
+func (cs *CreationServer) importCrypto(in *generate.ReqImportCrypto, data []byte) (*generate.RespImportCrypto, error) {
+	orgs, err := geneses.ImportCryptoConfig(in.LeagueDomain, data, in.Orgs)
+	if nil != err {
+		return &generate.RespImportCrypto{}, err
+	}
+	resp := &generate.RespImportCrypto{Code: generate.Code_Success, Orgs: orgs}
+	if nil == in.Connection {
+		return resp, nil
+	}
+	conf := &config.Config{}
+	if err = conf.InitImportConfig(in.LeagueDomain, orgs, in.Connection); nil != err {
+		return resp, err
+	}
+	if resp.Config, err = yaml.Marshal(conf); nil != err {
+		return resp, err
+	}
+	// 请求已由ForwardStream转发至Leader节点，配置经日志提交后注册
+	if in.Connection.ConfigID != "" {
+		err = rafts.ProposeConfig(in.Connection.ConfigID, conf)
+	}
+	return resp, err
+}
+
+func (cs *CreationServer) DownloadArtifacts(in *generate.ReqDownloadArtifacts, stream generate.Generate_DownloadArtifactsServer) error {
+	writer := bufio.NewWriterSize(&artifactsWriter{stream: stream}, artifactsChunkSize)
+	err := geneses.ArchiveArtifacts(in, writer)
+	if nil == err {
+		err = writer.Flush()
+	}
+	if nil != err {
+		_ = stream.Send(&generate.RespDownloadArtifacts{Code: generate.Code_Fail, ErrMsg: err.Error()})
+		return err
+	}
+	return nil
+}
+
+// artifactsWriter 将归档数据按artifactsChunkSize分片发送
+type artifactsWriter struct {
+	stream generate.Generate_DownloadArtifactsServer
+}
+
+func (aw *artifactsWriter) Write(p []byte) (int, error) {
+	for offset := 0; offset < len(p); offset += artifactsChunkSize {
+		end := offset + artifactsChunkSize
+		if end > len(p) {
+			end = len(p)
+		}
+		data := make([]byte, end-offset)
+		copy(data, p[offset:end])
+		if err := aw.stream.Send(&generate.RespDownloadArtifacts{Code: generate.Code_Success, Data: data}); nil != err {
+			return offset, err
+		}
+	}
+	return len(p), nil
+}
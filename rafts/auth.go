@@ -0,0 +1,85 @@
+/*
+ * Copyright (c) 2019. Aberic - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rafts
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"github.com/aberic/fabric-client/grpc/proto/utils"
+	"net"
+)
+
+// authenticate 启用mTLS时拒绝未提供CA签发证书的raft请求，并要求证书属于集群成员nodeID
+func (r *Raft) authenticate(ctx context.Context, nodeID string) error {
+	if !utils.TLSEnabled() {
+		return nil
+	}
+	cert, err := utils.PeerCertificate(ctx)
+	if nil != err {
+		return fmt.Errorf("unauthenticated raft request: %s", err.Error())
+	}
+	return r.verifyIdentity(cert, nodeID)
+}
+
+// verifyIdentity 证书SAN须包含集群成员nodeID地址中的主机名或IP
+func (r *Raft) verifyIdentity(cert *x509.Certificate, nodeID string) error {
+	for _, node := range r.members() {
+		if node.Id != nodeID {
+			continue
+		}
+		if err := verifyNodeHost(cert, node); nil != err {
+			return fmt.Errorf("certificate is not issued to raft node %s: %s", nodeID, err.Error())
+		}
+		return nil
+	}
+	return fmt.Errorf("raft node %s is not a member", nodeID)
+}
+
+// authorize 启用mTLS时仅允许集群成员或配置的管理员变更集群成员及查询状态
+func (r *Raft) authorize(ctx context.Context) error {
+	if !utils.TLSEnabled() {
+		return nil
+	}
+	cert, err := utils.PeerCertificate(ctx)
+	if nil != err {
+		return fmt.Errorf("unauthenticated raft request: %s", err.Error())
+	}
+	return r.verifyAuthority(cert)
+}
+
+// verifyAuthority 证书CommonName须为配置的管理员，或证书SAN包含任一集群成员地址中的主机名或IP
+func (r *Raft) verifyAuthority(cert *x509.Certificate) error {
+	for _, admin := range r.admins {
+		if cert.Subject.CommonName == admin {
+			return nil
+		}
+	}
+	for _, node := range r.members() {
+		if nil == verifyNodeHost(cert, node) {
+			return nil
+		}
+	}
+	return fmt.Errorf("certificate %s is neither a raft member nor an admin", cert.Subject.CommonName)
+}
+
+// verifyNodeHost 校验证书SAN是否包含节点地址中的主机名或IP
+func verifyNodeHost(cert *x509.Certificate, node *Node) error {
+	host := node.Url
+	if h, _, err := net.SplitHostPort(node.Url); nil == err {
+		host = h
+	}
+	return cert.VerifyHostname(host)
+}
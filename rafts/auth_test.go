@@ -0,0 +1,95 @@
+/*
+ * Copyright (c) 2019. Aberic - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rafts
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestVerifyIdentity(t *testing.T) {
+	cert := testCert("raft2", []string{"raft2.example.com"}, []net.IP{net.ParseIP("127.0.0.1")}, t)
+	r := &Raft{
+		self:   &Node{Id: "1", Url: "127.0.0.1:19877"},
+		member: true,
+		nodes:  []*Node{{Id: "2", Url: "raft2.example.com:19877"}, {Id: "3", Url: "raft3.example.com:19877"}},
+	}
+	if err := r.verifyIdentity(cert, "2"); nil != err {
+		t.Error(err)
+	}
+	if err := r.verifyIdentity(cert, "1"); nil != err {
+		t.Error(err)
+	}
+	if err := r.verifyIdentity(cert, "3"); nil == err {
+		t.Error("certificate of another node should be refused")
+	}
+	if err := r.verifyIdentity(cert, "4"); nil == err {
+		t.Error("non-member should be refused")
+	}
+}
+
+func TestVerifyAuthority(t *testing.T) {
+	r := &Raft{
+		self:   &Node{Id: "1", Url: "127.0.0.1:19877"},
+		member: true,
+		nodes:  []*Node{{Id: "2", Url: "raft2.example.com:19877"}},
+		admins: []string{"raft-admin"},
+	}
+	if err := r.verifyAuthority(testCert("raft2", []string{"raft2.example.com"}, nil, t)); nil != err {
+		t.Error(err)
+	}
+	if err := r.verifyAuthority(testCert("raft-admin", nil, nil, t)); nil != err {
+		t.Error(err)
+	}
+	if err := r.verifyAuthority(testCert("client", []string{"client.example.com"}, nil, t)); nil == err {
+		t.Error("certificate of neither member nor admin should be refused")
+	}
+	r.member = false
+	if err := r.verifyAuthority(testCert("raft1", nil, []net.IP{net.ParseIP("127.0.0.1")}, t)); nil == err {
+		t.Error("certificate of non-member self should be refused")
+	}
+}
+
+// testCert 生成自签名证书
+func testCert(commonName string, dnsNames []string, ips []net.IP, t *testing.T) *x509.Certificate {
+	priKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if nil != err {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     dnsNames,
+		IPAddresses:  ips,
+	}
+	certData, err := x509.CreateCertificate(rand.Reader, template, template, &priKey.PublicKey, priKey)
+	if nil != err {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(certData)
+	if nil != err {
+		t.Fatal(err)
+	}
+	return cert
+}
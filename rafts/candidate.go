@@ -15,14 +15,8 @@
 package rafts
 
 import (
-	"context"
-	"errors"
-	"github.com/aberic/fabric-client/grpc/proto/utils"
 	"github.com/aberic/gnomon"
 	"github.com/panjf2000/ants"
-	"google.golang.org/grpc"
-	"sync"
-	"time"
 )
 
 // candidate 用于选举Leader的一种角色
@@ -37,18 +31,14 @@ type candidate struct {
 
 // vote 发起投票结果
 type vote struct {
-	// lock 发起投票结果变更锁
-	lock sync.Mutex
-	// term 当前请求投票的任期
-	term int32
-	// voteIDsFrom 当前请求投票任期获得投票的节点id
-	voteIDsFrom []string
+	// rv 当前任期的投票请求，成为Candidate时生成，发送期间不再变更
+	rv *ReqVote
 	// voteChan 投票结果
 	voteChan chan voteChan
 	// voteDead 死亡节点
 	voteDead chan int8
-	// voteEnd 结束投票
-	voteEnd chan error
+	// voteEnd 角色已被其他请求转换，结束投票
+	voteEnd chan struct{}
 }
 
 type voteChan struct {
@@ -56,19 +46,36 @@ type voteChan struct {
 	id string
 	// 投票结果
 	grant bool
+	// 投票节点的任期
+	term int32
 }
 
+// become 增加任期并投票给自身，调用方须持有raft.lock，由调用方在释放锁后调用work发起投票
 func (c *candidate) become(raft *Raft) {
 	gnomon.Log().Info("raft", gnomon.Log().Field("become", "Candidate"))
 	c.raft = raft
 	c.raft.term++
 	c.raft.persistence.votedFor.id = c.raft.self.Id
 	c.raft.persistence.votedFor.term = c.raft.term
-	c.raft.persistence.votedFor.timestamp = time.Now().UnixNano()
-	c.requestVotePool, _ = ants.NewPoolWithFunc(len(c.raft.nodes), func(i interface{}) {
+	c.raft.persistence.votedFor.timestamp = c.raft.clock.now().UnixNano()
+	c.raft.persistence.saveState(c.raft.term)
+	lastLogIndex, lastLogTerm := c.raft.log.lastIndexAndTerm()
+	c.vote = &vote{
+		rv: &ReqVote{
+			Term:         c.raft.term,
+			CandidateId:  c.raft.self.Id,
+			Url:          c.raft.self.Url,
+			LastLeaderId: c.raft.persistence.leaderID,
+			LastLogIndex: lastLogIndex,
+			LastLogTerm:  lastLogTerm,
+			Timestamp:    c.raft.persistence.votedFor.timestamp,
+		},
+		voteEnd: make(chan struct{}),
+	}
+	c.requestVotePool, _ = ants.NewPoolWithFunc(poolSize(c.raft.others()), func(i interface{}) {
+		defer c.raft.tasks.done()
 		c.requestVote(i)
 	})
-	c.work()
 }
 
 func (c *candidate) leader() {
@@ -86,9 +93,7 @@ func (c *candidate) follower() {
 }
 
 func (c *candidate) release() {
-	if nil != c.vote {
-		c.vote.voteEnd <- errors.New("candidate release")
-	}
+	close(c.vote.voteEnd)
 	c.requestVotePool.Release()
 }
 
@@ -96,99 +101,151 @@ func (c *candidate) role() int {
 	return RoleCandidate
 }
 
+// work 向其他成员索要选票，调用方不能持有raft.lock，获得大多数成员选票后成为Leader，否则转换为Follower
 func (c *candidate) work() {
-	c.vote = &vote{
-		term:        c.raft.term,
-		voteIDsFrom: []string{c.raft.self.Id},
-		voteChan:    make(chan voteChan, len(c.raft.nodes)),
-		voteDead:    make(chan int8, len(c.raft.nodes)),
-		voteEnd:     make(chan error),
-	}
-	c.sendRequestVotes()
-	defer c.vote.lock.Unlock()
-	c.vote.lock.Lock()
-	position := 0 // 遍历数
-	dead := 0     // 死亡数
-	for position < len(c.raft.nodes) {
+	nodes := c.raft.others()
+	c.vote.voteChan = make(chan voteChan, len(nodes))
+	c.vote.voteDead = make(chan int8, len(nodes))
+	c.sendRequestVotes(nodes)
+	voteIDsFrom := []string{c.raft.self.Id} // 当前请求投票任期获得投票的节点id
+	var term int32                          // 投票节点中的最高任期
+	for position := 0; position < len(nodes); position++ {
 		select {
 		case voteResult := <-c.vote.voteChan:
 			if voteResult.grant {
-				c.vote.voteIDsFrom = append(c.vote.voteIDsFrom, voteResult.id)
+				voteIDsFrom = append(voteIDsFrom, voteResult.id)
+			}
+			if voteResult.term > term {
+				term = voteResult.term
 			}
-			position++
 		case <-c.vote.voteDead:
-			dead++
-			position++
-		case err := <-c.vote.voteEnd:
-			gnomon.Log().Error("raft", gnomon.Log().Err(err))
-			position = len(c.raft.nodes)
-			c.vote = nil
-			c.follower()
+		case <-c.vote.voteEnd:
+			gnomon.Log().Info("raft", gnomon.Log().Field("candidate", "released"), gnomon.Log().Field("term", c.vote.rv.Term))
 			return
 		}
 	}
-	if position-dead == 0 || 100*len(c.vote.voteIDsFrom)/(position-dead) > 50 {
-		c.vote = nil
+	defer c.raft.lock.Unlock()
+	c.raft.lock.Lock()
+	// 投票期间已收到Leader心跳或更高任期的请求
+	if c.raft.role != c {
+		return
+	}
+	// 发现更高任期时更新任期并转换为Follower
+	if term > c.vote.rv.Term {
+		c.raft.updateTerm(term)
+		return
+	}
+	// 获得包括自身在内的集群成员半数以上选票才能成为Leader，不可达的节点同样计入成员数，避免同一任期出现多个Leader
+	if 2*len(voteIDsFrom) > len(nodes)+1 {
 		c.leader()
 	} else {
-		c.vote = nil
 		c.follower()
 	}
 }
 
 // requestVote 发起选举，索要选票
 func (c *candidate) requestVote(i interface{}) {
-	var (
-		rvr    interface{}
-		result *ReqVoteReturn
-		err    error
-	)
 	node := i.(*Node)
-	rvr, err = utils.RPC(node.Url, func(conn *grpc.ClientConn) (interface{}, error) {
-		// 创建grpc客户端
-		cli := NewRaftClient(conn)
-		//客户端向grpc服务端发起请求
-		if result, err = cli.RequestVote(context.Background(), &ReqVote{
-			Term:         c.raft.term,
-			CandidateId:  c.raft.self.Id,
-			Url:          c.raft.self.Url,
-			LastLeaderId: c.raft.persistence.leaderID,
-			LastTerm:     c.raft.persistence.currentTerm,
-			LastVersion:  c.raft.persistence.version,
-			Timestamp:    c.raft.persistence.votedFor.timestamp,
-		}); nil != err {
-			return nil, err
-		}
-		return result, nil
-	})
-	if nil != err && nil != c.vote.voteDead {
+	reqVoteReturn, err := c.raft.transport.requestVote(node, c.vote.rv)
+	if nil != err {
 		c.vote.voteDead <- 0
 		return
 	}
-	reqVoteReturn := rvr.(*ReqVoteReturn)
-	if reqVoteReturn.VoteGranted && nil != c.vote.voteChan { // 如果投票
+	if reqVoteReturn.VoteGranted { // 如果投票
 		c.vote.voteChan <- voteChan{
 			id:    node.Id,
 			grant: true,
+			term:  reqVoteReturn.Term,
 		}
-	} else if nil != c.vote.voteChan {
+	} else {
 		c.vote.voteChan <- voteChan{
 			grant: false,
+			term:  reqVoteReturn.Term,
 		}
 	}
 }
 
 // sendRequestVotes 批量发起选举，索要选票
-func (c *candidate) sendRequestVotes() {
-	c.requestVotePool.Tune(len(c.raft.nodes))
-	gnomon.Log().Info("raft", gnomon.Log().Field("send requestVotes", c.raft.nodes))
+func (c *candidate) sendRequestVotes(nodes []*Node) {
+	tunePool(c.requestVotePool, nodes)
+	gnomon.Log().Info("raft", gnomon.Log().Field("send requestVotes", nodes))
 	// 遍历发送心跳
-	for _, node := range c.raft.nodes {
-		if node.Id == c.raft.self.Id {
-			continue
-		}
+	for _, node := range nodes {
+		c.raft.tasks.add()
 		if err := c.requestVotePool.Invoke(node); nil != err {
-			return
+			c.raft.tasks.done()
+			c.vote.voteDead <- 0
+		}
+	}
+}
+
+// campaign 选举超时后先以下一任期发起预投票，大多数成员同意后才增加任期成为Candidate，调用方不能持有lock
+//
+// 被隔离的节点预投票无法通过，不会增加任期，恢复通信后不会以更高的任期迫使Leader下台
+func (r *Raft) campaign() {
+	r.lock.Lock()
+	lastLogIndex, lastLogTerm := r.log.lastIndexAndTerm()
+	rv := &ReqVote{
+		Term:         r.term + 1,
+		CandidateId:  r.self.Id,
+		Url:          r.self.Url,
+		LastLeaderId: r.persistence.leaderID,
+		LastLogIndex: lastLogIndex,
+		LastLogTerm:  lastLogTerm,
+		PreVote:      true,
+	}
+	r.lock.Unlock()
+	granted, term := r.preVote(rv)
+	r.lock.Lock()
+	// 预投票期间已收到Leader心跳或更高任期的请求，放弃本轮选举
+	if r.role.role() != RoleFollower || r.term != rv.Term-1 || r.scheduled.leaderAlive() {
+		r.lock.Unlock()
+		return
+	}
+	if !granted {
+		// 成员因任期更高而拒绝时自身更新至该任期
+		r.updateTerm(term)
+		r.scheduled.refreshLastHeartBeatTime()
+		r.lock.Unlock()
+		return
+	}
+	r.role.candidate()
+	c := r.role.(*candidate)
+	r.lock.Unlock()
+	c.work()
+}
+
+// preVote 向其他成员发起预投票，不改变自身及其他成员的任期和投票，调用方不能持有lock
+//
+// 返回包括自身在内的大多数成员是否同意，及拒绝预投票的成员中的最高任期
+func (r *Raft) preVote(rv *ReqVote) (bool, int32) {
+	nodes := r.others()
+	quorum := (len(nodes)+1)/2 + 1
+	results := make(chan *ReqVoteReturn, len(nodes))
+	for _, node := range nodes {
+		node := node
+		r.tasks.spawn(func() {
+			rvr, err := r.transport.requestVote(node, rv)
+			if nil != err {
+				rvr = &ReqVoteReturn{}
+			}
+			results <- rvr
+		})
+	}
+	granted := 1
+	var term int32
+	for range nodes {
+		if granted >= quorum {
+			break
+		}
+		rvr := <-results
+		if rvr.VoteGranted {
+			granted++
+		} else if rvr.Term > term {
+			term = rvr.Term
 		}
 	}
+	gnomon.Log().Info("raft", gnomon.Log().Field("preVote", rv.Term), gnomon.Log().Field("granted", granted),
+		gnomon.Log().Field("quorum", quorum))
+	return granted >= quorum, term
 }
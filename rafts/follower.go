@@ -27,8 +27,6 @@ type follower struct {
 func (f *follower) become(raft *Raft) {
 	gnomon.Log().Info("raft", gnomon.Log().Field("become", "Follower"))
 	f.raft = raft
-	f.raft.persistence.votedFor.id = ""
-	f.raft.persistence.votedFor.term = 0
 	f.raft.scheduled.refreshLastHeartBeatTime()
 }
 
@@ -0,0 +1,522 @@
+/*
+ * Copyright (c) 2019. Aberic - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rafts
+
+import (
+	"errors"
+	"github.com/aberic/fabric-client/config"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// settleTimeout 等待集群内异步任务完成的真实超时时间
+const settleTimeout = 10 * time.Second
+
+var errUnreachable = errors.New("node is unreachable")
+
+// fakeClock 可控时钟，仅在advance时前进
+type fakeClock struct {
+	lock   sync.Mutex
+	time   time.Time
+	timers []*fakeTimer
+}
+
+// fakeTimer 时钟前进至at时触发
+type fakeTimer struct {
+	at time.Time
+	c  chan time.Time
+}
+
+func (c *fakeClock) now() time.Time {
+	defer c.lock.Unlock()
+	c.lock.Lock()
+	return c.time
+}
+
+func (c *fakeClock) after(d time.Duration) <-chan time.Time {
+	defer c.lock.Unlock()
+	c.lock.Lock()
+	timer := &fakeTimer{at: c.time.Add(d), c: make(chan time.Time, 1)}
+	if d <= 0 {
+		timer.c <- c.time
+	} else {
+		c.timers = append(c.timers, timer)
+	}
+	return timer.c
+}
+
+// advance 推进时钟，触发到期的定时器
+func (c *fakeClock) advance(d time.Duration) {
+	defer c.lock.Unlock()
+	c.lock.Lock()
+	c.time = c.time.Add(d)
+	timers := c.timers[:0]
+	for _, timer := range c.timers {
+		if timer.at.After(c.time) {
+			timers = append(timers, timer)
+		} else {
+			timer.c <- c.time
+		}
+	}
+	c.timers = timers
+}
+
+// pending 尚未触发的定时器数
+func (c *fakeClock) pending() int {
+	defer c.lock.Unlock()
+	c.lock.Lock()
+	return len(c.timers)
+}
+
+// memStore 内存配置集合
+type memStore struct {
+	lock sync.Mutex
+	data map[string]*config.Config
+}
+
+func newMemStore() *memStore {
+	return &memStore{data: map[string]*config.Config{}}
+}
+
+func (s *memStore) add(configID string, conf *config.Config) {
+	defer s.lock.Unlock()
+	s.lock.Lock()
+	s.data[configID] = conf
+}
+
+func (s *memStore) retain(configIDs []string) {
+	defer s.lock.Unlock()
+	s.lock.Lock()
+	retained := map[string]*config.Config{}
+	for _, configID := range configIDs {
+		if conf, ok := s.data[configID]; ok {
+			retained[configID] = conf
+		}
+	}
+	s.data = retained
+}
+
+func (s *memStore) delete(configID string) {
+	defer s.lock.Unlock()
+	s.lock.Lock()
+	delete(s.data, configID)
+}
+
+func (s *memStore) reset(configs map[string]*config.Config) {
+	defer s.lock.Unlock()
+	s.lock.Lock()
+	s.data = map[string]*config.Config{}
+	for configID, conf := range configs {
+		s.data[configID] = conf
+	}
+}
+
+func (s *memStore) configs() map[string]config.Config {
+	defer s.lock.Unlock()
+	s.lock.Lock()
+	configs := map[string]config.Config{}
+	for configID, conf := range s.data {
+		configs[configID] = *conf
+	}
+	return configs
+}
+
+// ids 配置ID升序列表
+func (s *memStore) ids() []string {
+	defer s.lock.Unlock()
+	s.lock.Lock()
+	ids := make([]string, 0, len(s.data))
+	for configID := range s.data {
+		ids = append(ids, configID)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// link 节点间的单向链路
+type link struct {
+	from, to string
+}
+
+// delay 链路延迟，match不为nil时仅延迟匹配的请求
+type delay struct {
+	d     time.Duration
+	match func(msg interface{}) bool
+}
+
+// delayed 延迟投递的请求，发送方视为请求失败
+type delayed struct {
+	at      time.Time
+	deliver func()
+}
+
+// network 进程内节点间通信，支持丢弃、延迟、分区及节点停止
+type network struct {
+	lock  sync.Mutex
+	clock *fakeClock
+	tasks *tasks
+	// 运行中的节点，key为节点ID
+	nodes map[string]*Raft
+	// 丢弃的单向链路
+	drops map[link]bool
+	// 单向链路的延迟
+	delays map[link]*delay
+	// 节点所在分区编号，不同分区间的请求及响应均丢弃
+	partitions map[string]int
+	// 待投递的延迟请求
+	pending []*delayed
+}
+
+// reachable 判断from至to的链路是否可达，调用方须持有锁
+func (n *network) reachable(from, to string) bool {
+	if nil == n.nodes[to] || nil == n.nodes[from] || n.drops[link{from, to}] {
+		return false
+	}
+	return n.partitions[from] == n.partitions[to]
+}
+
+// deliver 将请求投递至to节点处理，链路延迟时到期后再处理且发送方视为请求失败，响应链路不可达时同样视为失败
+func (n *network) deliver(from, to string, msg interface{}, handle func(r *Raft) error) error {
+	n.lock.Lock()
+	if !n.reachable(from, to) {
+		n.lock.Unlock()
+		return errUnreachable
+	}
+	target := n.nodes[to]
+	if d := n.delays[link{from, to}]; nil != d && (nil == d.match || d.match(msg)) {
+		n.pending = append(n.pending, &delayed{at: n.clock.now().Add(d.d), deliver: func() { _ = handle(target) }})
+		n.lock.Unlock()
+		return errUnreachable
+	}
+	n.lock.Unlock()
+	if err := handle(target); nil != err {
+		return err
+	}
+	defer n.lock.Unlock()
+	n.lock.Lock()
+	if !n.reachable(to, from) {
+		return errUnreachable
+	}
+	return nil
+}
+
+// flush 异步投递已到期的延迟请求
+func (n *network) flush() {
+	defer n.lock.Unlock()
+	n.lock.Lock()
+	now := n.clock.now()
+	pending := n.pending[:0]
+	for _, d := range n.pending {
+		if d.at.After(now) {
+			pending = append(pending, d)
+			continue
+		}
+		n.tasks.spawn(d.deliver)
+	}
+	n.pending = pending
+}
+
+// memTransport 节点的进程内通信
+type memTransport struct {
+	self    string
+	network *network
+}
+
+func (t *memTransport) heartbeat(node *Node, hBeat *HBeat) (*HBeatReturn, error) {
+	var hbr *HBeatReturn
+	err := t.network.deliver(t.self, node.Id, hBeat, func(r *Raft) (err error) {
+		hbr, err = r.heartbeat(hBeat)
+		return
+	})
+	return hbr, err
+}
+
+func (t *memTransport) requestVote(node *Node, rv *ReqVote) (*ReqVoteReturn, error) {
+	var rvr *ReqVoteReturn
+	err := t.network.deliver(t.self, node.Id, rv, func(r *Raft) error {
+		rvr = r.requestVote(rv)
+		return nil
+	})
+	return rvr, err
+}
+
+func (t *memTransport) installSnapshot(node *Node, snapshot *Snapshot) (*SnapshotReturn, error) {
+	var sr *SnapshotReturn
+	err := t.network.deliver(t.self, node.Id, snapshot, func(r *Raft) (err error) {
+		sr, err = r.installSnapshot(snapshot)
+		return
+	})
+	return sr, err
+}
+
+func (t *memTransport) readIndex(node *Node) (*ReadIndexReturn, error) {
+	var rir *ReadIndexReturn
+	err := t.network.deliver(t.self, node.Id, nil, func(r *Raft) (err error) {
+		rir, err = r.leaderReadIndexReturn()
+		return
+	})
+	return rir, err
+}
+
+// harness 进程内Raft集群，节点不启动定时任务，由测试推进时钟并触发定时任务
+type harness struct {
+	t       *testing.T
+	dir     string
+	ids     []string
+	clock   *fakeClock
+	tasks   *tasks
+	network *network
+	// 各节点的配置集合，节点重启后重新创建
+	stores map[string]*memStore
+	// 已应用的配置操作日志数超过该值时压缩至快照
+	snapshotEntries int32
+}
+
+// newCluster 创建并启动节点ID为1至size的集群
+func newCluster(t *testing.T, size int, snapshotEntries int32) *harness {
+	dir, err := ioutil.TempDir("", "raft-cluster")
+	if nil != err {
+		t.Fatal(err)
+	}
+	c := &harness{
+		t:               t,
+		dir:             dir,
+		clock:           &fakeClock{time: time.Unix(1e9, 0)},
+		tasks:           &tasks{},
+		stores:          map[string]*memStore{},
+		snapshotEntries: snapshotEntries,
+	}
+	c.network = &network{
+		clock:      c.clock,
+		tasks:      c.tasks,
+		nodes:      map[string]*Raft{},
+		drops:      map[link]bool{},
+		delays:     map[link]*delay{},
+		partitions: map[string]int{},
+	}
+	for i := 1; i <= size; i++ {
+		c.ids = append(c.ids, strconv.Itoa(i))
+	}
+	for _, id := range c.ids {
+		c.start(id)
+	}
+	return c
+}
+
+// close 停止全部节点并删除持久化目录
+func (c *harness) close() {
+	for _, id := range c.ids {
+		c.stop(id)
+	}
+	c.settle()
+	_ = os.RemoveAll(c.dir)
+}
+
+// start 以持久化目录中的状态启动节点
+func (c *harness) start(id string) {
+	c.launch(id, true)
+}
+
+// join 以非成员身份启动新节点，由Leader提交成员变更后加入集群
+func (c *harness) join(id string) {
+	c.ids = append(c.ids, id)
+	c.launch(id, false)
+}
+
+func (c *harness) launch(id string, member bool) {
+	nodes := make([]*Node, 0, len(c.ids)-1)
+	for _, other := range c.ids {
+		if other != id {
+			nodes = append(nodes, &Node{Id: other, Url: "node" + other})
+		}
+	}
+	c.stores[id] = newMemStore()
+	seed, _ := strconv.Atoi(id)
+	r := newRaft(&options{
+		self:            &Node{Id: id, Url: "node" + id},
+		nodes:           nodes,
+		member:          member,
+		dataPath:        filepath.Join(c.dir, id),
+		snapshotEntries: c.snapshotEntries,
+		transport:       &memTransport{self: id, network: c.network},
+		clock:           c.clock,
+		random:          rand.New(rand.NewSource(int64(seed))),
+		store:           c.stores[id],
+		tasks:           c.tasks,
+	})
+	defer c.network.lock.Unlock()
+	c.network.lock.Lock()
+	c.network.nodes[id] = r
+}
+
+// stop 停止节点，停止后的节点不可达
+func (c *harness) stop(id string) {
+	c.network.lock.Lock()
+	r := c.network.nodes[id]
+	delete(c.network.nodes, id)
+	c.network.lock.Unlock()
+	if nil != r {
+		r.lock.Lock()
+		r.role.release()
+		r.lock.Unlock()
+	}
+}
+
+// restart 停止节点后以持久化目录中的状态重新启动
+func (c *harness) restart(id string) {
+	c.stop(id)
+	c.settle()
+	c.start(id)
+}
+
+// node 获取运行中的节点
+func (c *harness) node(id string) *Raft {
+	defer c.network.lock.Unlock()
+	c.network.lock.Lock()
+	return c.network.nodes[id]
+}
+
+// running 运行中的节点ID
+func (c *harness) running() []string {
+	ids := make([]string, 0, len(c.ids))
+	for _, id := range c.ids {
+		if nil != c.node(id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// advance 推进时钟，投递到期的延迟请求
+func (c *harness) advance(d time.Duration) {
+	c.clock.advance(d)
+	c.network.flush()
+	c.settle()
+}
+
+// tick 依次触发节点的定时任务，未指定时触发全部运行中的节点，每个节点的定时任务及其引发的异步任务完成后再触发下一个
+func (c *harness) tick(ids ...string) {
+	if len(ids) == 0 {
+		ids = c.running()
+	}
+	for _, id := range ids {
+		if r := c.node(id); nil != r {
+			c.tasks.spawn(r.scheduled.tick)
+			c.settle()
+		}
+	}
+}
+
+// tickTogether 同时触发节点的定时任务
+func (c *harness) tickTogether(ids ...string) {
+	for _, id := range ids {
+		if r := c.node(id); nil != r {
+			c.tasks.spawn(r.scheduled.tick)
+		}
+	}
+	c.settle()
+}
+
+// run 每tickInterval推进一次时钟并触发全部运行中节点的定时任务，共持续d
+func (c *harness) run(d time.Duration) {
+	for elapsed := time.Duration(0); elapsed < d; elapsed += tickInterval {
+		c.advance(tickInterval)
+		c.tick()
+	}
+}
+
+// elect 推进时钟至全部节点选举超时，由该节点发起选举
+func (c *harness) elect(id string) {
+	c.advance(2 * timeout * time.Millisecond)
+	c.tick(id)
+	if c.node(id).character() != RoleLeader {
+		c.t.Fatalf("node %s should be elected as leader", id)
+	}
+	// 提交空操作日志并同步提交索引
+	c.tick(id)
+	c.tick(id)
+}
+
+// propose 向节点提交配置操作，提交后等待日志复制至其他节点
+func (c *harness) propose(id string, entry *Entry) {
+	if err := c.node(id).propose(entry); nil != err {
+		c.t.Fatal(err)
+	}
+	c.settle()
+}
+
+// settle 等待集群内异步任务完成
+func (c *harness) settle() {
+	deadline := time.Now().Add(settleTimeout)
+	for c.tasks.pending() > 0 {
+		if time.Now().After(deadline) {
+			c.t.Fatalf("cluster did not settle, %d tasks pending", c.tasks.pending())
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+// leaders 运行中自认为Leader的节点ID
+func (c *harness) leaders() []string {
+	var ids []string
+	for _, id := range c.running() {
+		if c.node(id).character() == RoleLeader {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// drop 丢弃from至to的请求及响应
+func (c *harness) drop(from, to string) {
+	defer c.network.lock.Unlock()
+	c.network.lock.Lock()
+	c.network.drops[link{from, to}] = true
+}
+
+// delay 延迟投递from至to的请求，发送方视为请求失败，match不为nil时仅延迟匹配的请求
+func (c *harness) delay(from, to string, d time.Duration, match func(msg interface{}) bool) {
+	defer c.network.lock.Unlock()
+	c.network.lock.Lock()
+	c.network.delays[link{from, to}] = &delay{d: d, match: match}
+}
+
+// partition 将节点划分为互不可达的分区，未指定的节点位于分区0
+func (c *harness) partition(groups ...[]string) {
+	defer c.network.lock.Unlock()
+	c.network.lock.Lock()
+	c.network.partitions = map[string]int{}
+	for i, group := range groups {
+		for _, id := range group {
+			c.network.partitions[id] = i + 1
+		}
+	}
+}
+
+// heal 恢复全部链路，已延迟的请求仍按时投递
+func (c *harness) heal() {
+	defer c.network.lock.Unlock()
+	c.network.lock.Lock()
+	c.network.drops = map[link]bool{}
+	c.network.delays = map[link]*delay{}
+	c.network.partitions = map[string]int{}
+}
@@ -15,13 +15,11 @@
 package rafts
 
 import (
-	"context"
-	"github.com/aberic/fabric-client/grpc/proto/utils"
-	"github.com/aberic/fabric-client/service"
 	"github.com/aberic/gnomon"
 	"github.com/panjf2000/ants"
-	"google.golang.org/grpc"
 	"gopkg.in/yaml.v3"
+	"sort"
+	"sync"
 )
 
 // leader 负责接收客户端的请求，将日志复制到其他节点并告知其他节点何时应用这些日志是安全的
@@ -30,20 +28,51 @@ type leader struct {
 	raft *Raft
 	// 发送心跳协程池
 	heartBeatPool *ants.PoolWithFunc
+	// 各Follower节点日志复制进度，key为节点ID
+	progresses map[string]*progress
+	// 日志复制进度变更锁
+	lock sync.Mutex
+	// 成为Leader时间戳ms
+	since int64
+	// 成为Leader时的任期，Leader期间不变，心跳及提交无需持有raft.lock读取
+	term int32
+}
+
+// progress Leader节点记录的Follower节点日志复制进度
+type progress struct {
+	// 下一条需要发送给该节点的日志索引
+	nextIndex int32
+	// 已复制到该节点的最大日志索引
+	matchIndex int32
+	// 最后一次心跳或快照请求是否成功
+	reachable bool
+	// 最后一次收到该节点响应的时间戳ms
+	lastAck int64
+	// 最后一次请求失败原因
+	errMsg string
+	// 心跳请求是否尚未返回
+	inflight bool
 }
 
 func (l *leader) become(raft *Raft) {
 	gnomon.Log().Info("raft", gnomon.Log().Field("become", "Leader"))
 	l.raft = raft
-	l.raft.persistence.version = 0
-	l.raft.persistence.currentTerm = l.raft.term
+	l.term = raft.term
+	l.since = l.raft.scheduled.now()
 	l.raft.persistence.leaderID = l.raft.self.Id
-	l.raft.persistence.votedFor.id = ""
-	l.raft.persistence.votedFor.term = 0
-	l.heartBeatPool, _ = ants.NewPoolWithFunc(len(l.raft.nodes), func(i interface{}) {
-		l.heartbeat(i)
+	l.raft.persistence.saveState(l.raft.term)
+	l.progresses = map[string]*progress{}
+	l.heartBeatPool, _ = ants.NewPoolWithFunc(poolSize(l.raft.others()), func(i interface{}) {
+		defer l.raft.tasks.done()
+		node := i.(*Node)
+		defer l.sent(node.Id)
+		l.heartbeat(node)
 	})
-	l.raft.scheduled.tickerStart()
+	// 追加空操作日志，使之前任期的日志随当前任期的日志一起提交
+	if _, err := l.raft.log.append(l.term, &Entry{Type: EntryType_Noop}); nil != err {
+		gnomon.Log().Warn("raft", gnomon.Log().Field("append noop", l.term), gnomon.Log().Err(err))
+	}
+	l.advanceCommit()
 }
 
 func (l *leader) leader() {}
@@ -62,9 +91,6 @@ func (l *leader) follower() {
 
 func (l *leader) release() {
 	l.heartBeatPool.Release()
-	if nil != l.raft.scheduled.tickerEnd {
-		l.raft.scheduled.tickerEnd <- 1
-	}
 }
 
 func (l *leader) role() int {
@@ -72,67 +98,285 @@ func (l *leader) role() int {
 }
 
 func (l *leader) work() {
+	if !l.checkQuorum() {
+		gnomon.Log().Warn("raft", gnomon.Log().Field("checkQuorum", "majority of members are not active, step down"),
+			gnomon.Log().Field("term", l.term))
+		l.follower()
+		return
+	}
 	l.sendHeartbeats()
 }
 
-// HB 组合心跳发送参数
-type HB struct {
-	// Node 节点信息
-	node *Node
-	// AppendEntries 用于Leader节点复制日志给其他节点，也作为心跳
-	hBeat *HBeat
-}
-
-// heartBeat 发送心跳
-func (l *leader) heartbeat(i interface{}) {
-	var (
-		hbr    interface{}
-		result *HBeatReturn
-		err    error
-	)
-	hb := i.(*HB)
-	hbr, err = utils.RPC(hb.node.Url, func(conn *grpc.ClientConn) (interface{}, error) {
-		// 创建grpc客户端
-		cli := NewRaftClient(conn)
-		//客户端向grpc服务端发起请求
-		if result, err = cli.Heartbeat(context.Background(), hb.hBeat); nil != err {
-			return nil, err
+// checkQuorum 包括自身在内的大多数成员在最短选举超时内响应过心跳时返回true，否则Leader可能已被隔离，其他成员会选举出新的Leader
+//
+// 成为Leader未满最短选举超时时Follower尚未来得及响应，视为满足
+func (l *leader) checkQuorum() bool {
+	now := l.raft.scheduled.now()
+	if now-l.since < timeout {
+		return true
+	}
+	members := l.raft.members()
+	active := 0
+	for _, member := range members {
+		if member.Id == l.raft.self.Id || now-l.progress(member.Id).lastAck < timeout {
+			active++
 		}
-		return result, nil
+	}
+	return 2*active > len(members)
+}
+
+// progress 获取节点日志复制进度，新节点从Leader最后一条日志之后开始复制
+func (l *leader) progress(nodeID string) *progress {
+	defer l.lock.Unlock()
+	l.lock.Lock()
+	pg, ok := l.progresses[nodeID]
+	if !ok {
+		lastIndex, _ := l.raft.log.lastIndexAndTerm()
+		pg = &progress{nextIndex: lastIndex + 1}
+		l.progresses[nodeID] = pg
+	}
+	return &progress{nextIndex: pg.nextIndex, matchIndex: pg.matchIndex, reachable: pg.reachable, lastAck: pg.lastAck, errMsg: pg.errMsg}
+}
+
+// sending 标记向节点发送心跳，上一次心跳尚未返回时返回false，避免响应缓慢的节点占满协程池而延误其他节点的心跳
+func (l *leader) sending(nodeID string) bool {
+	l.progress(nodeID)
+	defer l.lock.Unlock()
+	l.lock.Lock()
+	pg := l.progresses[nodeID]
+	if pg.inflight {
+		return false
+	}
+	pg.inflight = true
+	return true
+}
+
+// sent 向节点发送的心跳已返回
+func (l *leader) sent(nodeID string) {
+	defer l.lock.Unlock()
+	l.lock.Lock()
+	if pg := l.progresses[nodeID]; nil != pg {
+		pg.inflight = false
+	}
+}
+
+// acked 记录节点响应结果，err不为nil时节点不可达
+func (l *leader) acked(nodeID string, err error) {
+	defer l.lock.Unlock()
+	l.lock.Lock()
+	pg := l.progresses[nodeID]
+	if nil == pg {
+		return
+	}
+	if pg.reachable = nil == err; pg.reachable {
+		pg.lastAck = l.raft.scheduled.now()
+		pg.errMsg = ""
+	} else {
+		pg.errMsg = err.Error()
+	}
+}
+
+// peers 各Follower节点的日志复制进度及可达状态
+func (l *leader) peers() []*PeerStatus {
+	nodes := l.raft.others()
+	peers := make([]*PeerStatus, 0, len(nodes))
+	for _, node := range nodes {
+		pg := l.progress(node.Id)
+		peers = append(peers, &PeerStatus{
+			Id:         node.Id,
+			Url:        node.Url,
+			Reachable:  pg.reachable,
+			LastAck:    pg.lastAck,
+			MatchIndex: pg.matchIndex,
+			NextIndex:  pg.nextIndex,
+			ErrMsg:     pg.errMsg,
+		})
+	}
+	return peers
+}
+
+// heartBeat 向节点复制日志，也作为心跳，返回节点是否认可当前任期
+func (l *leader) heartbeat(node *Node) bool {
+	prevLogIndex, prevLogTerm, entries, ok := l.raft.log.entriesFrom(l.progress(node.Id).nextIndex)
+	if !ok {
+		return l.installSnapshot(node)
+	}
+	hBeat := &HBeat{
+		Term:         l.term,
+		LeaderId:     l.raft.self.Id,
+		PrevLogIndex: prevLogIndex,
+		PrevLogTerm:  prevLogTerm,
+		Entries:      entries,
+		LeaderCommit: l.raft.log.committed(),
+	}
+	heartbeatReturn, err := l.raft.transport.heartbeat(node, hBeat)
+	l.acked(node.Id, err)
+	if nil != err {
+		gnomon.Log().Warn("raft", gnomon.Log().Err(err))
+		return false
+	}
+	switch {
+	case heartbeatReturn.Term > hBeat.Term:
+		l.stepDown(heartbeatReturn.Term)
+		return false
+	case heartbeatReturn.Success:
+		l.matched(node.Id, prevLogIndex+int32(len(entries)))
+	default:
+		l.mismatched(node.Id, heartbeatReturn.LastLogIndex)
+	}
+	return true
+}
+
+// installSnapshot 节点落后于已压缩的日志，发送配置快照，返回节点是否认可当前任期
+func (l *leader) installSnapshot(node *Node) bool {
+	index, term, configs, members := l.raft.log.snapshot()
+	data, err := yaml.Marshal(configs)
+	if nil != err {
+		gnomon.Log().Error("raft", gnomon.Log().Field("snapshot", index), gnomon.Log().Err(err))
+		return false
+	}
+	currentTerm := l.term
+	snapshotReturn, err := l.raft.transport.installSnapshot(node, &Snapshot{
+		Term:              currentTerm,
+		LeaderId:          l.raft.self.Id,
+		LastIncludedIndex: index,
+		LastIncludedTerm:  term,
+		Data:              data,
+		Nodes:             members,
 	})
+	l.acked(node.Id, err)
 	if nil != err {
 		gnomon.Log().Warn("raft", gnomon.Log().Err(err))
+		return false
+	}
+	if snapshotReturn.Term > currentTerm {
+		l.stepDown(snapshotReturn.Term)
+		return false
+	}
+	gnomon.Log().Info("raft", gnomon.Log().Field("install snapshot", index), gnomon.Log().Field("node", node.Id))
+	l.matched(node.Id, index)
+	return true
+}
+
+// readIndex 向大多数成员确认自身仍为Leader后返回当前提交索引，用于线性一致读
+//
+// 当前任期尚未提交日志时，提交索引可能落后于之前任期已提交的日志，此时拒绝读请求
+func (l *leader) readIndex() (int32, error) {
+	if !l.committedInTerm() {
+		return 0, errLeaderNotReady
+	}
+	index := l.raft.log.committed()
+	if !l.confirm() {
+		return 0, ErrNotLeader
+	}
+	return index, nil
+}
+
+// committedInTerm 当前任期是否已提交日志，提交前提交索引可能落后于之前任期已提交的日志
+func (l *leader) committedInTerm() bool {
+	term, _ := l.raft.log.term(l.raft.log.committed())
+	return term == l.term
+}
+
+// confirm 向其他成员发送心跳，包括自身在内的大多数成员认可当前任期时确认自身仍为Leader
+func (l *leader) confirm() bool {
+	if !l.raft.isMember(l.raft.self.Id) {
+		return false
+	}
+	nodes := l.raft.others()
+	quorum := (len(nodes)+1)/2 + 1
+	acks := make(chan bool, len(nodes))
+	for _, node := range nodes {
+		node := node
+		l.raft.tasks.spawn(func() {
+			acks <- l.heartbeat(node)
+		})
+	}
+	granted := 1
+	for range nodes {
+		if granted >= quorum {
+			break
+		}
+		if <-acks {
+			granted++
+		}
+	}
+	defer l.raft.lock.Unlock()
+	l.raft.lock.Lock()
+	return granted >= quorum && l.raft.role == l
+}
+
+// stepDown 发现更高任期，Leader转换为Follower
+func (l *leader) stepDown(term int32) {
+	defer l.raft.lock.Unlock()
+	l.raft.lock.Lock()
+	if l.raft.role != l {
 		return
 	}
-	if heartbeatReturn := hbr.(*HBeatReturn); !heartbeatReturn.Success {
-		l.raft.term = heartbeatReturn.Term
-		l.candidate()
+	l.raft.updateTerm(term)
+}
+
+// matched 节点已复制到matchIndex，推进提交索引
+func (l *leader) matched(nodeID string, matchIndex int32) {
+	l.lock.Lock()
+	if pg := l.progresses[nodeID]; nil != pg && matchIndex > pg.matchIndex {
+		pg.matchIndex = matchIndex
+		pg.nextIndex = matchIndex + 1
 	}
+	l.lock.Unlock()
+	l.advanceCommit()
 }
 
-// sendHeartBeats 遍历发送心跳
-func (l *leader) sendHeartbeats() {
-	configStr, err := yaml.Marshal(service.GetASyncConfig())
-	if nil != err {
+// mismatched 节点日志与Leader不一致，回退nextIndex
+func (l *leader) mismatched(nodeID string, lastLogIndex int32) {
+	defer l.lock.Unlock()
+	l.lock.Lock()
+	pg := l.progresses[nodeID]
+	if nil == pg {
 		return
 	}
-	hBeat := &HBeat{
-		Term:     l.raft.term,
-		LeaderId: l.raft.self.Id,
-		Version:  l.raft.persistence.version,
-		Config:   configStr,
+	if pg.nextIndex--; lastLogIndex+1 < pg.nextIndex {
+		pg.nextIndex = lastLogIndex + 1
 	}
-	l.heartBeatPool.Tune(len(l.raft.nodes))
-	gnomon.Log().Debug("raft", gnomon.Log().Field("send heartbeat", hBeat), gnomon.Log().Field("nodes", l.raft.nodes))
+	if pg.nextIndex <= pg.matchIndex {
+		pg.nextIndex = pg.matchIndex + 1
+	}
+}
+
+// advanceCommit 当前任期的日志已复制到大多数节点时提交该日志
+func (l *leader) advanceCommit() {
+	lastIndex, _ := l.raft.log.lastIndexAndTerm()
+	matchIndexes := []int{int(lastIndex)}
+	l.lock.Lock()
+	for _, node := range l.raft.others() {
+		if pg := l.progresses[node.Id]; nil != pg {
+			matchIndexes = append(matchIndexes, int(pg.matchIndex))
+		} else {
+			matchIndexes = append(matchIndexes, 0)
+		}
+	}
+	l.lock.Unlock()
+	sort.Sort(sort.Reverse(sort.IntSlice(matchIndexes)))
+	index := int32(matchIndexes[len(matchIndexes)/2])
+	if term, ok := l.raft.log.term(index); ok && term == l.term {
+		l.raft.log.commit(index)
+	}
+}
+
+// sendHeartBeats 遍历发送心跳
+func (l *leader) sendHeartbeats() {
+	nodes := l.raft.others()
+	tunePool(l.heartBeatPool, nodes)
+	gnomon.Log().Debug("raft", gnomon.Log().Field("send heartbeat", l.term), gnomon.Log().Field("nodes", nodes))
 	// 遍历发送心跳
-	for _, node := range l.raft.nodes {
-		if node.Id == l.raft.self.Id {
+	for _, node := range nodes {
+		if !l.sending(node.Id) {
 			continue
 		}
-		if err := l.heartBeatPool.Invoke(&HB{
-			node:  node,
-			hBeat: hBeat,
-		}); nil != err {
+		l.raft.tasks.add()
+		if err := l.heartBeatPool.Invoke(node); nil != err {
+			l.raft.tasks.done()
+			l.sent(node.Id)
 			return
 		}
 	}
@@ -0,0 +1,377 @@
+/*
+ * Copyright (c) 2019. Aberic - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rafts
+
+import (
+	"github.com/aberic/fabric-client/config"
+	"github.com/aberic/gnomon"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sync"
+	"time"
+)
+
+const (
+	// maxEntriesPerHeartbeat 每次心跳最多复制的日志数
+	maxEntriesPerHeartbeat = 100
+	// defaultSnapshotEntries 已应用的日志数超过该值时压缩至快照
+	defaultSnapshotEntries = 1000
+)
+
+var (
+	// errEntryOverwritten 日志在提交前被新Leader的日志覆盖
+	errEntryOverwritten = status.Error(codes.Aborted, "raft log entry is overwritten by new leader")
+	// errLeaderNotReady Leader节点尚未提交当前任期的日志，无法确定最新的提交索引
+	errLeaderNotReady = status.Error(codes.Unavailable, "raft leader has not committed an entry in its term")
+	// errReadIndexTimeout 等待配置集合应用至读索引超时
+	errReadIndexTimeout = status.Error(codes.DeadlineExceeded, "raft read index timeout")
+)
+
+// raftLog 配置操作日志，日志提交后按顺序应用至配置集合
+type raftLog struct {
+	lock sync.Mutex
+	// entries[0] 为哨兵，记录第一条日志之前的索引及任期
+	entries []*Entry
+	// 已提交的最大日志索引
+	commitIndex int32
+	// 已应用至配置集合的最大日志索引
+	lastApplied int32
+	// 已应用的日志数超过该值时压缩至快照
+	snapshotEntries int32
+	// 已应用的集群成员，为空时沿用节点启动时的CLUSTER配置
+	members []*Node
+	// 集群成员变更应用后的回调
+	onMembers func(members []*Node)
+	// 已提交的日志应用至该配置集合
+	store store
+	// 等待日志应用结果的提交方，key为日志索引
+	waiters map[int32]chan error
+	// 配置集合应用新的日志或快照时关闭并替换，唤醒等待应用的读请求
+	appliedCh chan struct{}
+	// 持久化
+	persistence *persistence
+}
+
+// newRaftLog 由持久化的日志及快照恢复配置操作日志，entries[0]为已压缩至快照的最后一条日志
+func newRaftLog(p *persistence, s store, entries []*Entry, lastApplied, lastAppliedTerm int32, members []*Node) *raftLog {
+	l := &raftLog{
+		store:           s,
+		entries:         entries,
+		commitIndex:     lastApplied,
+		lastApplied:     lastApplied,
+		snapshotEntries: defaultSnapshotEntries,
+		members:         members,
+		onMembers:       func(members []*Node) {},
+		waiters:         map[int32]chan error{},
+		appliedCh:       make(chan struct{}),
+		persistence:     p,
+	}
+	if len(l.entries) == 0 {
+		l.entries = []*Entry{{}}
+	}
+	// 快照新于日志或与日志不一致时以快照为准
+	if entry := l.entry(lastApplied); nil == entry || entry.Term != lastAppliedTerm {
+		l.entries = []*Entry{{Index: lastApplied, Term: lastAppliedTerm}}
+	}
+	return l
+}
+
+// entry 获取索引对应的日志，不存在时返回nil，调用方须持有锁
+func (l *raftLog) entry(index int32) *Entry {
+	offset := index - l.entries[0].Index
+	if offset < 0 || int(offset) >= len(l.entries) {
+		return nil
+	}
+	return l.entries[offset]
+}
+
+func (l *raftLog) last() *Entry {
+	return l.entries[len(l.entries)-1]
+}
+
+// lastIndexAndTerm 最后一条日志的索引及任期
+func (l *raftLog) lastIndexAndTerm() (int32, int32) {
+	defer l.lock.Unlock()
+	l.lock.Lock()
+	return l.last().Index, l.last().Term
+}
+
+// committed 已提交的最大日志索引
+func (l *raftLog) committed() int32 {
+	defer l.lock.Unlock()
+	l.lock.Lock()
+	return l.commitIndex
+}
+
+// applied 已应用至配置集合的最大日志索引
+func (l *raftLog) applied() int32 {
+	defer l.lock.Unlock()
+	l.lock.Lock()
+	return l.lastApplied
+}
+
+// waitApplied 等待配置集合应用至index，timeout通道收到时间时返回超时错误
+func (l *raftLog) waitApplied(index int32, timeout <-chan time.Time) error {
+	for {
+		l.lock.Lock()
+		lastApplied, appliedCh := l.lastApplied, l.appliedCh
+		l.lock.Unlock()
+		if lastApplied >= index {
+			return nil
+		}
+		select {
+		case <-appliedCh:
+		case <-timeout:
+			return errReadIndexTimeout
+		}
+	}
+}
+
+// notifyApplied 唤醒等待配置集合应用的读请求，调用方须持有锁
+func (l *raftLog) notifyApplied() {
+	close(l.appliedCh)
+	l.appliedCh = make(chan struct{})
+}
+
+// upToDate 判断候选人的日志是否至少与本地日志一样新
+func (l *raftLog) upToDate(lastLogIndex, lastLogTerm int32) bool {
+	index, term := l.lastIndexAndTerm()
+	return lastLogTerm > term || (lastLogTerm == term && lastLogIndex >= index)
+}
+
+// term 获取索引对应日志的任期
+func (l *raftLog) term(index int32) (int32, bool) {
+	defer l.lock.Unlock()
+	l.lock.Lock()
+	if entry := l.entry(index); nil != entry {
+		return entry.Term, true
+	}
+	return 0, false
+}
+
+// append Leader节点追加日志并持久化，返回等待日志应用结果的通道，持久化失败时丢弃该日志
+func (l *raftLog) append(term int32, entry *Entry) (chan error, error) {
+	defer l.lock.Unlock()
+	l.lock.Lock()
+	entry.Index = l.last().Index + 1
+	entry.Term = term
+	l.entries = append(l.entries, entry)
+	if err := l.persistence.appendLog(l.entries, len(l.entries)-1); nil != err {
+		l.entries = l.entries[:len(l.entries)-1]
+		return nil, err
+	}
+	wait := make(chan error, 1)
+	l.waiters[entry.Index] = wait
+	return wait, nil
+}
+
+// pendingMembership 是否存在未提交的集群成员变更日志
+func (l *raftLog) pendingMembership() bool {
+	defer l.lock.Unlock()
+	l.lock.Lock()
+	for index := l.commitIndex + 1; index <= l.last().Index; index++ {
+		if entry := l.entry(index); nil != entry && entry.Type == EntryType_Membership {
+			return true
+		}
+	}
+	return false
+}
+
+// cancel 放弃等待日志应用结果
+func (l *raftLog) cancel(index int32) {
+	defer l.lock.Unlock()
+	l.lock.Lock()
+	delete(l.waiters, index)
+}
+
+// entriesFrom 获取从nextIndex开始需要复制的日志及其之前一条日志的索引和任期，日志已压缩至快照时返回false
+func (l *raftLog) entriesFrom(nextIndex int32) (int32, int32, []*Entry, bool) {
+	defer l.lock.Unlock()
+	l.lock.Lock()
+	if nextIndex <= l.entries[0].Index {
+		return 0, 0, nil, false
+	}
+	prev := l.entry(nextIndex - 1)
+	if nil == prev {
+		prev = l.last()
+		nextIndex = prev.Index + 1
+	}
+	offset := int(nextIndex - l.entries[0].Index)
+	end := offset + maxEntriesPerHeartbeat
+	if end > len(l.entries) {
+		end = len(l.entries)
+	}
+	return prev.Index, prev.Term, l.entries[offset:end], true
+}
+
+// appendEntries Follower节点校验并追加Leader复制的日志，返回是否匹配及与Leader一致的最后一条日志索引
+//
+// 如果存在index相同但是term不相同的日志，删除从该位置开始所有的日志
+//
+// 如果leaderCommit>commitIndex，将commitIndex设置为commitIndex = min(leaderCommit, index of last new entry)
+//
+// 持久化失败时恢复追加前的日志并返回错误，由Leader重新复制
+func (l *raftLog) appendEntries(prevLogIndex, prevLogTerm int32, entries []*Entry, leaderCommit int32) (bool, int32, error) {
+	defer l.lock.Unlock()
+	l.lock.Lock()
+	prev := l.entry(prevLogIndex)
+	if nil == prev {
+		return false, l.last().Index, nil
+	}
+	if prev.Term != prevLogTerm {
+		return false, prevLogIndex - 1, nil
+	}
+	var backup []*Entry
+	from, truncated := -1, false
+	for _, entry := range entries {
+		if local := l.entry(entry.Index); nil != local {
+			if local.Term == entry.Term {
+				continue
+			}
+			if !truncated {
+				backup = append([]*Entry{}, l.entries...)
+			}
+			l.truncate(entry.Index)
+			truncated = true
+		}
+		if from < 0 {
+			from = len(l.entries)
+		}
+		l.entries = append(l.entries, entry)
+	}
+	if from >= 0 {
+		var err error
+		if truncated {
+			err = l.persistence.saveLog(l.entries)
+		} else {
+			backup = l.entries[:from]
+			err = l.persistence.appendLog(l.entries, from)
+		}
+		if nil != err {
+			l.entries = backup
+			return false, prevLogIndex, err
+		}
+	}
+	lastNewIndex := prevLogIndex + int32(len(entries))
+	if leaderCommit > l.commitIndex {
+		if leaderCommit < lastNewIndex {
+			l.commitTo(leaderCommit)
+		} else {
+			l.commitTo(lastNewIndex)
+		}
+	}
+	return true, lastNewIndex, nil
+}
+
+// truncate 删除从index开始的所有日志，并通知等待这些日志的提交方，调用方须持有锁
+func (l *raftLog) truncate(index int32) {
+	l.notify(index, l.last().Index, errEntryOverwritten)
+	l.entries = l.entries[:index-l.entries[0].Index]
+}
+
+// notify 通知等待索引from至to日志的提交方，调用方须持有锁
+func (l *raftLog) notify(from, to int32, err error) {
+	for index := from; index <= to; index++ {
+		if wait, ok := l.waiters[index]; ok {
+			wait <- err
+			delete(l.waiters, index)
+		}
+	}
+}
+
+// commit 提交日志至index并应用
+func (l *raftLog) commit(index int32) {
+	defer l.lock.Unlock()
+	l.lock.Lock()
+	l.commitTo(index)
+}
+
+// commitTo 提交日志至index，并按顺序将已提交的日志应用至配置集合，调用方须持有锁
+func (l *raftLog) commitTo(index int32) {
+	if index <= l.commitIndex || index > l.last().Index {
+		return
+	}
+	l.commitIndex = index
+	for l.lastApplied < l.commitIndex {
+		l.lastApplied++
+		entry := l.entry(l.lastApplied)
+		var err error
+		if entry.Type == EntryType_Membership {
+			l.members = entry.Nodes
+			l.onMembers(entry.Nodes)
+		} else {
+			err = applyEntry(l.store, entry)
+		}
+		if nil != err {
+			gnomon.Log().Error("raft", gnomon.Log().Field("apply", entry.Index), gnomon.Log().Err(err))
+		}
+		l.notify(entry.Index, entry.Index, err)
+	}
+	l.notifyApplied()
+	// 配置快照持久化失败时不压缩日志，重启后仍可由日志恢复
+	err := l.persistence.saveConfigs(l.lastApplied, l.entry(l.lastApplied).Term, l.store.configs(), l.members)
+	if nil == err && l.lastApplied-l.entries[0].Index >= l.snapshotEntries {
+		l.compact()
+	}
+}
+
+// compact 配置集合已作为快照持久化，删除已应用的日志，调用方须持有锁
+func (l *raftLog) compact() {
+	offset := l.lastApplied - l.entries[0].Index
+	l.entries = append([]*Entry{{Index: l.lastApplied, Term: l.entries[offset].Term}}, l.entries[offset+1:]...)
+	l.persistence.saveLog(l.entries)
+	gnomon.Log().Info("raft", gnomon.Log().Field("compact", l.lastApplied))
+}
+
+// snapshot 获取配置快照、集群成员及快照包含的最后一条日志的索引和任期
+func (l *raftLog) snapshot() (int32, int32, map[string]config.Config, []*Node) {
+	defer l.lock.Unlock()
+	l.lock.Lock()
+	return l.lastApplied, l.entry(l.lastApplied).Term, l.store.configs(), l.members
+}
+
+// installSnapshot 使用Leader发送的快照重置配置集合，保留快照之后与Leader一致的日志，持久化失败时返回错误
+func (l *raftLog) installSnapshot(index, term int32, configs map[string]*config.Config, members []*Node) error {
+	defer l.lock.Unlock()
+	l.lock.Lock()
+	if index <= l.commitIndex {
+		return nil
+	}
+	if entry := l.entry(index); nil != entry && entry.Term == term {
+		l.notify(l.entries[0].Index+1, index, nil)
+		l.entries = append([]*Entry{{Index: index, Term: term}}, l.entries[index-l.entries[0].Index+1:]...)
+	} else {
+		l.notify(l.entries[0].Index+1, l.last().Index, errEntryOverwritten)
+		l.entries = []*Entry{{Index: index, Term: term}}
+	}
+	l.commitIndex = index
+	l.lastApplied = index
+	l.store.reset(configs)
+	if len(members) > 0 {
+		l.members = members
+		l.onMembers(members)
+	}
+	l.notifyApplied()
+	if err := l.persistence.saveConfigs(index, term, l.store.configs(), l.members); nil != err {
+		// 日志文件已落后于内存日志，下次持久化日志时重写整个文件
+		l.persistence.logDirty = true
+		return err
+	}
+	if err := l.persistence.saveLog(l.entries); nil != err {
+		return err
+	}
+	gnomon.Log().Info("raft", gnomon.Log().Field("install snapshot", index), gnomon.Log().Field("term", term))
+	return nil
+}
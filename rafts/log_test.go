@@ -0,0 +1,144 @@
+/*
+ * Copyright (c) 2019. Aberic - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rafts
+
+import (
+	"github.com/aberic/fabric-client/config"
+	"github.com/aberic/fabric-client/service"
+	"gopkg.in/yaml.v3"
+	"testing"
+	"time"
+)
+
+func TestRaftLog(t *testing.T) {
+	defer service.Recover(nil)
+	data, err := yaml.Marshal(&config.Config{})
+	if nil != err {
+		t.Fatal(err)
+	}
+	leaderLog := newRaftLog(&persistence{}, serviceStore{}, nil, 0, 0, nil)
+	wait, _ := leaderLog.append(1, &Entry{Type: EntryType_Init, ConfigID: "log1", Data: data})
+	leaderLog.append(1, &Entry{Type: EntryType_Init, ConfigID: "log2", Data: data})
+
+	// follower 在任期2有一条未提交的冲突日志
+	followerLog := newRaftLog(&persistence{}, serviceStore{}, []*Entry{{}, {Index: 1, Term: 1, Type: EntryType_Init, ConfigID: "log1", Data: data},
+		{Index: 2, Term: 1, Type: EntryType_Init, ConfigID: "log2", Data: data}, {Index: 3, Term: 2, Type: EntryType_Delete, ConfigID: "log2"}}, 0, 0, nil)
+	leaderLog.append(3, &Entry{Type: EntryType_Delete, ConfigID: "log1"})
+	if ok, _, _ := followerLog.appendEntries(3, 3, nil, 0); ok {
+		t.Error("mismatched prevLogTerm should be refused")
+	}
+	prevLogIndex, prevLogTerm, entries, _ := leaderLog.entriesFrom(2)
+	ok, matchIndex, _ := followerLog.appendEntries(prevLogIndex, prevLogTerm, entries, 2)
+	if !ok || matchIndex != 3 {
+		t.Fatalf("entries should be appended, got %v %d", ok, matchIndex)
+	}
+	if index, term := followerLog.lastIndexAndTerm(); index != 3 || term != 3 {
+		t.Errorf("conflicting entries should be replaced, got last %d/%d", index, term)
+	}
+	if nil == service.Get("log1") || nil == service.Get("log2") || followerLog.lastApplied != 2 {
+		t.Errorf("entries up to leaderCommit should be applied, lastApplied %d", followerLog.lastApplied)
+	}
+	leaderLog.commit(3)
+	if err = <-wait; nil != err {
+		t.Error(err)
+	}
+	if nil != service.Get("log1") {
+		t.Error("delete entry should be applied")
+	}
+	if followerLog.upToDate(2, 3) || !followerLog.upToDate(1, 4) {
+		t.Error("candidate log freshness should compare last term first")
+	}
+}
+
+func TestRaftLogSnapshot(t *testing.T) {
+	defer service.Recover(nil)
+	data, err := yaml.Marshal(&config.Config{})
+	if nil != err {
+		t.Fatal(err)
+	}
+	leaderLog := newRaftLog(&persistence{}, serviceStore{}, nil, 0, 0, nil)
+	leaderLog.snapshotEntries = 2
+	leaderLog.append(1, &Entry{Type: EntryType_Init, ConfigID: "snap1", Data: data})
+	leaderLog.append(1, &Entry{Type: EntryType_Init, ConfigID: "snap2", Data: data})
+	leaderLog.append(2, &Entry{Type: EntryType_Delete, ConfigID: "snap1"})
+	leaderLog.commit(2)
+	if leaderLog.entries[0].Index != 2 || leaderLog.entries[0].Term != 1 || len(leaderLog.entries) != 2 {
+		t.Fatalf("applied entries should be compacted, got %v", leaderLog.entries)
+	}
+	if _, _, _, ok := leaderLog.entriesFrom(2); ok {
+		t.Error("compacted entries should be sent by snapshot")
+	}
+	prevLogIndex, prevLogTerm, entries, ok := leaderLog.entriesFrom(3)
+	if !ok || prevLogIndex != 2 || prevLogTerm != 1 || len(entries) != 1 {
+		t.Errorf("entries after snapshot should be replicated, got %d/%d %v", prevLogIndex, prevLogTerm, entries)
+	}
+
+	index, term, configs, _ := leaderLog.snapshot()
+	snapshotData, err := yaml.Marshal(configs)
+	if nil != err {
+		t.Fatal(err)
+	}
+	var cs map[string]*config.Config
+	if err = yaml.Unmarshal(snapshotData, &cs); nil != err {
+		t.Fatal(err)
+	}
+	service.Recover(nil)
+	followerLog := newRaftLog(&persistence{}, serviceStore{}, []*Entry{{}, {Index: 1, Term: 1, Type: EntryType_Delete, ConfigID: "snap2"}}, 0, 0, nil)
+	followerLog.installSnapshot(index, term, cs, nil)
+	if followerLog.lastApplied != 2 || len(followerLog.entries) != 1 || nil == service.Get("snap2") {
+		t.Errorf("snapshot should reset log and configs, got %d %v", followerLog.lastApplied, followerLog.entries)
+	}
+	if ok, matchIndex, _ := followerLog.appendEntries(prevLogIndex, prevLogTerm, entries, 3); !ok || matchIndex != 3 || nil != service.Get("snap1") {
+		t.Errorf("entries after snapshot should be appended and applied, got %v %d", ok, matchIndex)
+	}
+}
+
+func TestRaftLogMembership(t *testing.T) {
+	var applied []*Node
+	l := newRaftLog(&persistence{}, serviceStore{}, nil, 0, 0, nil)
+	l.onMembers = func(members []*Node) { applied = members }
+	members := []*Node{{Id: "1", Url: "127.0.0.1:19877"}, {Id: "2", Url: "127.0.0.1:19878"}}
+	l.append(1, &Entry{Type: EntryType_Membership, Nodes: members})
+	if !l.pendingMembership() {
+		t.Error("uncommitted membership entry should be pending")
+	}
+	l.commit(1)
+	if l.pendingMembership() || len(applied) != 2 || len(l.members) != 2 {
+		t.Errorf("committed membership should be applied, got %v", applied)
+	}
+	if _, _, _, snapshotMembers := l.snapshot(); len(snapshotMembers) != 2 {
+		t.Errorf("snapshot should carry members, got %v", snapshotMembers)
+	}
+}
+
+func TestRaftLogWaitApplied(t *testing.T) {
+	defer service.Recover(nil)
+	l := newRaftLog(&persistence{}, serviceStore{}, nil, 0, 0, nil)
+	l.append(1, &Entry{Type: EntryType_Noop})
+	clock := &fakeClock{time: time.Unix(1e9, 0)}
+	timeout := clock.after(readIndexTimeout)
+	clock.advance(readIndexTimeout)
+	if err := l.waitApplied(1, timeout); err != errReadIndexTimeout {
+		t.Errorf("wait should time out before entry is applied, got %v", err)
+	}
+	wait := make(chan error, 1)
+	go func() {
+		wait <- l.waitApplied(1, clock.after(readIndexTimeout))
+	}()
+	l.commit(1)
+	if err := <-wait; nil != err {
+		t.Error(err)
+	}
+}
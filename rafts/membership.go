@@ -0,0 +1,156 @@
+/*
+ * Copyright (c) 2019. Aberic - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rafts
+
+import (
+	"errors"
+	"github.com/aberic/gnomon"
+	"github.com/panjf2000/ants"
+	"strings"
+)
+
+// others 除自身外的集群成员
+func (r *Raft) others() []*Node {
+	defer r.nodesLock.RUnlock()
+	r.nodesLock.RLock()
+	return r.nodes
+}
+
+// members 包括自身在内的全部集群成员
+func (r *Raft) members() []*Node {
+	defer r.nodesLock.RUnlock()
+	r.nodesLock.RLock()
+	members := make([]*Node, 0, len(r.nodes)+1)
+	if r.member {
+		members = append(members, &Node{Id: r.self.Id, Url: r.self.Url})
+	}
+	return append(members, r.nodes...)
+}
+
+// isMember 判断节点是否为集群成员
+func (r *Raft) isMember(id string) bool {
+	defer r.nodesLock.RUnlock()
+	r.nodesLock.RLock()
+	if id == r.self.Id {
+		return r.member
+	}
+	for _, node := range r.nodes {
+		if node.Id == id {
+			return true
+		}
+	}
+	return false
+}
+
+// setMembers 应用已提交的集群成员变更，自身被移除时不再参与选举
+func (r *Raft) setMembers(members []*Node) {
+	r.nodesLock.Lock()
+	nodes := make([]*Node, 0, len(members))
+	member := false
+	for _, node := range members {
+		if node.Id == r.self.Id {
+			member = true
+			continue
+		}
+		nodes = append(nodes, &Node{Id: node.Id, Url: node.Url})
+	}
+	r.nodes = nodes
+	r.member = member
+	r.nodesLock.Unlock()
+	gnomon.Log().Info("raft", gnomon.Log().Field("members", members), gnomon.Log().Field("member", member))
+	if !member {
+		// 在提交日志的协程之外释放Leader角色，避免与持有lock的调用方相互等待
+		r.tasks.spawn(func() {
+			defer r.lock.Unlock()
+			r.lock.Lock()
+			if nil != r.role && r.role.role() == RoleLeader && !r.isMember(r.self.Id) {
+				r.role.follower()
+			}
+		})
+	}
+}
+
+// poolSize 协程池大小，至少为1
+func poolSize(nodes []*Node) int {
+	if len(nodes) == 0 {
+		return 1
+	}
+	return len(nodes)
+}
+
+// tunePool 按集群成员数扩大协程池
+//
+// 不缩小协程池，ants在运行中的协程数超过容量时回收协程不会唤醒阻塞的Invoke
+func tunePool(pool *ants.PoolWithFunc, nodes []*Node) {
+	if size := poolSize(nodes); size > pool.Cap() {
+		pool.Tune(size)
+	}
+}
+
+// changeMembers Leader节点通过日志提交单节点成员变更，同一时间只允许一个未提交的变更
+func (r *Raft) changeMembers(node *Node, add bool) *MemberReturn {
+	if err := r.proposeMembers(node, add); nil != err {
+		gnomon.Log().Warn("raft", gnomon.Log().Field("change members", node), gnomon.Log().Err(err))
+		return &MemberReturn{ErrMsg: err.Error(), Nodes: r.members()}
+	}
+	return &MemberReturn{Success: true, Nodes: r.members()}
+}
+
+// proposeMembers 校验并提交成员变更
+//
+// Leader提交当前任期的日志前，之前任期未提交的成员变更可能随后被提交，与新的变更叠加后不再是单节点变更，此时拒绝变更
+func (r *Raft) proposeMembers(node *Node, add bool) error {
+	r.lock.Lock()
+	role := r.role
+	r.lock.Unlock()
+	if nil == role {
+		return errors.New("raft cluster is not started")
+	}
+	l, ok := role.(*leader)
+	if !ok {
+		return errors.New(strings.Join([]string{"raft node is not leader, leader is", r.leaderURL()}, " "))
+	}
+	if gnomon.String().IsEmpty(node.Id) || (add && gnomon.String().IsEmpty(node.Url)) {
+		return errors.New("node id and url are required")
+	}
+	defer r.changeLock.Unlock()
+	r.changeLock.Lock()
+	if r.log.pendingMembership() {
+		return errors.New("another membership change is not committed yet")
+	}
+	if !l.committedInTerm() {
+		return errLeaderNotReady
+	}
+	members := r.members()
+	exist := -1
+	for i, member := range members {
+		if member.Id == node.Id {
+			exist = i
+		}
+	}
+	switch {
+	case add && exist >= 0:
+		return errors.New("node is already a member")
+	case add:
+		members = append(members, &Node{Id: node.Id, Url: node.Url})
+	case exist < 0:
+		return errors.New("node is not a member")
+	case len(members) == 1:
+		return errors.New("can not remove the last member")
+	default:
+		members = append(members[:exist], members[exist+1:]...)
+	}
+	return r.propose(&Entry{Type: EntryType_Membership, Nodes: members})
+}
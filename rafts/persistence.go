@@ -26,12 +26,41 @@
 
 package rafts
 
+import (
+	"bytes"
+	"github.com/aberic/fabric-client/config"
+	"github.com/aberic/gnomon"
+	"gopkg.in/yaml.v3"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+const (
+	// stateFileName 任期及投票信息持久化文件
+	stateFileName = "state.yaml"
+	// configsFileName 已应用的配置集合及其日志索引持久化文件
+	configsFileName = "configs.yaml"
+	// logFileName 配置操作日志持久化文件
+	logFileName = "log.yaml"
+)
+
+var (
+	// logDocStart 追加至日志文件的每批日志作为独立的yaml文档，以文档开始标记开头
+	logDocStart = []byte("\n---\n")
+	// logDocEnd 追加至日志文件的每批日志以文档结束标记结尾，用于识别写入中断的不完整日志
+	logDocEnd = []byte("\n...\n")
+)
+
 // persistence 所有角色都拥有的持久化的状态（在响应RPC请求之前变更且持久化的状态）
 type persistence struct {
-	leaderID    string    // 当前任务Leader ID
-	currentTerm int32     // 服务器的任期，初始为0，递增
-	votedFor    *votedFor // 在当前获得选票的候选人的 Id
-	version     int32     // 当前配置版本 index 递增
+	leaderID string    // 当前任务Leader ID
+	votedFor *votedFor // 在当前获得选票的候选人的 Id
+	path     string    // 持久化目录，为空时仅保存在内存中
+	logDirty bool      // 日志文件与内存日志不一致，下次持久化日志时重写整个文件，由raftLog.lock保护
+	lock     sync.Mutex
 }
 
 type votedFor struct {
@@ -40,126 +69,264 @@ type votedFor struct {
 	timestamp int64  // 在当前获取选票的候选人时间戳
 }
 
-//// storage 存储本地文件
-//func (p *persistence) storage() {
-//	filePath, _ := p.fileStorage()
-//	if exist, err := file.PathExists(filePath); nil != err {
-//		log.Self.Error("raft", log.Error(err))
-//	} else if !exist {
-//		lastIndex := strings.LastIndex(filePath, "/")
-//		parentPath := filePath[0:lastIndex]
-//		if err := os.MkdirAll(parentPath, os.ModePerm); nil != err {
-//			log.Self.Error("raft", log.Error(err))
-//			return
-//		}
-//		// 创建文件，如果文件已存在，会将文件清空
-//		if f, err := os.Create(filePath); nil != err {
-//			log.Self.Error("raft", log.Error(err))
-//			return
-//		} else {
-//			p.save(f)
-//		}
-//	} else {
-//		// //表示最佳的方式打开文件，如果不存在就创建，打开的模式是可读可写，权限是644
-//		if f, err := os.OpenFile(filePath, os.O_APPEND|os.O_RDWR, 0644); nil != err {
-//			log.Self.Error("raft", log.Error(err))
-//			return
-//		} else {
-//			p.save(f)
-//		}
-//	}
-//}
-//
-//func (p *persistence) loadStorage() (configs map[string]*config.Config, err error) {
-//	var (
-//		exist bool
-//	)
-//	filePath, line := p.fileStorage()
-//	if exist, err = file.PathExists(filePath); nil != err {
-//		return
-//	} else if !exist {
-//		err = errors.New("file not exist")
-//
-//		return nil, nil
-//	}
-//	data := p.readLine(filePath, line)
-//	//byteLineChange := []byte("\n")
-//	//data = data[0 : len(data)-len(byteLineChange)]
-//	if nil == data {
-//		err = errors.New("file read nil")
-//		return
-//	}
-//	err = yaml.Unmarshal(data, configs)
-//	if nil == err {
-//		p.configs = configs
-//	}
-//	return
-//}
-//
-//func (p *persistence) readLine(filename string, ling int32) []byte {
-//	f, _ := os.Open(filename)
-//	defer p.fileClose(f)
-//	r := bufio.NewReader(f)
-//	var lineLocal int32
-//	lineLocal = 0
-//	for {
-//		if lineLocal == ling {
-//			data, err := r.ReadBytes('\n')
-//			if err == io.EOF {
-//				return data
-//			}
-//			return data
-//		} else {
-//			_, err := r.ReadBytes('\n')
-//			if err == io.EOF {
-//				break
-//			}
-//			lineLocal++
-//		}
-//	}
-//	return nil
-//}
-//
-//func (p *persistence) save(f *os.File) {
-//	defer p.fileClose(f)
-//	data, err := yaml.Marshal(p.configs)
-//	if nil != err {
-//		log.Self.Error("raft", log.Error(err))
-//		return
-//	}
-//	if ret, err := f.Seek(io.SeekStart, io.SeekEnd); nil != err {
-//		log.Self.Error("raft", log.Error(err))
-//		return
-//	} else {
-//		byteLineChange := []byte("\n")
-//		for _, b := range byteLineChange {
-//			data = append(data, b)
-//		}
-//		if _, err := f.WriteAt(data, ret); nil != err {
-//			log.Self.Error("raft", log.Error(err))
-//			return
-//		}
-//	}
-//}
+// hardState 持久化的任期及投票信息
+type hardState struct {
+	Term      int32  `yaml:"term"`
+	LeaderID  string `yaml:"leaderID"`
+	VotedFor  string `yaml:"votedFor"`
+	VotedTerm int32  `yaml:"votedTerm"`
+	VotedTime int64  `yaml:"votedTime"`
+}
+
+// configState 持久化的配置集合及其已应用的日志索引和任期，即配置快照
+type configState struct {
+	Version int32                     `yaml:"version"`
+	Term    int32                     `yaml:"term"`
+	Configs map[string]*config.Config `yaml:"configs"`
+	Nodes   []*nodeState              `yaml:"nodes,omitempty"`
+}
+
+// nodeState 持久化的集群成员
+type nodeState struct {
+	ID  string `yaml:"id"`
+	URL string `yaml:"url"`
+}
+
+// logState 持久化的配置操作日志，prevIndex及prevTerm为已压缩至快照的最后一条日志的索引和任期
+type logState struct {
+	PrevIndex int32       `yaml:"prevIndex"`
+	PrevTerm  int32       `yaml:"prevTerm"`
+	Entries   []*logEntry `yaml:"entries"`
+}
+
+// logEntry 持久化的配置操作日志
+type logEntry struct {
+	Index     int32        `yaml:"index"`
+	Term      int32        `yaml:"term"`
+	Type      int32        `yaml:"type"`
+	ConfigID  string       `yaml:"configID,omitempty"`
+	Data      string       `yaml:"data,omitempty"`
+	ConfigIDs []string     `yaml:"configIDs,omitempty"`
+	Nodes     []*nodeState `yaml:"nodes,omitempty"`
+}
+
+// saveState 持久化服务器任期、Leader及投票信息，须在响应RPC请求之前调用，持久化失败时不得响应该请求
+func (p *persistence) saveState(term int32) error {
+	state := &hardState{
+		Term:      term,
+		LeaderID:  p.leaderID,
+		VotedFor:  p.votedFor.id,
+		VotedTerm: p.votedFor.term,
+		VotedTime: p.votedFor.timestamp,
+	}
+	if err := p.save(stateFileName, state); nil != err {
+		gnomon.Log().Error("raft", gnomon.Log().Field("save state", state), gnomon.Log().Err(err))
+		return err
+	}
+	return nil
+}
+
+// saveConfigs 持久化当前配置集合、集群成员及已应用的日志索引和任期
+func (p *persistence) saveConfigs(version, term int32, configs map[string]config.Config, members []*Node) error {
+	state := &configState{Version: version, Term: term, Configs: map[string]*config.Config{}, Nodes: toNodeStates(members)}
+	for configID := range configs {
+		conf := configs[configID]
+		state.Configs[configID] = &conf
+	}
+	if err := p.save(configsFileName, state); nil != err {
+		gnomon.Log().Error("raft", gnomon.Log().Field("save configs version", version), gnomon.Log().Err(err))
+		return err
+	}
+	return nil
+}
+
+// saveLog 重写整个配置操作日志文件，entries[0]为已压缩至快照的最后一条日志，仅在压缩、安装快照或覆盖日志后调用
+func (p *persistence) saveLog(entries []*Entry) error {
+	ls := &logState{PrevIndex: entries[0].Index, PrevTerm: entries[0].Term, Entries: toLogEntries(entries[1:])}
+	err := p.save(logFileName, ls)
+	p.logDirty = nil != err
+	if nil != err {
+		gnomon.Log().Error("raft", gnomon.Log().Field("save log", len(entries)), gnomon.Log().Err(err))
+	}
+	return err
+}
+
+// appendLog 将entries[from:]追加至配置操作日志文件，须在响应RPC请求之前调用
 //
-//func (p *persistence) fileClose(f *os.File) {
-//	if err := f.Close(); nil != err {
-//		log.Self.Error("raft", log.Error(err))
-//		return
-//	}
-//}
+// 日志文件不存在或与内存日志不一致时重写整个文件
+func (p *persistence) appendLog(entries []*Entry, from int) error {
+	if p.path == "" {
+		return nil
+	}
+	if p.logDirty || !gnomon.File().PathExists(filepath.Join(p.path, logFileName)) {
+		return p.saveLog(entries)
+	}
+	data, err := yaml.Marshal(toLogEntries(entries[from:]))
+	if nil != err {
+		return err
+	}
+	data = bytes.Join([][]byte{logDocStart[1:], data, logDocEnd[1:]}, nil)
+	if err = p.appendFile(logFileName, data); nil != err {
+		p.logDirty = true
+		gnomon.Log().Error("raft", gnomon.Log().Field("append log", len(entries)-from), gnomon.Log().Err(err))
+	}
+	return err
+}
+
+// loadState 读取持久化的任期及投票信息，返回服务器任期
+func (p *persistence) loadState() (int32, error) {
+	state := &hardState{}
+	if ok, err := p.read(stateFileName, state); nil != err || !ok {
+		return 0, err
+	}
+	p.leaderID = state.LeaderID
+	p.votedFor.id = state.VotedFor
+	p.votedFor.term = state.VotedTerm
+	p.votedFor.timestamp = state.VotedTime
+	return state.Term, nil
+}
+
+// loadConfigs 读取持久化的配置集合及其已应用的日志索引和任期，文件不存在时返回空配置集合
+func (p *persistence) loadConfigs() (*configState, error) {
+	cs := &configState{}
+	if _, err := p.read(configsFileName, cs); nil != err {
+		return nil, err
+	}
+	return cs, nil
+}
+
+// loadLog 读取持久化的配置操作日志，entries[0]为已压缩至快照的最后一条日志
 //
-//func (p *persistence) fileStorage() (filePath string, line int32) {
-//	mod := p.version % 1000
-//	return strings.Join(
-//		[]string{
-//			logPathLocal,
-//			"/",
-//			strconv.Itoa(int(p.currentTerm)),
-//			"/",
-//			strconv.Itoa(int(mod + 1)),
-//			".raft",
-//		},
-//		"",
-//	), p.version - mod*1000
-//}
+// 日志文件由重写的日志及其后追加的若干批日志组成，最后一批日志写入中断时丢弃该批日志
+func (p *persistence) loadLog() ([]*Entry, error) {
+	ls := &logState{}
+	data, err := p.readFile(logFileName)
+	if nil != err {
+		return nil, err
+	}
+	if start := bytes.Index(data, logDocStart); start >= 0 {
+		size := start + 1
+		if end := bytes.LastIndex(data, logDocEnd); end > start {
+			size = end + len(logDocEnd)
+		}
+		if size < len(data) {
+			gnomon.Log().Warn("raft", gnomon.Log().Field("load log", "discard incomplete entries"), gnomon.Log().Field("size", len(data)-size))
+			data = data[:size]
+			p.logDirty = true
+		}
+	}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	if err = decoder.Decode(ls); nil != err && err != io.EOF {
+		return nil, err
+	}
+	for {
+		var les []*logEntry
+		if err = decoder.Decode(&les); err == io.EOF {
+			break
+		} else if nil != err {
+			return nil, err
+		}
+		ls.Entries = append(ls.Entries, les...)
+	}
+	entries := make([]*Entry, len(ls.Entries)+1)
+	entries[0] = &Entry{Index: ls.PrevIndex, Term: ls.PrevTerm}
+	for i, le := range ls.Entries {
+		entries[i+1] = &Entry{Index: le.Index, Term: le.Term, Type: EntryType(le.Type), ConfigID: le.ConfigID,
+			Data: []byte(le.Data), ConfigIDs: le.ConfigIDs, Nodes: toNodes(le.Nodes)}
+	}
+	return entries, nil
+}
+
+func toLogEntries(entries []*Entry) []*logEntry {
+	les := make([]*logEntry, len(entries))
+	for i, entry := range entries {
+		les[i] = &logEntry{Index: entry.Index, Term: entry.Term, Type: int32(entry.Type), ConfigID: entry.ConfigID,
+			Data: string(entry.Data), ConfigIDs: entry.ConfigIDs, Nodes: toNodeStates(entry.Nodes)}
+	}
+	return les
+}
+
+func toNodeStates(nodes []*Node) []*nodeState {
+	var nss []*nodeState
+	for _, node := range nodes {
+		nss = append(nss, &nodeState{ID: node.Id, URL: node.Url})
+	}
+	return nss
+}
+
+func toNodes(nss []*nodeState) []*Node {
+	var nodes []*Node
+	for _, ns := range nss {
+		nodes = append(nodes, &Node{Id: ns.ID, Url: ns.URL})
+	}
+	return nodes
+}
+
+// save 将数据写入临时文件并同步至磁盘后重命名，避免写入中断导致文件损坏
+func (p *persistence) save(fileName string, in interface{}) error {
+	if p.path == "" {
+		return nil
+	}
+	data, err := yaml.Marshal(in)
+	if nil != err {
+		return err
+	}
+	defer p.lock.Unlock()
+	p.lock.Lock()
+	if err = os.MkdirAll(p.path, 0755); nil != err {
+		return err
+	}
+	filePath := filepath.Join(p.path, fileName)
+	tmpFilePath := filePath + ".tmp"
+	f, err := os.OpenFile(tmpFilePath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0644)
+	if nil != err {
+		return err
+	}
+	if _, err = f.Write(data); nil == err {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); nil == err {
+		err = closeErr
+	}
+	if nil != err {
+		return err
+	}
+	return os.Rename(tmpFilePath, filePath)
+}
+
+// appendFile 将数据追加至文件末尾并同步至磁盘
+func (p *persistence) appendFile(fileName string, data []byte) error {
+	defer p.lock.Unlock()
+	p.lock.Lock()
+	f, err := os.OpenFile(filepath.Join(p.path, fileName), os.O_WRONLY|os.O_APPEND, 0644)
+	if nil != err {
+		return err
+	}
+	if _, err = f.Write(data); nil == err {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); nil == err {
+		err = closeErr
+	}
+	return err
+}
+
+func (p *persistence) read(fileName string, out interface{}) (bool, error) {
+	data, err := p.readFile(fileName)
+	if nil != err || nil == data {
+		return false, err
+	}
+	return true, yaml.Unmarshal(data, out)
+}
+
+// readFile 读取持久化文件，未持久化或文件不存在时返回nil
+func (p *persistence) readFile(fileName string) ([]byte, error) {
+	if p.path == "" {
+		return nil, nil
+	}
+	filePath := filepath.Join(p.path, fileName)
+	if !gnomon.File().PathExists(filePath) {
+		return nil, nil
+	}
+	return ioutil.ReadFile(filePath)
+}
@@ -0,0 +1,124 @@
+/*
+ * Copyright (c) 2019. Aberic - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rafts
+
+import (
+	"github.com/aberic/fabric-client/config"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPersistence(t *testing.T) {
+	tmpPath, err := ioutil.TempDir("", "raft")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.RemoveAll(tmpPath) }()
+	p := &persistence{path: tmpPath, votedFor: &votedFor{}}
+	if term, err := p.loadState(); nil != err || term != 0 {
+		t.Fatalf("empty persistence should load nothing, got %d %v", term, err)
+	}
+	p.leaderID = "2"
+	p.votedFor = &votedFor{id: "2", term: 4, timestamp: 100}
+	p.saveState(4)
+	conf := config.Config{}
+	conf.AddOrSetOrgForOrganizations("Org1", "Org1MSP", "/tmp/crypto", nil, nil, nil)
+	p.saveConfigs(5, 4, map[string]config.Config{"conf1": conf}, []*Node{{Id: "1", Url: "127.0.0.1:19877"}})
+	p.saveLog([]*Entry{{Index: 3, Term: 1}, {Index: 4, Term: 1}, {Index: 5, Term: 4, Type: EntryType_Recover, ConfigIDs: []string{"conf1"}}})
+
+	restart := &persistence{path: tmpPath, votedFor: &votedFor{}}
+	term, err := restart.loadState()
+	if nil != err {
+		t.Fatal(err)
+	}
+	if term != 4 || restart.leaderID != "2" {
+		t.Errorf("state should be restored, got term %d leader %s", term, restart.leaderID)
+	}
+	if restart.votedFor.id != "2" || restart.votedFor.term != 4 || restart.votedFor.timestamp != 100 {
+		t.Errorf("votedFor should be restored, got %+v", restart.votedFor)
+	}
+	cs, err := restart.loadConfigs()
+	if nil != err {
+		t.Fatal(err)
+	}
+	if cs.Version != 5 || cs.Term != 4 || len(cs.Nodes) != 1 || cs.Nodes[0].URL != "127.0.0.1:19877" || nil == cs.Configs["conf1"] || nil == cs.Configs["conf1"].Organizations["Org1"] {
+		t.Errorf("configs should be restored, got %d/%d %v", cs.Version, cs.Term, cs.Configs)
+	}
+	entries, err := restart.loadLog()
+	if nil != err {
+		t.Fatal(err)
+	}
+	if len(entries) != 3 || entries[0].Index != 3 || entries[2].Term != 4 || entries[2].Type != EntryType_Recover ||
+		entries[2].ConfigIDs[0] != "conf1" {
+		t.Errorf("log should be restored, got %v", entries)
+	}
+	if l := newRaftLog(restart, serviceStore{}, entries, cs.Version, cs.Term, nil); l.entries[0].Index != 3 || l.lastApplied != 5 {
+		t.Errorf("log consistent with snapshot should be kept, got %v", l.entries)
+	}
+	if l := newRaftLog(restart, serviceStore{}, entries, 7, 5, nil); len(l.entries) != 1 || l.entries[0].Index != 7 || l.commitIndex != 7 {
+		t.Errorf("log older than snapshot should be reset, got %v", l.entries)
+	}
+}
+
+func TestPersistence_AppendLog(t *testing.T) {
+	tmpPath, err := ioutil.TempDir("", "raft")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.RemoveAll(tmpPath) }()
+	p := &persistence{path: tmpPath, votedFor: &votedFor{}}
+	entries := []*Entry{{Index: 3, Term: 1}, {Index: 4, Term: 1}}
+	if err = p.appendLog(entries, 1); nil != err {
+		t.Fatal(err)
+	}
+	entries = append(entries, &Entry{Index: 5, Term: 2, Type: EntryType_Delete, ConfigID: "conf1"}, &Entry{Index: 6, Term: 2})
+	if err = p.appendLog(entries, 2); nil != err {
+		t.Fatal(err)
+	}
+	loaded, err := p.loadLog()
+	if nil != err {
+		t.Fatal(err)
+	}
+	if len(loaded) != 4 || loaded[0].Index != 3 || loaded[2].ConfigID != "conf1" || loaded[3].Index != 6 {
+		t.Fatalf("appended log should be restored, got %v", loaded)
+	}
+
+	// 最后一批日志写入中断时丢弃该批日志，下次持久化时重写整个文件
+	if err = p.appendFile(logFileName, []byte("---\n- index: 7\n  term: 2\n")); nil != err {
+		t.Fatal(err)
+	}
+	restart := &persistence{path: tmpPath, votedFor: &votedFor{}}
+	if loaded, err = restart.loadLog(); nil != err || len(loaded) != 4 || !restart.logDirty {
+		t.Fatalf("incomplete entries should be discarded, got %v %v", loaded, err)
+	}
+	loaded = append(loaded, &Entry{Index: 7, Term: 3})
+	if err = restart.appendLog(loaded, 4); nil != err || restart.logDirty {
+		t.Fatalf("dirty log should be rewritten, got %v", err)
+	}
+	if loaded, err = restart.loadLog(); nil != err || len(loaded) != 5 || loaded[4].Term != 3 {
+		t.Errorf("rewritten log should be restored, got %v %v", loaded, err)
+	}
+
+	// 持久化目录不可写时返回错误
+	broken := &persistence{path: filepath.Join(tmpPath, logFileName), votedFor: &votedFor{}}
+	if err = broken.saveState(1); nil == err {
+		t.Error("save state should fail when persistence path is not a directory")
+	}
+	if err = broken.appendLog(loaded, 4); nil == err || !broken.logDirty {
+		t.Error("append log should fail when persistence path is not a directory")
+	}
+}
@@ -15,7 +15,13 @@
 package rafts
 
 import (
+	"errors"
+	"github.com/aberic/fabric-client/config"
 	"github.com/aberic/gnomon"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v3"
+	"math/rand"
 	"strings"
 	"sync"
 	"time"
@@ -24,21 +30,67 @@ import (
 // Raft 接收客户端提交的同步内容，被封装在自定义的方法中
 //
 // 也返回客户端期望的同步结果及从其他节点同步过来的信息
+//
+// lock串行化角色转换及任期、投票、Leader信息的变更，持有期间不发起节点间通信，避免节点间相互等待
 type Raft struct {
+	// 角色及任期、投票、Leader信息变更锁
+	lock sync.Mutex
 	// 服务器的任期，初始为0，递增
 	term int32
 	// 自身节点信息
 	self *Node
-	// 当前Raft可见节点集合
+	// 除自身外的集群成员
 	nodes []*Node
+	// 自身是否为集群成员，非成员不参与选举
+	member bool
+	// 除集群成员外允许变更成员及查询状态的管理员证书CommonName
+	admins []string
+	// 集群成员变更锁
+	nodesLock sync.RWMutex
+	// 确保同一时间只发起一个集群成员变更
+	changeLock sync.Mutex
 	// persistence 所有角色都拥有的持久化的状态（在响应RPC请求之前变更且持久化的状态）
 	persistence *persistence
+	// 配置操作日志
+	log *raftLog
 	// 自身节点角色状态
 	role roleChange
 	// Raft任务调度服务
 	scheduled *scheduled
-	// 确保Raft的启动方法只会被调用一次
-	once sync.Once
+	// 节点间通信
+	transport transport
+	// 时钟
+	clock clock
+	// 已提交的配置操作日志应用至该配置集合
+	store store
+	// 异步任务计数
+	tasks *tasks
+}
+
+// options Raft实例配置
+type options struct {
+	// 自身节点信息
+	self *Node
+	// 除自身外的集群成员
+	nodes []*Node
+	// 是否以集群成员身份启动，非成员待Leader通过AddNode提交后才参与选举
+	member bool
+	// 除集群成员外允许变更成员及查询状态的管理员证书CommonName
+	admins []string
+	// 任期、投票、配置集合及配置操作日志持久化目录，为空时仅保存在内存中
+	dataPath string
+	// 已应用的配置操作日志数超过该值时压缩至快照
+	snapshotEntries int32
+	// 节点间通信
+	transport transport
+	// 时钟
+	clock clock
+	// 选举超时随机数
+	random *rand.Rand
+	// 配置集合
+	store store
+	// 异步任务计数，集群内的节点可共用
+	tasks *tasks
 }
 
 // roleChange 角色转换接口
@@ -69,50 +121,65 @@ const (
 	K8S      = "K8S"          // K8S=true
 	BrokerID = "BROKER_ID"    // BROKER_ID=1
 	nodeAddr = "NODE_ADDRESS" // NODE_ADDRESS=example.com NODE_ADDRESS=127.0.0.1
+	// RAFT_DATA_PATH=/home/data/raft 任期、投票、配置集合及配置操作日志持久化目录
+	raftDataPath = "RAFT_DATA_PATH"
+	// RAFT_SNAPSHOT_ENTRIES=1000 已应用的配置操作日志数超过该值时压缩至快照
+	raftSnapshotEntries = "RAFT_SNAPSHOT_ENTRIES"
+	// RAFT_JOIN=true 以非成员身份启动，待Leader通过AddNode提交后才参与选举
+	raftJoin = "RAFT_JOIN"
+	// RAFT_ADMINS=admin1,admin2 启用mTLS时除集群成员外允许调用AddNode、RemoveNode、Status及ReadIndex的证书CommonName
+	raftAdmins = "RAFT_ADMINS"
 	// CLUSTER=1=127.0.0.1:19865:19877,2=127.0.0.2:19865:19877,3=127.0.0.3:19865:19877
 	cluster = "CLUSTER"
+	// proposeTimeout 配置操作日志等待应用的超时时间
+	proposeTimeout = 5 * time.Second
+	// readIndexTimeout 线性一致读等待配置集合应用的超时时间
+	readIndexTimeout = 5 * time.Second
 )
 
+var (
+	// ErrNotLeader 自身不是Leader节点，无法提交配置操作
+	ErrNotLeader = status.Error(codes.FailedPrecondition, "raft node is not leader")
+	// ErrNoLeader 等待集群选举出Leader超时
+	ErrNoLeader = status.Error(codes.Unavailable, "raft cluster has no leader")
+)
+
+// LeaderChanged 判断配置操作是否因Leader变更未被提交，可向新的Leader重新提交
+func LeaderChanged(err error) bool {
+	code := status.Code(err)
+	return code == codes.FailedPrecondition || code == codes.Aborted
+}
+
 var (
 	// instance Raft 实例
 	instance *Raft
 	// once 确保Raft的启动方法只会被调用一次
-	once  sync.Once
-	self  *Node
-	nodes []*Node
+	once sync.Once
 )
 
-func init() {
-	// 仅测试用
-	//_ = os.Setenv(BrokerID, "1")
-	//_ = os.Setenv(nodeAddr, "127.0.0.1:19880")
-	//_ = os.Setenv(cluster, "1=127.0.0.1:19877,2=127.0.0.1:19878,3=127.0.0.1:19879")
-
-	self = &Node{}
+// envOptions 由环境变量读取Raft配置，未组网时返回nil
+func envOptions() *options {
+	self := &Node{}
 	if k8s := gnomon.Env().GetBool(K8S); k8s {
 		if self.Url = gnomon.Env().Get("HOSTNAME"); gnomon.String().IsEmpty(self.Url) {
 			gnomon.Log().Info("raft k8s fail", gnomon.Log().Field("addr", self.Url))
-			return
+			return nil
 		}
 		gnomon.Log().Info("raft k8s", gnomon.Log().Field("addr", self.Url))
 		self.Id = strings.Split(self.Url, "-")[1]
 		gnomon.Log().Info("raft k8s", gnomon.Log().Field("id", self.Id))
 	} else {
 		if self.Url = gnomon.Env().Get(nodeAddr); gnomon.String().IsEmpty(self.Url) {
-			return
+			return nil
 		}
 		if self.Id = gnomon.Env().Get(BrokerID); gnomon.String().IsEmpty(self.Id) {
 			gnomon.Log().Error("raft", gnomon.Log().Field("note", "broker id is not appoint"))
-			return
+			return nil
 		}
 	}
-	nodesStr := gnomon.Env().Get(cluster)
-	if gnomon.String().IsEmpty(nodesStr) {
-		nodes = make([]*Node, 0)
-	} else {
-		clusterArr := strings.Split(nodesStr, ",")
-		nodes = make([]*Node, 0)
-		for _, cluster := range clusterArr {
+	nodes := make([]*Node, 0)
+	if nodesStr := gnomon.Env().Get(cluster); gnomon.String().IsNotEmpty(nodesStr) {
+		for _, cluster := range strings.Split(nodesStr, ",") {
 			clusterSplit := strings.Split(cluster, "=")
 			id := clusterSplit[0]
 			if gnomon.String().IsEmpty(id) {
@@ -122,13 +189,29 @@ func init() {
 			if id == self.Id {
 				continue
 			}
-			nodeUrl := clusterSplit[1]
 			nodes = append(nodes, &Node{
 				Id:  id,
-				Url: nodeUrl,
+				Url: clusterSplit[1],
 			})
 		}
 	}
+	var admins []string
+	if adminsStr := gnomon.Env().Get(raftAdmins); gnomon.String().IsNotEmpty(adminsStr) {
+		admins = strings.Split(adminsStr, ",")
+	}
+	return &options{
+		self:            self,
+		nodes:           nodes,
+		member:          !gnomon.Env().GetBool(raftJoin),
+		admins:          admins,
+		dataPath:        gnomon.Env().GetD(raftDataPath, "data/raft"),
+		snapshotEntries: int32(gnomon.Env().GetIntD(raftSnapshotEntries, defaultSnapshotEntries)),
+		transport:       rpcTransport{},
+		clock:           realClock{},
+		random:          rand.New(rand.NewSource(time.Now().UnixNano())),
+		store:           serviceStore{},
+		tasks:           &tasks{},
+	}
 }
 
 func NewRaft() {
@@ -136,70 +219,211 @@ func NewRaft() {
 	_ = obtainRaft()
 }
 
+// obtainRaft 获取由环境变量配置的Raft实例，未组网时返回单节点实例
 func obtainRaft() *Raft {
 	once.Do(func() {
-		instance = &Raft{}
-		instance.start()
+		opts := envOptions()
+		if nil == opts {
+			gnomon.Log().Info("raft", gnomon.Log().Field("initRaft", "未组网或参数配置有误，raft集群无法启动"))
+			instance = &Raft{store: serviceStore{}}
+			return
+		}
+		instance = newRaft(opts)
+		instance.scheduled.start()
 	})
 	return instance
 }
 
-// Start Raft启用方法
-func (r *Raft) start() {
-	r.once.Do(func() {
-		r.initRaft(self, nodes)
-	})
-}
-
-// initRaft Raft初始化
-func (r *Raft) initRaft(self *Node, nodes []*Node) {
+// newRaft 初始化Raft，恢复持久化的状态后以Follower角色启动，定时任务由调用方启动
+func newRaft(opts *options) *Raft {
 	gnomon.Log().Info("raft", gnomon.Log().Field("initRaft", "初始化Raft"))
-	if nil == self || nil == nodes {
-		gnomon.Log().Info("raft", gnomon.Log().Field("initRaft", "未组网或参数配置有误，raft集群无法启动"))
-		return
+	r := &Raft{
+		term:      0,
+		self:      opts.self,
+		nodes:     opts.nodes,
+		member:    opts.member,
+		admins:    opts.admins,
+		transport: opts.transport,
+		clock:     opts.clock,
+		store:     opts.store,
+		tasks:     opts.tasks,
 	}
-	r.term = 0
-	r.self = self
-	r.nodes = nodes
 	r.persistence = &persistence{
-		currentTerm: r.term,
 		votedFor: &votedFor{
 			id:        "",
 			term:      0,
-			timestamp: time.Now().UnixNano(),
+			timestamp: r.clock.now().UnixNano(),
 		},
-		version: 0,
+		path: opts.dataPath,
 	}
-	r.role = &follower{raft: r}
+	r.restore(opts.snapshotEntries)
 	r.scheduled = &scheduled{
-		raft: r,
+		raft:   r,
+		random: opts.random,
 	}
+	r.role = &follower{raft: r}
 	r.role.become(r)
-	r.scheduled.start()
+	return r
 }
 
-func (r *Raft) appendNode(node *Node) {
-	r.nodes = append(r.nodes, node)
+// restore 重启后恢复任期、投票、配置集合及配置操作日志，避免以任期0及空配置重新加入集群
+func (r *Raft) restore(snapshotEntries int32) {
+	var (
+		entries []*Entry
+		cs      *configState
+		err     error
+	)
+	if r.term, err = r.persistence.loadState(); nil != err {
+		gnomon.Log().Error("raft", gnomon.Log().Field("restore", "state"), gnomon.Log().Err(err))
+	}
+	if cs, err = r.persistence.loadConfigs(); nil != err {
+		gnomon.Log().Error("raft", gnomon.Log().Field("restore", "configs"), gnomon.Log().Err(err))
+		cs = &configState{}
+	} else if nil != cs.Configs {
+		r.store.reset(cs.Configs)
+	}
+	if entries, err = r.persistence.loadLog(); nil != err {
+		gnomon.Log().Error("raft", gnomon.Log().Field("restore", "log"), gnomon.Log().Err(err))
+	}
+	// 已提交过成员变更时以持久化的集群成员为准，否则沿用CLUSTER配置
+	members := toNodes(cs.Nodes)
+	if len(members) > 0 {
+		r.setMembers(members)
+	}
+	r.log = newRaftLog(r.persistence, r.store, entries, cs.Version, cs.Term, members)
+	r.log.onMembers = r.setMembers
+	r.log.snapshotEntries = snapshotEntries
+	gnomon.Log().Info("raft", gnomon.Log().Field("term", r.term), gnomon.Log().Field("lastApplied", cs.Version),
+		gnomon.Log().Field("entries", len(r.log.entries)-1))
 }
 
-func (r *Raft) release() {
-	r.scheduled.tickerEnd <- 1
-	r.scheduled.checkRelease <- 1
+// Character 获取自身节点角色，未组网时单节点自身即为Leader
+func Character() int {
+	return obtainRaft().character()
 }
 
-func Character() int {
-	return obtainRaft().role.role()
+func (r *Raft) character() int {
+	defer r.lock.Unlock()
+	r.lock.Lock()
+	if nil != r.role {
+		return r.role.role()
+	}
+	return RoleLeader
 }
 
+// LeaderURL 获取Leader节点地址，自身为Leader或Leader未知时返回空
 func LeaderURL() string {
-	for _, node := range obtainRaft().nodes {
-		if node.Id == obtainRaft().persistence.leaderID {
-			return node.Url
-		}
+	return obtainRaft().leaderURL()
+}
+
+func (r *Raft) leaderURL() string {
+	if node := r.leader(); nil != node {
+		return node.Url
 	}
 	return ""
 }
 
-func VersionAdd() {
-	obtainRaft().persistence.version++
+// leader 获取除自身外的Leader节点
+func (r *Raft) leader() *Node {
+	r.lock.Lock()
+	leaderID := r.persistence.leaderID
+	r.lock.Unlock()
+	for _, node := range r.others() {
+		if node.Id == leaderID {
+			return node
+		}
+	}
+	return nil
+}
+
+// ProposeConfig 提交新增或覆盖配置操作
+func ProposeConfig(configID string, conf *config.Config) error {
+	data, err := yaml.Marshal(conf)
+	if nil != err {
+		return err
+	}
+	return obtainRaft().propose(&Entry{Type: EntryType_Init, ConfigID: configID, Data: data})
+}
+
+// ProposeRecover 提交仅保留configIDs中配置的操作
+func ProposeRecover(configIDs []string) error {
+	return obtainRaft().propose(&Entry{Type: EntryType_Recover, ConfigIDs: configIDs})
+}
+
+// ProposeDelete 提交删除配置操作
+func ProposeDelete(configID string) error {
+	return obtainRaft().propose(&Entry{Type: EntryType_Delete, ConfigID: configID})
+}
+
+// propose Leader节点追加配置操作日志，待日志复制到大多数节点并应用至配置集合后返回，未组网时直接应用
+func (r *Raft) propose(entry *Entry) error {
+	r.lock.Lock()
+	if nil == r.role {
+		r.lock.Unlock()
+		return applyEntry(r.store, entry)
+	}
+	l, ok := r.role.(*leader)
+	if !ok {
+		r.lock.Unlock()
+		return ErrNotLeader
+	}
+	wait, err := r.log.append(l.term, entry)
+	r.lock.Unlock()
+	if nil != err {
+		return err
+	}
+	l.advanceCommit()
+	r.tasks.spawn(l.sendHeartbeats)
+	select {
+	case err := <-wait:
+		return err
+	case <-r.clock.after(proposeTimeout):
+		r.log.cancel(entry.Index)
+		return errors.New("raft propose timeout")
+	}
+}
+
+// ReadIndex 线性一致读，返回后本节点的配置集合不落后于调用时集群已提交的配置，未组网时直接返回
+//
+// Leader节点向大多数成员确认自身仍为Leader后取当前提交索引，Follower节点向Leader节点获取提交索引，均等待本节点应用至该索引
+func ReadIndex() error {
+	return obtainRaft().readIndex()
+}
+
+func (r *Raft) readIndex() error {
+	var (
+		index int32
+		err   error
+	)
+	r.lock.Lock()
+	role := r.role
+	r.lock.Unlock()
+	if nil == role {
+		return nil
+	}
+	switch role := role.(type) {
+	case *leader:
+		index, err = role.readIndex()
+	case *follower:
+		index, err = r.leaderReadIndex()
+	default:
+		err = ErrNoLeader
+	}
+	if nil != err {
+		return err
+	}
+	return r.log.waitApplied(index, r.clock.after(readIndexTimeout))
+}
+
+// leaderReadIndex 向Leader节点获取线性一致读的提交索引
+func (r *Raft) leaderReadIndex() (int32, error) {
+	node := r.leader()
+	if nil == node {
+		return 0, ErrNoLeader
+	}
+	rir, err := r.transport.readIndex(node)
+	if nil != err {
+		return 0, err
+	}
+	return rir.Index, nil
 }
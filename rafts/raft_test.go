@@ -0,0 +1,516 @@
+/*
+ * Copyright (c) 2019. Aberic - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rafts
+
+import (
+	"github.com/aberic/fabric-client/config"
+	"gopkg.in/yaml.v3"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClusterElection(t *testing.T) {
+	c := newCluster(t, 3, defaultSnapshotEntries)
+	defer c.close()
+	c.elect("1")
+	c.run(10 * time.Second)
+	if leaders := c.leaders(); !reflect.DeepEqual(leaders, []string{"1"}) {
+		t.Fatalf("leader should stay 1, got %v", leaders)
+	}
+	for _, id := range c.ids {
+		if r := c.node(id); r.term != 1 || r.persistence.leaderID != "1" {
+			t.Errorf("node %s should follow leader 1 in term 1, got leader %s term %d", id, r.persistence.leaderID, r.term)
+		}
+	}
+
+	// Leader停止后剩余节点重新选举，重启的旧Leader以Follower身份加入
+	c.stop("1")
+	c.run(5 * time.Second)
+	leaders := c.leaders()
+	if len(leaders) != 1 || leaders[0] == "1" {
+		t.Fatalf("one of remaining nodes should be elected after leader stopped, got %v", leaders)
+	}
+	c.start("1")
+	c.run(time.Second)
+	if r := c.node("1"); r.character() != RoleFollower || r.term != 2 || r.persistence.leaderID != leaders[0] {
+		t.Errorf("restarted node 1 should follow leader %s in term 2, got role %d leader %s term %d",
+			leaders[0], r.character(), r.persistence.leaderID, r.term)
+	}
+}
+
+func TestClusterSplitVote(t *testing.T) {
+	c := newCluster(t, 4, defaultSnapshotEntries)
+	defer c.close()
+	// 预投票均通过，投票时1只获得3的选票，2只获得4的选票
+	c.delay("1", "2", time.Second, isVote)
+	c.delay("1", "4", time.Second, isVote)
+	c.delay("2", "1", time.Second, isVote)
+	c.delay("2", "3", time.Second, isVote)
+	c.advance(2 * timeout * time.Millisecond)
+	c.tick("1", "2")
+	if leaders := c.leaders(); len(leaders) != 0 {
+		t.Fatalf("split vote should elect no leader, got %v", leaders)
+	}
+	for _, id := range []string{"1", "2"} {
+		if term := c.node(id).term; term != 1 {
+			t.Errorf("candidate %s should be in term 1, got %d", id, term)
+		}
+	}
+	// 延迟到达的投票请求不能改变已投出的选票
+	c.heal()
+	c.advance(time.Second)
+	if leaders := c.leaders(); len(leaders) != 0 {
+		t.Fatalf("delayed vote requests should elect no leader, got %v", leaders)
+	}
+
+	c.elect("1")
+	c.run(5 * time.Second)
+	if leaders := c.leaders(); !reflect.DeepEqual(leaders, []string{"1"}) {
+		t.Fatalf("node 1 should be elected in next term, got %v", leaders)
+	}
+	for _, id := range c.ids {
+		if term := c.node(id).term; term != 2 {
+			t.Errorf("node %s should be in term 2, got %d", id, term)
+		}
+	}
+}
+
+func TestClusterPreVote(t *testing.T) {
+	c := newCluster(t, 3, defaultSnapshotEntries)
+	defer c.close()
+	c.elect("1")
+
+	// 被隔离的节点反复选举超时，预投票无法通过，不会增加任期
+	c.partition([]string{"3"})
+	c.run(10 * time.Second)
+	if term := c.node("3").term; term != 1 {
+		t.Errorf("partitioned node should not increase term, got %d", term)
+	}
+	c.heal()
+	c.run(2 * time.Second)
+	if leaders := c.leaders(); !reflect.DeepEqual(leaders, []string{"1"}) {
+		t.Fatalf("rejoined node should not disrupt leader 1, got %v", leaders)
+	}
+	for _, id := range c.ids {
+		if term := c.node(id).term; term != 1 {
+			t.Errorf("node %s should stay in term 1, got %d", id, term)
+		}
+	}
+
+	// Leader有效期间拒绝更高任期的投票请求，Leader不因同一任期的心跳下台
+	lastLogIndex, lastLogTerm := c.node("3").log.lastIndexAndTerm()
+	for _, preVote := range []bool{true, false} {
+		rv := &ReqVote{Term: 5, CandidateId: "3", LastLogIndex: lastLogIndex, LastLogTerm: lastLogTerm, PreVote: preVote}
+		for _, id := range []string{"1", "2"} {
+			if c.node(id).requestVote(rv).VoteGranted {
+				t.Errorf("node %s should refuse vote request while leader is alive, preVote %v", id, preVote)
+			}
+		}
+	}
+	if votedFor := c.node("2").persistence.votedFor; votedFor.term != 1 {
+		t.Errorf("refused vote request should not change vote, got term %d", votedFor.term)
+	}
+	if hbr, _ := c.node("1").heartbeat(&HBeat{Term: 1, LeaderId: "2"}); hbr.Success || c.node("1").character() != RoleLeader {
+		t.Error("leader should refuse heartbeat of same term and stay leader")
+	}
+}
+
+func TestClusterVoteTerm(t *testing.T) {
+	c := newCluster(t, 3, defaultSnapshotEntries)
+	defer c.close()
+	c.elect("1")
+	c.stop("1")
+	c.advance(2 * timeout * time.Millisecond)
+
+	// 预投票不改变任期，更高任期的投票请求即使被拒绝也使自身更新任期并持久化
+	lastLogIndex, lastLogTerm := c.node("2").log.lastIndexAndTerm()
+	if rvr := c.node("2").requestVote(&ReqVote{Term: 3, CandidateId: "3", LastLogIndex: lastLogIndex, LastLogTerm: lastLogTerm,
+		PreVote: true}); !rvr.VoteGranted || rvr.Term != 1 {
+		t.Errorf("pre vote should be granted without changing term, got %v term %d", rvr.VoteGranted, rvr.Term)
+	}
+	if rvr := c.node("2").requestVote(&ReqVote{Term: 2, CandidateId: "1"}); rvr.VoteGranted || rvr.Term != 2 {
+		t.Errorf("out of date candidate should be refused in term 2, got %v term %d", rvr.VoteGranted, rvr.Term)
+	}
+	if rvr := c.node("2").requestVote(&ReqVote{Term: 3, CandidateId: "3", LastLogIndex: lastLogIndex,
+		LastLogTerm: lastLogTerm}); !rvr.VoteGranted || rvr.Term != 3 {
+		t.Errorf("vote should be granted in term 3, got %v term %d", rvr.VoteGranted, rvr.Term)
+	}
+	c.restart("2")
+	if r := c.node("2"); r.term != 3 || r.persistence.votedFor.id != "3" || r.persistence.votedFor.term != 3 {
+		t.Fatalf("term and vote should be persisted, got term %d vote %+v", r.term, r.persistence.votedFor)
+	}
+
+	// 选举超时后以更新后的任期发起选举，不会在已投票的任期再次投票
+	if rvr := c.node("2").requestVote(&ReqVote{Term: 3, CandidateId: "1", LastLogIndex: lastLogIndex,
+		LastLogTerm: lastLogTerm}); rvr.VoteGranted {
+		t.Error("node 2 should not vote twice in term 3")
+	}
+	c.elect("2")
+	if leaders := c.leaders(); !reflect.DeepEqual(leaders, []string{"2"}) {
+		t.Fatalf("node 2 should be elected, got %v", leaders)
+	}
+	if term := c.node("2").term; term != 4 {
+		t.Errorf("node 2 should be elected in term 4, got %d", term)
+	}
+}
+
+func TestClusterCheckQuorum(t *testing.T) {
+	c := newCluster(t, 3, defaultSnapshotEntries)
+	defer c.close()
+	c.elect("1")
+
+	// 被隔离的Leader无法获得大多数成员的响应，主动下台
+	c.partition([]string{"1"})
+	c.run(5 * time.Second)
+	if role := c.node("1").character(); role == RoleLeader {
+		t.Error("partitioned leader should step down")
+	}
+	if term := c.node("1").term; term != 1 {
+		t.Errorf("partitioned leader should not increase term, got %d", term)
+	}
+	leaders := c.leaders()
+	if len(leaders) != 1 || leaders[0] == "1" {
+		t.Fatalf("majority should elect new leader, got %v", leaders)
+	}
+	c.heal()
+	c.run(2 * time.Second)
+	if after := c.leaders(); !reflect.DeepEqual(after, leaders) {
+		t.Fatalf("leader %v should stay after heal, got %v", leaders, after)
+	}
+	if r := c.node("1"); r.term != 2 || r.persistence.leaderID != leaders[0] {
+		t.Errorf("node 1 should follow leader %s in term 2, got leader %s term %d", leaders[0], r.persistence.leaderID, r.term)
+	}
+}
+
+func TestClusterStaleLeader(t *testing.T) {
+	c := newCluster(t, 3, defaultSnapshotEntries)
+	defer c.close()
+	c.elect("1")
+	c.propose("1", initEntry(t, "before"))
+
+	c.partition([]string{"1"})
+	c.elect("2")
+	if leaders := c.leaders(); !reflect.DeepEqual(leaders, []string{"1", "2"}) {
+		t.Fatalf("partitioned leader 1 should not know leader 2 is elected, got %v", leaders)
+	}
+	if err := c.node("1").readIndex(); err != ErrNotLeader {
+		t.Errorf("stale leader should refuse linearizable read, got %v", err)
+	}
+	stale := make(chan error, 1)
+	staleIndex, _ := c.node("1").log.lastIndexAndTerm()
+	go func() {
+		stale <- c.node("1").propose(initEntry(t, "stale"))
+	}()
+	deadline := time.Now().Add(settleTimeout)
+	for index, _ := c.node("1").log.lastIndexAndTerm(); index == staleIndex; index, _ = c.node("1").log.lastIndexAndTerm() {
+		if time.Now().After(deadline) {
+			t.Fatal("stale leader should append proposed entry")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	c.propose("2", initEntry(t, "after"))
+
+	c.heal()
+	c.run(2 * time.Second)
+	select {
+	case err := <-stale:
+		if !LeaderChanged(err) {
+			t.Errorf("entry proposed to stale leader should be overwritten, got %v", err)
+		}
+	case <-time.After(settleTimeout):
+		t.Fatal("entry proposed to stale leader should be overwritten")
+	}
+	if leaders := c.leaders(); !reflect.DeepEqual(leaders, []string{"2"}) {
+		t.Fatalf("stale leader 1 should step down, got %v", leaders)
+	}
+	for _, id := range c.ids {
+		if ids := c.stores[id].ids(); !reflect.DeepEqual(ids, []string{"after", "before"}) {
+			t.Errorf("node %s should apply configs of new leader only, got %v", id, ids)
+		}
+	}
+}
+
+func TestClusterConfigReplication(t *testing.T) {
+	c := newCluster(t, 3, 4)
+	defer c.close()
+	c.elect("1")
+	c.propose("1", initEntry(t, "c1"))
+	c.propose("1", initEntry(t, "c2"))
+	c.tick("1")
+	for _, id := range c.ids {
+		if ids := c.stores[id].ids(); !reflect.DeepEqual(ids, []string{"c1", "c2"}) {
+			t.Errorf("node %s should apply replicated configs, got %v", id, ids)
+		}
+	}
+
+	// 停止期间的日志已被Leader压缩，重启后先恢复持久化的配置，再由快照追上Leader
+	c.stop("3")
+	for _, entry := range []*Entry{
+		{Type: EntryType_Recover, ConfigIDs: []string{"c2"}},
+		initEntry(t, "c3"),
+		{Type: EntryType_Delete, ConfigID: "c2"},
+		initEntry(t, "c4"),
+		initEntry(t, "c5"),
+	} {
+		c.propose("1", entry)
+	}
+	if err := c.node("2").readIndex(); nil != err {
+		t.Fatal(err)
+	}
+	if ids := c.stores["2"].ids(); !reflect.DeepEqual(ids, []string{"c3", "c4", "c5"}) {
+		t.Errorf("follower should apply configs committed before linearizable read, got %v", ids)
+	}
+	c.start("3")
+	if ids := c.stores["3"].ids(); !reflect.DeepEqual(ids, []string{"c1", "c2"}) {
+		t.Errorf("restarted node should restore persisted configs, got %v", ids)
+	}
+	c.run(time.Second)
+	for _, id := range c.ids {
+		if ids := c.stores[id].ids(); !reflect.DeepEqual(ids, []string{"c3", "c4", "c5"}) {
+			t.Errorf("node %s should catch up with leader, got %v", id, ids)
+		}
+	}
+	if leaderApplied, applied := c.node("1").log.applied(), c.node("3").log.applied(); applied != leaderApplied {
+		t.Errorf("restarted node should apply to %d, got %d", leaderApplied, applied)
+	}
+}
+
+func TestClusterConcurrency(t *testing.T) {
+	c := newCluster(t, 3, 4)
+	defer c.close()
+	c.elect("1")
+
+	// 各节点的定时任务、节点间请求、提交、读请求及状态查询并发执行，包括Leader被隔离后的重新选举
+	stop := make(chan struct{})
+	var wg sync.WaitGroup
+	for _, id := range c.ids {
+		r := c.node(id)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				case <-time.After(time.Millisecond):
+					_, _ = r.status()
+					_ = r.readIndex()
+					_ = r.leaderURL()
+				}
+			}
+		}()
+	}
+	runTogether := func(d time.Duration) {
+		for elapsed := time.Duration(0); elapsed < d; elapsed += tickInterval {
+			c.advance(tickInterval)
+			c.tickTogether(c.ids...)
+		}
+	}
+	var ids []string
+	for i := 0; i < 10; i++ {
+		ids = append(ids, "c"+strconv.Itoa(i))
+		go c.node("1").propose(initEntry(t, ids[i]))
+		runTogether(tickInterval)
+	}
+	c.partition([]string{"1"})
+	runTogether(5 * time.Second)
+	c.heal()
+	runTogether(2 * time.Second)
+	close(stop)
+	wg.Wait()
+	c.settle()
+
+	leaders := c.leaders()
+	if len(leaders) != 1 || leaders[0] == "1" {
+		t.Fatalf("one of the majority should be elected, got %v", leaders)
+	}
+	c.propose(leaders[0], initEntry(t, "after"))
+	c.tick(leaders[0])
+	for _, id := range c.ids {
+		if got := c.stores[id].ids(); !reflect.DeepEqual(got, append([]string{"after"}, ids...)) {
+			t.Errorf("node %s should apply all configs, got %v", id, got)
+		}
+	}
+}
+
+// isVote 是否为投票请求，不包括预投票
+func TestClusterProposeTimeout(t *testing.T) {
+	c := newCluster(t, 3, defaultSnapshotEntries)
+	defer c.close()
+	c.elect("1")
+
+	// 日志无法复制到大多数节点时按时钟超时返回，并放弃等待该日志
+	c.partition([]string{"1"}, []string{"2", "3"})
+	entry := initEntry(t, "c1")
+	result := make(chan error, 1)
+	go func() {
+		result <- c.node("1").propose(entry)
+	}()
+	for c.clock.pending() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	c.advance(proposeTimeout)
+	if err := <-result; nil == err || err.Error() != "raft propose timeout" {
+		t.Errorf("propose should time out, got %v", err)
+	}
+	if ids := c.stores["1"].ids(); len(ids) != 0 {
+		t.Errorf("uncommitted entry should not be applied, got %v", ids)
+	}
+}
+
+func TestClusterMembership(t *testing.T) {
+	c := newCluster(t, 3, defaultSnapshotEntries)
+	defer c.close()
+
+	// Leader提交当前任期的日志前拒绝成员变更
+	isHeartbeat := func(msg interface{}) bool {
+		_, ok := msg.(*HBeat)
+		return ok
+	}
+	c.delay("1", "2", time.Second, isHeartbeat)
+	c.delay("1", "3", time.Second, isHeartbeat)
+	c.advance(2 * timeout * time.Millisecond)
+	c.tick("1")
+	if c.node("1").character() != RoleLeader {
+		t.Fatal("node 1 should be elected as leader")
+	}
+	if err := c.node("1").proposeMembers(&Node{Id: "4", Url: "node4"}, true); err != errLeaderNotReady {
+		t.Fatalf("membership change should be refused before leader commits in its term, got %v", err)
+	}
+	c.heal()
+	c.run(time.Second)
+	c.propose("1", initEntry(t, "c1"))
+
+	// 新节点加入后追上Leader的日志
+	c.join("4")
+	if mr := c.node("1").changeMembers(&Node{Id: "4", Url: "node4"}, true); !mr.Success {
+		t.Fatalf("node 4 should be added, got %s", mr.ErrMsg)
+	}
+	c.run(time.Second)
+	for _, id := range c.ids {
+		if !c.node(id).isMember("4") {
+			t.Errorf("node %s should treat node 4 as member", id)
+		}
+		if ids := c.stores[id].ids(); !reflect.DeepEqual(ids, []string{"c1"}) {
+			t.Errorf("node %s should apply replicated configs, got %v", id, ids)
+		}
+	}
+
+	// 移除Follower
+	if mr := c.node("1").changeMembers(&Node{Id: "2"}, false); !mr.Success {
+		t.Fatalf("node 2 should be removed, got %s", mr.ErrMsg)
+	}
+	c.run(time.Second)
+	c.stop("2")
+	for _, id := range []string{"1", "3", "4"} {
+		if c.node(id).isMember("2") {
+			t.Errorf("node %s should not treat node 2 as member", id)
+		}
+	}
+
+	// 移除Leader后Leader下台，剩余成员选出新Leader并继续提交
+	if mr := c.node("1").changeMembers(&Node{Id: "1"}, false); !mr.Success {
+		t.Fatalf("leader should be removed, got %s", mr.ErrMsg)
+	}
+	c.run(5 * time.Second)
+	if c.node("1").character() == RoleLeader {
+		t.Error("removed leader should step down")
+	}
+	leaders := c.leaders()
+	if len(leaders) != 1 || leaders[0] == "1" {
+		t.Fatalf("remaining members should elect a new leader, got %v", leaders)
+	}
+	c.propose(leaders[0], initEntry(t, "c2"))
+	c.tick(leaders[0])
+	for _, id := range []string{"3", "4"} {
+		if c.node(id).isMember("1") {
+			t.Errorf("node %s should not treat node 1 as member", id)
+		}
+		if ids := c.stores[id].ids(); !reflect.DeepEqual(ids, []string{"c1", "c2"}) {
+			t.Errorf("node %s should apply configs proposed by new leader, got %v", id, ids)
+		}
+	}
+}
+
+func TestClusterPersistFailure(t *testing.T) {
+	c := newCluster(t, 3, defaultSnapshotEntries)
+	defer c.close()
+	c.elect("1")
+	c.propose("1", initEntry(t, "c1"))
+
+	// 持久化失败的节点不保留未持久化的日志，由其他节点组成大多数提交
+	path := filepath.Join(c.dir, "2")
+	breakPersistence := func() {
+		if err := os.RemoveAll(path); nil != err {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, nil, 0644); nil != err {
+			t.Fatal(err)
+		}
+	}
+	breakPersistence()
+	lastIndex, _ := c.node("2").log.lastIndexAndTerm()
+	c.propose("1", initEntry(t, "c2"))
+	if index, _ := c.node("2").log.lastIndexAndTerm(); index != lastIndex {
+		t.Errorf("node 2 should not keep unpersisted entries, got last index %d want %d", index, lastIndex)
+	}
+	if ids := c.stores["1"].ids(); !reflect.DeepEqual(ids, []string{"c1", "c2"}) {
+		t.Errorf("leader should commit with node 3, got %v", ids)
+	}
+
+	// 恢复后由Leader重新复制，重启后可由持久化的日志及配置恢复
+	if err := os.Remove(path); nil != err {
+		t.Fatal(err)
+	}
+	c.run(time.Second)
+	if ids := c.stores["2"].ids(); !reflect.DeepEqual(ids, []string{"c1", "c2"}) {
+		t.Errorf("node 2 should catch up after persistence recovered, got %v", ids)
+	}
+	c.restart("2")
+	leaderIndex, _ := c.node("1").log.lastIndexAndTerm()
+	if index, _ := c.node("2").log.lastIndexAndTerm(); index != leaderIndex {
+		t.Errorf("restarted node 2 should restore log to %d, got %d", leaderIndex, index)
+	}
+
+	// 投票未能持久化时拒绝投票
+	breakPersistence()
+	c.stop("1")
+	c.advance(2 * timeout * time.Millisecond)
+	lastLogIndex, lastLogTerm := c.node("3").log.lastIndexAndTerm()
+	if rvr := c.node("2").requestVote(&ReqVote{Term: 3, CandidateId: "3", LastLogIndex: lastLogIndex,
+		LastLogTerm: lastLogTerm}); rvr.VoteGranted || c.node("2").persistence.votedFor.id == "3" {
+		t.Error("node 2 should refuse vote when vote can not be persisted")
+	}
+}
+
+func isVote(msg interface{}) bool {
+	rv, ok := msg.(*ReqVote)
+	return ok && !rv.PreVote
+}
+
+// initEntry 新增空配置的配置操作日志
+func initEntry(t *testing.T, configID string) *Entry {
+	data, err := yaml.Marshal(&config.Config{})
+	if nil != err {
+		t.Fatal(err)
+	}
+	return &Entry{Type: EntryType_Init, ConfigID: configID, Data: data}
+}
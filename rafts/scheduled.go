@@ -16,94 +16,139 @@ package rafts
 
 import (
 	"github.com/aberic/gnomon"
-	"github.com/robfig/cron"
-	"strings"
+	"math/rand"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
 const (
-	timeout = 1500 // Raft心跳超时ms
+	timeout = 1500 // Raft心跳超时ms，即最短选举超时，每轮选举超时在timeout至2*timeout之间随机
+	// tickInterval 定时任务间隔，Leader每次执行时发送心跳，Follower每次执行时检查选举是否超时
+	tickInterval = timeout * time.Millisecond / 10
 )
 
+// clock 时钟，测试时可替换为可控时钟
+type clock interface {
+	now() time.Time
+	// after 经过d后向返回的通道发送当前时间
+	after(d time.Duration) <-chan time.Time
+}
+
+// realClock 系统时钟
+type realClock struct{}
+
+func (realClock) now() time.Time {
+	return time.Now()
+}
+
+func (realClock) after(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// tasks 异步任务计数，测试时用于等待集群内所有异步任务完成
+type tasks struct {
+	count int64
+}
+
+func (t *tasks) add() {
+	atomic.AddInt64(&t.count, 1)
+}
+
+func (t *tasks) done() {
+	atomic.AddInt64(&t.count, -1)
+}
+
+// pending 未完成的异步任务数
+func (t *tasks) pending() int64 {
+	return atomic.LoadInt64(&t.count)
+}
+
+// spawn 异步执行任务
+func (t *tasks) spawn(f func()) {
+	t.add()
+	go func() {
+		defer t.done()
+		f()
+	}()
+}
+
 type scheduled struct {
 	// Raft服务
 	raft *Raft
-	// Raft关闭心跳服务
-	tickerEnd chan int8
-	// 检查Raft状态定时任务
-	checkCron *cron.Cron
-	// 检查Raft计时任务可用性通道
-	checkErr chan error
-	// 释放关闭
-	checkRelease chan int8
-	// 心跳定时任务
-	ticker *time.Ticker
-	// 最后一次接收到心跳时间戳ms
+	// 停止定时任务
+	stop chan struct{}
+	// 最后一次接收到心跳或重置选举计时时间戳ms
 	time int64
+	// 本轮选举超时ms，每次重置选举计时时重新随机，避免多个节点同时发起选举
+	electionTimeout int64
+	// 最后一次接收到Leader心跳时间戳ms
+	leaderTime int64
+	// 选举超时随机数
+	random *rand.Rand
+	// 随机数生成锁
+	lock sync.Mutex
 }
 
-// start 启动定时检查raft计时任务可用性任务
+// start 按tickInterval周期执行定时任务
 func (s *scheduled) start() {
-	gnomon.Log().Info("raft", gnomon.Log().Field("start", s.raft.nodes))
-	s.tickerEnd = make(chan int8, 1)
-	s.checkCron = cron.New()
-	s.checkErr = make(chan error, 1)
-	s.checkRelease = make(chan int8, 1)
+	gnomon.Log().Info("raft", gnomon.Log().Field("start", s.raft.others()))
+	s.stop = make(chan struct{})
+	ticker := time.NewTicker(tickInterval)
 	// 如果不异步，会阻塞主线程
-	go s.check()
-}
-
-// check 定时调用此方法检查raft计时任务可用性
-func (s *scheduled) check() {
-	go s.task()
-Loop:
-	for {
-		select {
-		case err := <-s.checkErr:
-			gnomon.Log().Error("raft", gnomon.Log().Field("check err", "reStartCheck"), gnomon.Log().Err(err))
-			s.check()
-		case <-s.checkRelease:
-			break Loop
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				s.tick()
+			case <-s.stop:
+				return
+			}
 		}
-	}
+	}()
 }
 
-// task 心跳及Raft状态检查定时任务方法
-func (s *scheduled) task() {
-	s.checkCron.Stop()
-	err := s.checkCron.AddFunc(strings.Join([]string{"*/3 * * * * ?"}, ""), func() {
-		if s.raft.role.role() == RoleFollower && time.Now().UnixNano()/1e6-s.time > timeout { // 如果自身是follower节点
+// tick 定时任务方法，Leader发送心跳，Follower选举超时后发起预投票
+//
+// scheduled的计时字段由持有raft.lock的调用方读写
+func (s *scheduled) tick() {
+	s.raft.lock.Lock()
+	switch s.raft.role.role() {
+	case RoleLeader: // 如果相等，则说明自身即为 Leader 节点
+		s.raft.role.work()
+	case RoleFollower:
+		if s.raft.isMember(s.raft.self.Id) && s.now()-s.time > s.electionTimeout { // 如果自身是follower节点
 			gnomon.Log().Debug("raft", gnomon.Log().Field("Term", s.raft.term), gnomon.Log().Field("task", "follower timeout"))
-			s.raft.role.candidate()
+			// 选举期间需要等待其他节点响应，释放锁后发起
+			s.raft.lock.Unlock()
+			s.raft.campaign()
+			return
 		}
-	})
-	if nil != err {
-		s.checkErr <- err
-	} else {
-		s.checkCron.Start()
 	}
+	s.raft.lock.Unlock()
 }
 
-// tickerStart 启动心跳定时任务
-func (s *scheduled) tickerStart() {
-	gnomon.Log().Debug("raft", gnomon.Log().Field("Term", s.raft.term), gnomon.Log().Field("cron", "start ticker"))
-	s.ticker = time.NewTicker(timeout * time.Millisecond / 10)
-	go func() {
-	Loop:
-		for {
-			select {
-			case <-s.ticker.C:
-				if s.raft.role.role() == RoleLeader { // 如果相等，则说明自身即为 Leader 节点
-					s.raft.role.work()
-				}
-			case <-s.tickerEnd:
-				break Loop
-			}
-		}
-	}()
+// now 当前时间戳ms
+func (s *scheduled) now() int64 {
+	return s.raft.clock.now().UnixNano() / 1e6
 }
 
-// refreshLastHeartBeatTime 更新最后一次接收到心跳时间戳ms
+// refreshLastHeartBeatTime 更新最后一次接收到心跳时间戳ms，并重置选举计时
 func (s *scheduled) refreshLastHeartBeatTime() {
-	s.time = time.Now().UnixNano() / 1e6
+	s.lock.Lock()
+	s.electionTimeout = timeout + s.random.Int63n(timeout)
+	s.lock.Unlock()
+	s.time = s.now()
+}
+
+// refreshLeaderTime 收到Leader的心跳，更新最后一次接收到Leader心跳时间戳ms
+func (s *scheduled) refreshLeaderTime() {
+	s.leaderTime = s.now()
+	s.refreshLastHeartBeatTime()
+}
+
+// leaderAlive 最短选举超时内是否收到过Leader的心跳，此时Leader仍然有效，拒绝其他节点的选举请求
+func (s *scheduled) leaderAlive() bool {
+	return s.leaderTime > 0 && s.now()-s.leaderTime < timeout
 }
@@ -16,124 +16,272 @@ package rafts
 
 import (
 	"github.com/aberic/fabric-client/config"
-	"github.com/aberic/fabric-client/service"
 	"github.com/aberic/gnomon"
 	"golang.org/x/net/context"
 	"gopkg.in/yaml.v3"
 )
 
+// Server Raft grpc服务，校验请求方后交由环境变量配置的Raft实例处理
 type Server struct{}
 
 // HeartBeat 发送心跳
-func (s *Server) Heartbeat(_ context.Context, hBeat *HBeat) (hbr *HBeatReturn, err error) {
+func (s *Server) Heartbeat(ctx context.Context, hBeat *HBeat) (*HBeatReturn, error) {
+	if err := s.authenticate(ctx, hBeat.LeaderId); nil != err {
+		return nil, err
+	}
+	return obtainRaft().heartbeat(hBeat)
+}
+
+// RequestVote 发起选举，索要选票
+func (s *Server) RequestVote(ctx context.Context, rv *ReqVote) (*ReqVoteReturn, error) {
+	if err := s.authenticate(ctx, rv.CandidateId); nil != err {
+		return nil, err
+	}
+	return obtainRaft().requestVote(rv), nil
+}
+
+// AddNode 向集群中添加节点，由Leader节点通过日志提交
+func (s *Server) AddNode(ctx context.Context, node *Node) (*MemberReturn, error) {
+	if err := s.authorize(ctx); nil != err {
+		return nil, err
+	}
+	return obtainRaft().changeMembers(node, true), nil
+}
+
+// RemoveNode 从集群中移除节点，由Leader节点通过日志提交
+func (s *Server) RemoveNode(ctx context.Context, node *Node) (*MemberReturn, error) {
+	if err := s.authorize(ctx); nil != err {
+		return nil, err
+	}
+	return obtainRaft().changeMembers(node, false), nil
+}
+
+// Status 获取节点状态
+func (s *Server) Status(ctx context.Context, _ *ReqStatus) (*NodeStatus, error) {
+	if err := s.authorize(ctx); nil != err {
+		return nil, err
+	}
+	return Status()
+}
+
+// ReadIndex Leader节点确认自身仍为Leader后返回当前提交索引
+func (s *Server) ReadIndex(ctx context.Context, _ *ReqReadIndex) (*ReadIndexReturn, error) {
+	if err := s.authorize(ctx); nil != err {
+		return nil, err
+	}
+	return obtainRaft().leaderReadIndexReturn()
+}
+
+// InstallSnapshot 安装快照
+func (s *Server) InstallSnapshot(ctx context.Context, snapshot *Snapshot) (*SnapshotReturn, error) {
+	if err := s.authenticate(ctx, snapshot.LeaderId); nil != err {
+		return nil, err
+	}
+	return obtainRaft().installSnapshot(snapshot)
+}
+
+// authenticate 校验raft请求方证书，拒绝未认证或非集群成员发送的请求
+func (s *Server) authenticate(ctx context.Context, nodeID string) error {
+	if err := obtainRaft().authenticate(ctx, nodeID); nil != err {
+		gnomon.Log().Warn("raft", gnomon.Log().Field("refuse", nodeID), gnomon.Log().Err(err))
+		return err
+	}
+	return nil
+}
+
+// authorize 校验成员变更及查询类请求方证书，拒绝非集群成员且非管理员发送的请求
+func (s *Server) authorize(ctx context.Context) error {
+	if err := obtainRaft().authorize(ctx); nil != err {
+		gnomon.Log().Warn("raft", gnomon.Log().Field("refuse", "unauthorized"), gnomon.Log().Err(err))
+		return err
+	}
+	return nil
+}
+
+// heartbeat 处理Leader节点的心跳及日志复制请求，任期、Leader或日志持久化失败时返回错误，由Leader重新发送
+func (r *Raft) heartbeat(hBeat *HBeat) (*HBeatReturn, error) {
+	defer r.lock.Unlock()
+	r.lock.Lock()
 	gnomon.Log().Debug("raft", gnomon.Log().Field("receive heartbeat", hBeat))
-	hbr = &HBeatReturn{}
-	if hBeat.Term < obtainRaft().term {
+	hbr := &HBeatReturn{}
+	var err error
+	if hBeat.Term < r.term {
 		hbr.Success = false
-	} else if hBeat.Term == obtainRaft().term {
-		switch obtainRaft().role.role() {
+	} else if hBeat.Term == r.term {
+		switch r.role.role() {
 		case RoleLeader:
-			obtainRaft().role.candidate()
+			// 同一任期只会选出一个Leader，不因该心跳放弃Leader身份
+			gnomon.Log().Warn("raft", gnomon.Log().Field("refuse heartbeat", hBeat.LeaderId), gnomon.Log().Field("term", hBeat.Term))
 			hbr.Success = false
 		case RoleCandidate:
-			obtainRaft().role.follower()
-			s.syncConfig(hBeat)
-			hbr.Success = true
+			r.role.follower()
+			err = r.appendEntries(hBeat, hbr)
 		case RoleFollower:
-			s.syncConfig(hBeat)
-			hbr.Success = true
+			err = r.appendEntries(hBeat, hbr)
 		}
-	} else if hBeat.Term > obtainRaft().term {
-		switch obtainRaft().role.role() {
-		case RoleLeader, RoleCandidate:
-			obtainRaft().role.follower()
+	} else if hBeat.Term > r.term {
+		if err = r.updateTerm(hBeat.Term); nil == err {
+			err = r.appendEntries(hBeat, hbr)
 		}
-		s.syncConfig(hBeat)
-		hbr.Success = true
 	}
-	hbr.Term = obtainRaft().term
-	return
+	if nil != err {
+		return nil, err
+	}
+	hbr.Term = r.term
+	return hbr, nil
 }
 
-// RequestVote 发起选举，索要选票
-func (s *Server) RequestVote(_ context.Context, rv *ReqVote) (rvr *ReqVoteReturn, err error) {
+// requestVote 处理候选人的投票及预投票请求
+//
+// Leader有效期间拒绝投票请求且不更新任期；否则更高任期的投票请求先使自身更新任期并清空投票，再判断是否投票
+func (r *Raft) requestVote(rv *ReqVote) *ReqVoteReturn {
+	defer r.lock.Unlock()
+	r.lock.Lock()
 	gnomon.Log().Info("raft", gnomon.Log().Field("receive RequestVote", rv))
-	rvr = &ReqVoteReturn{}
-	rvr.Term = obtainRaft().term
-	if rv.Term < obtainRaft().term {
+	rvr := &ReqVoteReturn{}
+	if rv.Term < r.term || (rv.PreVote && rv.Term == r.term) {
 		gnomon.Log().Info("raft", gnomon.Log().Field("refuse", rv),
-			gnomon.Log().Field("termLocal", obtainRaft().term),
+			gnomon.Log().Field("termLocal", r.term),
 			gnomon.Log().Field("termReceive", rv.Term))
-		rvr.VoteGranted = false
-	} else if rv.Term >= obtainRaft().term {
-		rvr.VoteGranted = s.voteFor(rv)
-	}
-	s.syncNodes(&Node{
-		Id:  rv.CandidateId,
-		Url: rv.Url,
-	})
-	return
-}
-
-func (s *Server) syncNodes(node *Node) {
-	have := false
-	for _, n := range obtainRaft().nodes {
-		if n.Id == node.Id {
-			have = true
+	} else if r.role.role() == RoleLeader || r.scheduled.leaderAlive() {
+		gnomon.Log().Info("raft", gnomon.Log().Field("refuse", rv), gnomon.Log().Field("leader", "leader is alive"))
+	} else {
+		var err error
+		if !rv.PreVote {
+			err = r.updateTerm(rv.Term)
+		}
+		if nil != err {
+			gnomon.Log().Warn("raft", gnomon.Log().Field("refuse", rv), gnomon.Log().Err(err))
+		} else if !r.isMember(rv.CandidateId) {
+			gnomon.Log().Warn("raft", gnomon.Log().Field("refuse", rv), gnomon.Log().Field("candidate", "not a member"))
+		} else if !r.log.upToDate(rv.LastLogIndex, rv.LastLogTerm) {
+			gnomon.Log().Info("raft", gnomon.Log().Field("refuse", rv), gnomon.Log().Field("log", "candidate log is out of date"))
+		} else if rv.PreVote {
+			rvr.VoteGranted = true
+		} else {
+			rvr.VoteGranted = r.voteFor(rv)
 		}
 	}
-	if !have {
-		obtainRaft().appendNode(node)
-	}
+	rvr.Term = r.term
+	return rvr
 }
 
-// syncConfig 同步配置信息方案
-func (s *Server) syncConfig(hBeat *HBeat) {
-	if obtainRaft().persistence.leaderID == hBeat.LeaderId && obtainRaft().persistence.version == hBeat.Version && obtainRaft().term == hBeat.Term {
-		obtainRaft().scheduled.refreshLastHeartBeatTime()
-		return
+// leaderReadIndexReturn 自身为Leader时确认自身仍为Leader后返回当前提交索引
+func (r *Raft) leaderReadIndexReturn() (*ReadIndexReturn, error) {
+	r.lock.Lock()
+	l, ok := r.role.(*leader)
+	r.lock.Unlock()
+	if !ok {
+		return nil, ErrNotLeader
+	}
+	index, err := l.readIndex()
+	if nil != err {
+		return nil, err
 	}
+	return &ReadIndexReturn{Index: index}, nil
+}
+
+// installSnapshot 处理Leader节点发送的配置快照
+func (r *Raft) installSnapshot(snapshot *Snapshot) (*SnapshotReturn, error) {
+	gnomon.Log().Info("raft", gnomon.Log().Field("receive snapshot", snapshot.LastIncludedIndex),
+		gnomon.Log().Field("term", snapshot.LastIncludedTerm))
 	var cs map[string]*config.Config
-	if err := yaml.Unmarshal(hBeat.Config, &cs); nil != err {
-		gnomon.Log().Error("raft", gnomon.Log().Err(err))
-	} else {
-		service.RecoverConfig(cs)
-		gnomon.Log().Debug("raft", gnomon.Log().Field("syncConfig", "refresh time"))
-		obtainRaft().term = hBeat.Term
-		obtainRaft().persistence.leaderID = hBeat.LeaderId
-		obtainRaft().persistence.version = hBeat.Version
-		obtainRaft().persistence.currentTerm = hBeat.Term
-		obtainRaft().scheduled.refreshLastHeartBeatTime()
-	}
-}
-
-// voteFor 要求投票节点任期大于当前任期返回方案
-func (s *Server) voteFor(rv *ReqVote) bool {
-	if rv.Term == obtainRaft().term {
-		if rv.Timestamp < obtainRaft().persistence.votedFor.timestamp {
-			s.vote(rv)
-			return true
+	if err := yaml.Unmarshal(snapshot.Data, &cs); nil != err {
+		return nil, err
+	}
+	defer r.lock.Unlock()
+	r.lock.Lock()
+	if snapshot.Term < r.term {
+		return &SnapshotReturn{Term: r.term}, nil
+	}
+	if err := r.updateTerm(snapshot.Term); nil != err {
+		return nil, err
+	}
+	if r.role.role() != RoleFollower {
+		r.role.follower()
+	}
+	if err := r.updateLeader(snapshot.Term, snapshot.LeaderId); nil != err {
+		return nil, err
+	}
+	if nil == cs {
+		cs = map[string]*config.Config{}
+	}
+	if err := r.log.installSnapshot(snapshot.LastIncludedIndex, snapshot.LastIncludedTerm, cs, snapshot.Nodes); nil != err {
+		return nil, err
+	}
+	return &SnapshotReturn{Term: r.term}, nil
+}
+
+// updateLeader 更新任期及Leader信息，并刷新最后一次接收到心跳时间，调用方须持有lock，持久化失败时返回错误
+func (r *Raft) updateLeader(term int32, leaderID string) error {
+	if r.persistence.leaderID != leaderID || r.term != term {
+		r.term = term
+		r.persistence.leaderID = leaderID
+		if err := r.persistence.saveState(r.term); nil != err {
+			return err
 		}
 	}
-	if rv.Term > obtainRaft().persistence.votedFor.term {
-		s.vote(rv)
-		return true
+	r.scheduled.refreshLeaderTime()
+	return nil
+}
+
+// updateTerm 收到更高任期的请求或响应时更新任期、清空Leader及投票并持久化，非Follower角色转换为Follower
+//
+// 须在响应RPC请求之前调用，调用方须持有lock，持久化失败时返回错误，调用方不得据此响应请求
+func (r *Raft) updateTerm(term int32) error {
+	if term <= r.term {
+		return nil
+	}
+	gnomon.Log().Info("raft", gnomon.Log().Field("update term", term), gnomon.Log().Field("termLocal", r.term))
+	r.term = term
+	r.persistence.leaderID = ""
+	r.persistence.votedFor.id = ""
+	r.persistence.votedFor.term = term
+	err := r.persistence.saveState(r.term)
+	if r.role.role() != RoleFollower {
+		r.role.follower()
 	}
-	if rv.Term == obtainRaft().persistence.votedFor.term && gnomon.String().IsEmpty(obtainRaft().persistence.votedFor.id) {
-		s.vote(rv)
-		return true
+	return err
+}
+
+// appendEntries 更新Leader信息并追加Leader复制的日志，持久化失败时返回错误
+func (r *Raft) appendEntries(hBeat *HBeat, hbr *HBeatReturn) error {
+	if err := r.updateLeader(hBeat.Term, hBeat.LeaderId); nil != err {
+		return err
+	}
+	var err error
+	hbr.Success, hbr.LastLogIndex, err = r.log.appendEntries(hBeat.PrevLogIndex, hBeat.PrevLogTerm, hBeat.Entries, hBeat.LeaderCommit)
+	return err
+}
+
+// voteFor 同一任期只投票给一个Candidate，调用前任期已更新至投票请求的任期
+func (r *Raft) voteFor(rv *ReqVote) bool {
+	votedFor := r.persistence.votedFor
+	if rv.Term > votedFor.term {
+		return r.vote(rv)
+	}
+	if rv.Term == votedFor.term && (gnomon.String().IsEmpty(votedFor.id) || votedFor.id == rv.CandidateId) {
+		return r.vote(rv)
 	}
 	gnomon.Log().Info("raft", gnomon.Log().Field("refuse", rv),
-		gnomon.Log().Field("termLocal", obtainRaft().term),
+		gnomon.Log().Field("termLocal", r.term),
 		gnomon.Log().Field("termReceive", rv.Term))
 	return false
 }
 
-func (s *Server) vote(rv *ReqVote) {
-	obtainRaft().persistence.votedFor.id = rv.CandidateId
-	obtainRaft().persistence.votedFor.term = rv.Term
-	obtainRaft().scheduled.refreshLastHeartBeatTime()
+// vote 投票给Candidate并持久化，持久化失败时撤销投票并拒绝，避免重启后同一任期再次投票
+func (r *Raft) vote(rv *ReqVote) bool {
+	votedFor := *r.persistence.votedFor
+	r.persistence.votedFor.id = rv.CandidateId
+	r.persistence.votedFor.term = rv.Term
+	if err := r.persistence.saveState(r.term); nil != err {
+		*r.persistence.votedFor = votedFor
+		gnomon.Log().Warn("raft", gnomon.Log().Field("refuse", rv), gnomon.Log().Err(err))
+		return false
+	}
+	r.scheduled.refreshLastHeartBeatTime()
 	gnomon.Log().Info("raft", gnomon.Log().Field("accept", rv),
-		gnomon.Log().Field("termLocal", obtainRaft().term),
+		gnomon.Log().Field("termLocal", r.term),
 		gnomon.Log().Field("termReceive", rv.Term))
+	return true
 }
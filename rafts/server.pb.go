@@ -22,6 +22,46 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+// entryType 配置操作日志类型
+type EntryType int32
+
+const (
+	// 空操作，Leader当选后追加，用于提交之前任期的日志
+	EntryType_Noop EntryType = 0
+	// 新增或覆盖配置
+	EntryType_Init EntryType = 1
+	// 仅保留configIDs中的配置
+	EntryType_Recover EntryType = 2
+	// 删除配置
+	EntryType_Delete EntryType = 3
+	// 变更集群成员，nodes为变更后的全部成员
+	EntryType_Membership EntryType = 4
+)
+
+var EntryType_name = map[int32]string{
+	0: "Noop",
+	1: "Init",
+	2: "Recover",
+	3: "Delete",
+	4: "Membership",
+}
+
+var EntryType_value = map[string]int32{
+	"Noop":       0,
+	"Init":       1,
+	"Recover":    2,
+	"Delete":     3,
+	"Membership": 4,
+}
+
+func (x EntryType) String() string {
+	return proto.EnumName(EntryType_name, int32(x))
+}
+
+func (EntryType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_08ce3bd0eb6eb3b8, []int{0}
+}
+
 // node 节点信息
 type Node struct {
 	// 节点ID
@@ -72,6 +112,101 @@ func (m *Node) GetUrl() string {
 	return ""
 }
 
+// entry 配置操作日志
+type Entry struct {
+	// 日志索引，从1开始递增
+	Index int32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// 日志写入时Leader节点的任期
+	Term int32 `protobuf:"varint,2,opt,name=term,proto3" json:"term,omitempty"`
+	// 日志类型
+	Type EntryType `protobuf:"varint,3,opt,name=type,proto3,enum=rafts.EntryType" json:"type,omitempty"`
+	// 操作的配置ID
+	ConfigID string `protobuf:"bytes,4,opt,name=configID,proto3" json:"configID,omitempty"`
+	// Init日志的yaml配置内容
+	Data []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	// Recover日志保留的配置ID集合
+	ConfigIDs []string `protobuf:"bytes,6,rep,name=configIDs,proto3" json:"configIDs,omitempty"`
+	// Membership日志变更后的全部集群成员
+	Nodes                []*Node  `protobuf:"bytes,7,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Entry) Reset()         { *m = Entry{} }
+func (m *Entry) String() string { return proto.CompactTextString(m) }
+func (*Entry) ProtoMessage()    {}
+func (*Entry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08ce3bd0eb6eb3b8, []int{1}
+}
+
+func (m *Entry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Entry.Unmarshal(m, b)
+}
+func (m *Entry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Entry.Marshal(b, m, deterministic)
+}
+func (m *Entry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Entry.Merge(m, src)
+}
+func (m *Entry) XXX_Size() int {
+	return xxx_messageInfo_Entry.Size(m)
+}
+func (m *Entry) XXX_DiscardUnknown() {
+	xxx_messageInfo_Entry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Entry proto.InternalMessageInfo
+
+func (m *Entry) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *Entry) GetTerm() int32 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+func (m *Entry) GetType() EntryType {
+	if m != nil {
+		return m.Type
+	}
+	return EntryType_Noop
+}
+
+func (m *Entry) GetConfigID() string {
+	if m != nil {
+		return m.ConfigID
+	}
+	return ""
+}
+
+func (m *Entry) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Entry) GetConfigIDs() []string {
+	if m != nil {
+		return m.ConfigIDs
+	}
+	return nil
+}
+
+func (m *Entry) GetNodes() []*Node {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
 // hBeat 用于Leader节点复制日志给其他节点，也作为心跳
 //
 // prevLogIndex和prevLogTerm表示上一次发送的日志的索引和任期，用于保证收到的日志是连续的
@@ -80,10 +215,14 @@ type HBeat struct {
 	Term int32 `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
 	// Leader节点的ID
 	LeaderId string `protobuf:"bytes,2,opt,name=leaderId,proto3" json:"leaderId,omitempty"`
-	// 当前配置版本 index 递增
-	Version int32 `protobuf:"varint,4,opt,name=version,proto3" json:"version,omitempty"`
-	// 当前term同步配置信息
-	Config               []byte   `protobuf:"bytes,5,opt,name=config,proto3" json:"config,omitempty"`
+	// 新日志之前一条日志的索引
+	PrevLogIndex int32 `protobuf:"varint,3,opt,name=prevLogIndex,proto3" json:"prevLogIndex,omitempty"`
+	// 新日志之前一条日志的任期
+	PrevLogTerm int32 `protobuf:"varint,6,opt,name=prevLogTerm,proto3" json:"prevLogTerm,omitempty"`
+	// 需要复制的日志，为空时仅作为心跳
+	Entries []*Entry `protobuf:"bytes,7,rep,name=entries,proto3" json:"entries,omitempty"`
+	// Leader节点已提交的日志索引
+	LeaderCommit         int32    `protobuf:"varint,8,opt,name=leaderCommit,proto3" json:"leaderCommit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -93,7 +232,7 @@ func (m *HBeat) Reset()         { *m = HBeat{} }
 func (m *HBeat) String() string { return proto.CompactTextString(m) }
 func (*HBeat) ProtoMessage()    {}
 func (*HBeat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_08ce3bd0eb6eb3b8, []int{1}
+	return fileDescriptor_08ce3bd0eb6eb3b8, []int{2}
 }
 
 func (m *HBeat) XXX_Unmarshal(b []byte) error {
@@ -128,20 +267,34 @@ func (m *HBeat) GetLeaderId() string {
 	return ""
 }
 
-func (m *HBeat) GetVersion() int32 {
+func (m *HBeat) GetPrevLogIndex() int32 {
 	if m != nil {
-		return m.Version
+		return m.PrevLogIndex
 	}
 	return 0
 }
 
-func (m *HBeat) GetConfig() []byte {
+func (m *HBeat) GetPrevLogTerm() int32 {
 	if m != nil {
-		return m.Config
+		return m.PrevLogTerm
+	}
+	return 0
+}
+
+func (m *HBeat) GetEntries() []*Entry {
+	if m != nil {
+		return m.Entries
 	}
 	return nil
 }
 
+func (m *HBeat) GetLeaderCommit() int32 {
+	if m != nil {
+		return m.LeaderCommit
+	}
+	return 0
+}
+
 // hBeatReturn 接收者实现逻辑
 //
 // 返回false，如果收到的任期比当前任期小
@@ -157,7 +310,9 @@ type HBeatReturn struct {
 	// 当前任期号，用于Leader节点更新自己的任期（应该说是如果这个返回值比Leader自身的任期大，那么Leader需要更新自己的任期）
 	Term int32 `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
 	// 如果Follower节点匹配prevLogIndex和prevLogTerm，返回true
-	Success              bool     `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Success bool `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	// Follower节点与Leader一致的最后一条日志索引，未匹配时用于Leader快速回退nextIndex
+	LastLogIndex         int32    `protobuf:"varint,3,opt,name=lastLogIndex,proto3" json:"lastLogIndex,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -167,7 +322,7 @@ func (m *HBeatReturn) Reset()         { *m = HBeatReturn{} }
 func (m *HBeatReturn) String() string { return proto.CompactTextString(m) }
 func (*HBeatReturn) ProtoMessage()    {}
 func (*HBeatReturn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_08ce3bd0eb6eb3b8, []int{2}
+	return fileDescriptor_08ce3bd0eb6eb3b8, []int{3}
 }
 
 func (m *HBeatReturn) XXX_Unmarshal(b []byte) error {
@@ -202,6 +357,13 @@ func (m *HBeatReturn) GetSuccess() bool {
 	return false
 }
 
+func (m *HBeatReturn) GetLastLogIndex() int32 {
+	if m != nil {
+		return m.LastLogIndex
+	}
+	return 0
+}
+
 // reqVote 用于Candidate获取选票
 type ReqVote struct {
 	// Candidate的任期
@@ -212,12 +374,14 @@ type ReqVote struct {
 	Url string `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
 	// Candidate最后Leader节点的ID
 	LastLeaderId string `protobuf:"bytes,4,opt,name=lastLeaderId,proto3" json:"lastLeaderId,omitempty"`
-	// Candidate最后一条日志的版本
-	LastVersion int32 `protobuf:"varint,5,opt,name=lastVersion,proto3" json:"lastVersion,omitempty"`
+	// Candidate最后一条日志的索引
+	LastLogIndex int32 `protobuf:"varint,5,opt,name=lastLogIndex,proto3" json:"lastLogIndex,omitempty"`
 	// Candidate最后一条日志的任期
-	LastTerm int32 `protobuf:"varint,6,opt,name=lastTerm,proto3" json:"lastTerm,omitempty"`
+	LastLogTerm int32 `protobuf:"varint,6,opt,name=lastLogTerm,proto3" json:"lastLogTerm,omitempty"`
 	// 时间戳ns
-	Timestamp            int64    `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Timestamp int64 `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// 预投票，term为发起方下一任期，接收者不改变任期及投票，大多数成员同意后发起方才增加任期发起选举
+	PreVote              bool     `protobuf:"varint,8,opt,name=preVote,proto3" json:"preVote,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -227,7 +391,7 @@ func (m *ReqVote) Reset()         { *m = ReqVote{} }
 func (m *ReqVote) String() string { return proto.CompactTextString(m) }
 func (*ReqVote) ProtoMessage()    {}
 func (*ReqVote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_08ce3bd0eb6eb3b8, []int{3}
+	return fileDescriptor_08ce3bd0eb6eb3b8, []int{4}
 }
 
 func (m *ReqVote) XXX_Unmarshal(b []byte) error {
@@ -276,16 +440,16 @@ func (m *ReqVote) GetLastLeaderId() string {
 	return ""
 }
 
-func (m *ReqVote) GetLastVersion() int32 {
+func (m *ReqVote) GetLastLogIndex() int32 {
 	if m != nil {
-		return m.LastVersion
+		return m.LastLogIndex
 	}
 	return 0
 }
 
-func (m *ReqVote) GetLastTerm() int32 {
+func (m *ReqVote) GetLastLogTerm() int32 {
 	if m != nil {
-		return m.LastTerm
+		return m.LastLogTerm
 	}
 	return 0
 }
@@ -297,10 +461,19 @@ func (m *ReqVote) GetTimestamp() int64 {
 	return 0
 }
 
+func (m *ReqVote) GetPreVote() bool {
+	if m != nil {
+		return m.PreVote
+	}
+	return false
+}
+
 // 接收者的实现逻辑
 //
 // 返回false，如果收到的任期比当前任期小
 //
+// 返回false，如果接收者在最短选举超时内收到过Leader的心跳，或自身即为Leader
+//
 // 如果本地状态中votedFor为null或者candidateId，且candidate的日志等于或多余（按照index判断）接收者的日志，则接收者投票给candidate，即返回true
 type ReqVoteReturn struct {
 	// 当前任期，用于Candidate更新自己的任期
@@ -316,7 +489,7 @@ func (m *ReqVoteReturn) Reset()         { *m = ReqVoteReturn{} }
 func (m *ReqVoteReturn) String() string { return proto.CompactTextString(m) }
 func (*ReqVoteReturn) ProtoMessage()    {}
 func (*ReqVoteReturn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_08ce3bd0eb6eb3b8, []int{4}
+	return fileDescriptor_08ce3bd0eb6eb3b8, []int{5}
 }
 
 func (m *ReqVoteReturn) XXX_Unmarshal(b []byte) error {
@@ -351,40 +524,618 @@ func (m *ReqVoteReturn) GetVoteGranted() bool {
 	return false
 }
 
+// snapshot 用于Leader节点向落后于已压缩日志的Follower节点发送快照
+type Snapshot struct {
+	// Leader节点的任期
+	Term int32 `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
+	// Leader节点的ID
+	LeaderId string `protobuf:"bytes,2,opt,name=leaderId,proto3" json:"leaderId,omitempty"`
+	// 快照包含的最后一条日志的索引
+	LastIncludedIndex int32 `protobuf:"varint,3,opt,name=lastIncludedIndex,proto3" json:"lastIncludedIndex,omitempty"`
+	// 快照包含的最后一条日志的任期
+	LastIncludedTerm int32 `protobuf:"varint,4,opt,name=lastIncludedTerm,proto3" json:"lastIncludedTerm,omitempty"`
+	// 快照中yaml格式的配置集合
+	Data []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	// 快照中的全部集群成员，为空时沿用节点启动时的CLUSTER配置
+	Nodes                []*Node  `protobuf:"bytes,6,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Snapshot) Reset()         { *m = Snapshot{} }
+func (m *Snapshot) String() string { return proto.CompactTextString(m) }
+func (*Snapshot) ProtoMessage()    {}
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08ce3bd0eb6eb3b8, []int{6}
+}
+
+func (m *Snapshot) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Snapshot.Unmarshal(m, b)
+}
+func (m *Snapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Snapshot.Marshal(b, m, deterministic)
+}
+func (m *Snapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Snapshot.Merge(m, src)
+}
+func (m *Snapshot) XXX_Size() int {
+	return xxx_messageInfo_Snapshot.Size(m)
+}
+func (m *Snapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_Snapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Snapshot proto.InternalMessageInfo
+
+func (m *Snapshot) GetTerm() int32 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+func (m *Snapshot) GetLeaderId() string {
+	if m != nil {
+		return m.LeaderId
+	}
+	return ""
+}
+
+func (m *Snapshot) GetLastIncludedIndex() int32 {
+	if m != nil {
+		return m.LastIncludedIndex
+	}
+	return 0
+}
+
+func (m *Snapshot) GetLastIncludedTerm() int32 {
+	if m != nil {
+		return m.LastIncludedTerm
+	}
+	return 0
+}
+
+func (m *Snapshot) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *Snapshot) GetNodes() []*Node {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+// snapshotReturn 接收者实现逻辑
+//
+// 如果收到的任期比当前任期小，立即返回
+//
+// 如果存在与快照最后一条日志索引及任期相同的日志，保留其后的日志，否则丢弃全部日志
+//
+// 使用快照重置配置集合
+type SnapshotReturn struct {
+	// 当前任期号，用于Leader节点更新自己的任期
+	Term                 int32    `protobuf:"varint,1,opt,name=term,proto3" json:"term,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SnapshotReturn) Reset()         { *m = SnapshotReturn{} }
+func (m *SnapshotReturn) String() string { return proto.CompactTextString(m) }
+func (*SnapshotReturn) ProtoMessage()    {}
+func (*SnapshotReturn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08ce3bd0eb6eb3b8, []int{7}
+}
+
+func (m *SnapshotReturn) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SnapshotReturn.Unmarshal(m, b)
+}
+func (m *SnapshotReturn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SnapshotReturn.Marshal(b, m, deterministic)
+}
+func (m *SnapshotReturn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotReturn.Merge(m, src)
+}
+func (m *SnapshotReturn) XXX_Size() int {
+	return xxx_messageInfo_SnapshotReturn.Size(m)
+}
+func (m *SnapshotReturn) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotReturn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotReturn proto.InternalMessageInfo
+
+func (m *SnapshotReturn) GetTerm() int32 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+// memberReturn 集群成员变更结果
+type MemberReturn struct {
+	// 是否变更成功
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// 变更失败原因
+	ErrMsg string `protobuf:"bytes,2,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
+	// 变更后的全部集群成员
+	Nodes                []*Node  `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MemberReturn) Reset()         { *m = MemberReturn{} }
+func (m *MemberReturn) String() string { return proto.CompactTextString(m) }
+func (*MemberReturn) ProtoMessage()    {}
+func (*MemberReturn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08ce3bd0eb6eb3b8, []int{8}
+}
+
+func (m *MemberReturn) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MemberReturn.Unmarshal(m, b)
+}
+func (m *MemberReturn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MemberReturn.Marshal(b, m, deterministic)
+}
+func (m *MemberReturn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MemberReturn.Merge(m, src)
+}
+func (m *MemberReturn) XXX_Size() int {
+	return xxx_messageInfo_MemberReturn.Size(m)
+}
+func (m *MemberReturn) XXX_DiscardUnknown() {
+	xxx_messageInfo_MemberReturn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MemberReturn proto.InternalMessageInfo
+
+func (m *MemberReturn) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *MemberReturn) GetErrMsg() string {
+	if m != nil {
+		return m.ErrMsg
+	}
+	return ""
+}
+
+func (m *MemberReturn) GetNodes() []*Node {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+// reqStatus 获取节点状态
+type ReqStatus struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqStatus) Reset()         { *m = ReqStatus{} }
+func (m *ReqStatus) String() string { return proto.CompactTextString(m) }
+func (*ReqStatus) ProtoMessage()    {}
+func (*ReqStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08ce3bd0eb6eb3b8, []int{9}
+}
+
+func (m *ReqStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqStatus.Unmarshal(m, b)
+}
+func (m *ReqStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqStatus.Marshal(b, m, deterministic)
+}
+func (m *ReqStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqStatus.Merge(m, src)
+}
+func (m *ReqStatus) XXX_Size() int {
+	return xxx_messageInfo_ReqStatus.Size(m)
+}
+func (m *ReqStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqStatus proto.InternalMessageInfo
+
+// peerStatus Leader节点记录的Follower节点状态
+type PeerStatus struct {
+	// 节点ID
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 节点地址
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// 最后一次心跳或快照请求是否成功
+	Reachable bool `protobuf:"varint,3,opt,name=reachable,proto3" json:"reachable,omitempty"`
+	// 最后一次收到该节点响应的时间戳ms
+	LastAck int64 `protobuf:"varint,4,opt,name=lastAck,proto3" json:"lastAck,omitempty"`
+	// 已复制到该节点的最大日志索引
+	MatchIndex int32 `protobuf:"varint,5,opt,name=matchIndex,proto3" json:"matchIndex,omitempty"`
+	// 下一条需要发送给该节点的日志索引
+	NextIndex int32 `protobuf:"varint,6,opt,name=nextIndex,proto3" json:"nextIndex,omitempty"`
+	// 最后一次请求失败原因
+	ErrMsg               string   `protobuf:"bytes,7,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PeerStatus) Reset()         { *m = PeerStatus{} }
+func (m *PeerStatus) String() string { return proto.CompactTextString(m) }
+func (*PeerStatus) ProtoMessage()    {}
+func (*PeerStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08ce3bd0eb6eb3b8, []int{10}
+}
+
+func (m *PeerStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PeerStatus.Unmarshal(m, b)
+}
+func (m *PeerStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PeerStatus.Marshal(b, m, deterministic)
+}
+func (m *PeerStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeerStatus.Merge(m, src)
+}
+func (m *PeerStatus) XXX_Size() int {
+	return xxx_messageInfo_PeerStatus.Size(m)
+}
+func (m *PeerStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeerStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeerStatus proto.InternalMessageInfo
+
+func (m *PeerStatus) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *PeerStatus) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *PeerStatus) GetReachable() bool {
+	if m != nil {
+		return m.Reachable
+	}
+	return false
+}
+
+func (m *PeerStatus) GetLastAck() int64 {
+	if m != nil {
+		return m.LastAck
+	}
+	return 0
+}
+
+func (m *PeerStatus) GetMatchIndex() int32 {
+	if m != nil {
+		return m.MatchIndex
+	}
+	return 0
+}
+
+func (m *PeerStatus) GetNextIndex() int32 {
+	if m != nil {
+		return m.NextIndex
+	}
+	return 0
+}
+
+func (m *PeerStatus) GetErrMsg() string {
+	if m != nil {
+		return m.ErrMsg
+	}
+	return ""
+}
+
+// nodeStatus 节点状态
+type NodeStatus struct {
+	// 节点ID
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 节点地址
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// 节点角色 Leader/Candidate/Follower
+	Role string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	// 当前任期
+	Term int32 `protobuf:"varint,4,opt,name=term,proto3" json:"term,omitempty"`
+	// Leader节点ID
+	LeaderId string `protobuf:"bytes,5,opt,name=leaderId,proto3" json:"leaderId,omitempty"`
+	// Leader节点地址
+	LeaderUrl string `protobuf:"bytes,6,opt,name=leaderUrl,proto3" json:"leaderUrl,omitempty"`
+	// 配置版本，即已应用至配置集合的最大日志索引
+	Version int32 `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	// 已提交的最大日志索引
+	CommitIndex int32 `protobuf:"varint,8,opt,name=commitIndex,proto3" json:"commitIndex,omitempty"`
+	// 最后一条日志索引
+	LastLogIndex int32 `protobuf:"varint,9,opt,name=lastLogIndex,proto3" json:"lastLogIndex,omitempty"`
+	// 最后一次收到Leader心跳的时间戳ms
+	LastHeartbeat int64 `protobuf:"varint,10,opt,name=lastHeartbeat,proto3" json:"lastHeartbeat,omitempty"`
+	// 自身是否为集群成员
+	Member bool `protobuf:"varint,11,opt,name=member,proto3" json:"member,omitempty"`
+	// 自身为Leader时各Follower节点状态
+	Peers                []*PeerStatus `protobuf:"bytes,12,rep,name=peers,proto3" json:"peers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *NodeStatus) Reset()         { *m = NodeStatus{} }
+func (m *NodeStatus) String() string { return proto.CompactTextString(m) }
+func (*NodeStatus) ProtoMessage()    {}
+func (*NodeStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08ce3bd0eb6eb3b8, []int{11}
+}
+
+func (m *NodeStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeStatus.Unmarshal(m, b)
+}
+func (m *NodeStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeStatus.Marshal(b, m, deterministic)
+}
+func (m *NodeStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeStatus.Merge(m, src)
+}
+func (m *NodeStatus) XXX_Size() int {
+	return xxx_messageInfo_NodeStatus.Size(m)
+}
+func (m *NodeStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeStatus proto.InternalMessageInfo
+
+func (m *NodeStatus) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *NodeStatus) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *NodeStatus) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *NodeStatus) GetTerm() int32 {
+	if m != nil {
+		return m.Term
+	}
+	return 0
+}
+
+func (m *NodeStatus) GetLeaderId() string {
+	if m != nil {
+		return m.LeaderId
+	}
+	return ""
+}
+
+func (m *NodeStatus) GetLeaderUrl() string {
+	if m != nil {
+		return m.LeaderUrl
+	}
+	return ""
+}
+
+func (m *NodeStatus) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *NodeStatus) GetCommitIndex() int32 {
+	if m != nil {
+		return m.CommitIndex
+	}
+	return 0
+}
+
+func (m *NodeStatus) GetLastLogIndex() int32 {
+	if m != nil {
+		return m.LastLogIndex
+	}
+	return 0
+}
+
+func (m *NodeStatus) GetLastHeartbeat() int64 {
+	if m != nil {
+		return m.LastHeartbeat
+	}
+	return 0
+}
+
+func (m *NodeStatus) GetMember() bool {
+	if m != nil {
+		return m.Member
+	}
+	return false
+}
+
+func (m *NodeStatus) GetPeers() []*PeerStatus {
+	if m != nil {
+		return m.Peers
+	}
+	return nil
+}
+
+// reqReadIndex Follower节点向Leader节点获取线性一致读的提交索引
+type ReqReadIndex struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReqReadIndex) Reset()         { *m = ReqReadIndex{} }
+func (m *ReqReadIndex) String() string { return proto.CompactTextString(m) }
+func (*ReqReadIndex) ProtoMessage()    {}
+func (*ReqReadIndex) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08ce3bd0eb6eb3b8, []int{12}
+}
+
+func (m *ReqReadIndex) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReqReadIndex.Unmarshal(m, b)
+}
+func (m *ReqReadIndex) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReqReadIndex.Marshal(b, m, deterministic)
+}
+func (m *ReqReadIndex) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReqReadIndex.Merge(m, src)
+}
+func (m *ReqReadIndex) XXX_Size() int {
+	return xxx_messageInfo_ReqReadIndex.Size(m)
+}
+func (m *ReqReadIndex) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReqReadIndex.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReqReadIndex proto.InternalMessageInfo
+
+// readIndexReturn 线性一致读的提交索引
+type ReadIndexReturn struct {
+	// Leader节点确认自身仍为Leader时的提交索引
+	Index                int32    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReadIndexReturn) Reset()         { *m = ReadIndexReturn{} }
+func (m *ReadIndexReturn) String() string { return proto.CompactTextString(m) }
+func (*ReadIndexReturn) ProtoMessage()    {}
+func (*ReadIndexReturn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_08ce3bd0eb6eb3b8, []int{13}
+}
+
+func (m *ReadIndexReturn) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReadIndexReturn.Unmarshal(m, b)
+}
+func (m *ReadIndexReturn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReadIndexReturn.Marshal(b, m, deterministic)
+}
+func (m *ReadIndexReturn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReadIndexReturn.Merge(m, src)
+}
+func (m *ReadIndexReturn) XXX_Size() int {
+	return xxx_messageInfo_ReadIndexReturn.Size(m)
+}
+func (m *ReadIndexReturn) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReadIndexReturn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReadIndexReturn proto.InternalMessageInfo
+
+func (m *ReadIndexReturn) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("rafts.EntryType", EntryType_name, EntryType_value)
 	proto.RegisterType((*Node)(nil), "rafts.node")
+	proto.RegisterType((*Entry)(nil), "rafts.entry")
 	proto.RegisterType((*HBeat)(nil), "rafts.hBeat")
 	proto.RegisterType((*HBeatReturn)(nil), "rafts.hBeatReturn")
 	proto.RegisterType((*ReqVote)(nil), "rafts.reqVote")
 	proto.RegisterType((*ReqVoteReturn)(nil), "rafts.reqVoteReturn")
+	proto.RegisterType((*Snapshot)(nil), "rafts.snapshot")
+	proto.RegisterType((*SnapshotReturn)(nil), "rafts.snapshotReturn")
+	proto.RegisterType((*MemberReturn)(nil), "rafts.memberReturn")
+	proto.RegisterType((*ReqStatus)(nil), "rafts.reqStatus")
+	proto.RegisterType((*PeerStatus)(nil), "rafts.peerStatus")
+	proto.RegisterType((*NodeStatus)(nil), "rafts.nodeStatus")
+	proto.RegisterType((*ReqReadIndex)(nil), "rafts.reqReadIndex")
+	proto.RegisterType((*ReadIndexReturn)(nil), "rafts.readIndexReturn")
 }
 
 func init() { proto.RegisterFile("rafts/server.proto", fileDescriptor_08ce3bd0eb6eb3b8) }
 
 var fileDescriptor_08ce3bd0eb6eb3b8 = []byte{
-	// 344 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x52, 0xc1, 0x4a, 0xc3, 0x40,
-	0x10, 0x35, 0x6d, 0xd2, 0xb4, 0x93, 0x5a, 0x64, 0x10, 0x09, 0xc5, 0x43, 0xc8, 0x29, 0xa7, 0x16,
-	0x14, 0x4f, 0xde, 0x04, 0x11, 0xc1, 0x53, 0x90, 0xde, 0xb7, 0xd9, 0xa9, 0x0d, 0xb4, 0x49, 0xbb,
-	0x3b, 0xe9, 0x7f, 0xfa, 0x47, 0x92, 0x31, 0x89, 0x29, 0x14, 0x6f, 0xfb, 0xde, 0xee, 0xcb, 0x9b,
-	0xf7, 0x32, 0x80, 0x46, 0x6d, 0xd8, 0x2e, 0x2d, 0x99, 0x13, 0x99, 0xc5, 0xc1, 0x94, 0x5c, 0xa2,
-	0x27, 0x5c, 0x9c, 0x80, 0x5b, 0x94, 0x9a, 0x70, 0x06, 0x83, 0x5c, 0x87, 0x4e, 0xe4, 0x24, 0x93,
-	0x74, 0x90, 0x6b, 0xbc, 0x81, 0x61, 0x65, 0x76, 0xe1, 0x40, 0x88, 0xfa, 0x18, 0xe7, 0xe0, 0x6d,
-	0x5f, 0x48, 0x31, 0x22, 0xb8, 0x4c, 0x66, 0x2f, 0x8f, 0xbd, 0x54, 0xce, 0x38, 0x87, 0xf1, 0x8e,
-	0x94, 0x26, 0xf3, 0xae, 0x1b, 0x4d, 0x87, 0x31, 0x04, 0xff, 0x44, 0xc6, 0xe6, 0x65, 0x11, 0xba,
-	0x22, 0x69, 0x21, 0xde, 0xc1, 0x28, 0x2b, 0x8b, 0x4d, 0xfe, 0x15, 0x7a, 0x91, 0x93, 0x4c, 0xd3,
-	0x06, 0xc5, 0xcf, 0x10, 0x88, 0x55, 0x4a, 0x5c, 0x99, 0xe2, 0xa2, 0x61, 0x08, 0xbe, 0xad, 0xb2,
-	0x8c, 0xac, 0x15, 0xbf, 0x71, 0xda, 0xc2, 0xf8, 0xdb, 0x01, 0xdf, 0xd0, 0x71, 0x55, 0x32, 0x5d,
-	0x54, 0x46, 0x10, 0x64, 0xaa, 0xd0, 0xb9, 0x56, 0x4c, 0xdd, 0xb4, 0x7d, 0xaa, 0xcd, 0x3e, 0xec,
-	0xb2, 0x63, 0x0c, 0xd3, 0x9d, 0xb2, 0xfc, 0xd1, 0x46, 0x74, 0xe5, 0xea, 0x8c, 0xab, 0xbf, 0x5b,
-	0xe3, 0x55, 0x13, 0xd5, 0x13, 0xcb, 0x3e, 0x25, 0x25, 0x29, 0xcb, 0x9f, 0xf5, 0x44, 0x23, 0xb9,
-	0xee, 0x30, 0xde, 0xc3, 0x84, 0xf3, 0x3d, 0x59, 0x56, 0xfb, 0x43, 0xe8, 0x47, 0x4e, 0x32, 0x4c,
-	0xff, 0x88, 0xf8, 0x15, 0xae, 0x9b, 0x48, 0xff, 0x54, 0x12, 0x41, 0x70, 0x2a, 0x99, 0xde, 0x8c,
-	0x2a, 0x98, 0x74, 0x53, 0x4b, 0x9f, 0x7a, 0x28, 0xc0, 0x4d, 0xd5, 0x86, 0x71, 0x09, 0x93, 0x2d,
-	0x29, 0xc3, 0xeb, 0xfa, 0x77, 0x4e, 0x17, 0xb2, 0x09, 0x0b, 0x69, 0x7c, 0x8e, 0x7d, 0xf4, 0x6b,
-	0x16, 0x5f, 0xe1, 0x13, 0x04, 0x86, 0x8e, 0x15, 0x59, 0x96, 0x5a, 0x67, 0xcd, 0xa3, 0x66, 0xa6,
-	0xf9, 0xed, 0x39, 0x6e, 0x65, 0xeb, 0x91, 0xac, 0xda, 0xe3, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x76, 0xcb, 0xe5, 0x90, 0x80, 0x02, 0x00, 0x00,
+	// 924 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x41, 0x8f, 0xdb, 0x44,
+	0x14, 0x5e, 0xc7, 0x76, 0x62, 0x3f, 0xa7, 0x59, 0xef, 0xb4, 0x54, 0x56, 0x54, 0xa1, 0x60, 0xad,
+	0x68, 0x54, 0xc1, 0x2e, 0x5a, 0xc4, 0x05, 0x4e, 0x40, 0x11, 0x4d, 0x45, 0x7b, 0x98, 0x16, 0xae,
+	0x68, 0xd6, 0x7e, 0xbb, 0xb1, 0x48, 0x6c, 0xef, 0xcc, 0x24, 0xea, 0xfe, 0x37, 0x7e, 0x00, 0x12,
+	0x67, 0xc4, 0x0f, 0xe1, 0xc4, 0x0d, 0xcd, 0x78, 0x6c, 0x4f, 0x36, 0x59, 0x28, 0xb7, 0xf9, 0xbe,
+	0x99, 0xf1, 0x7b, 0xef, 0x9b, 0xef, 0xbd, 0x04, 0x08, 0x67, 0x57, 0x52, 0x9c, 0x0b, 0xe4, 0x5b,
+	0xe4, 0x67, 0x35, 0xaf, 0x64, 0x45, 0x7c, 0xcd, 0xa5, 0x73, 0xf0, 0xca, 0x2a, 0x47, 0x32, 0x81,
+	0x41, 0x91, 0x27, 0xce, 0xcc, 0x99, 0x87, 0x74, 0x50, 0xe4, 0x24, 0x06, 0x77, 0xc3, 0x57, 0xc9,
+	0x40, 0x13, 0x6a, 0x99, 0xfe, 0xe6, 0x80, 0x8f, 0xa5, 0xe4, 0xb7, 0xe4, 0x11, 0xf8, 0x45, 0x99,
+	0xe3, 0x3b, 0x7d, 0xdc, 0xa7, 0x0d, 0x20, 0x04, 0x3c, 0x89, 0x7c, 0xad, 0xaf, 0xf8, 0x54, 0xaf,
+	0xc9, 0x29, 0x78, 0xf2, 0xb6, 0xc6, 0xc4, 0x9d, 0x39, 0xf3, 0xc9, 0x45, 0x7c, 0xa6, 0x63, 0x9e,
+	0xe9, 0xaf, 0xbc, 0xbd, 0xad, 0x91, 0xea, 0x5d, 0x32, 0x85, 0x20, 0xab, 0xca, 0xab, 0xe2, 0x7a,
+	0xf1, 0x3c, 0xf1, 0x74, 0xc0, 0x0e, 0xab, 0xaf, 0xe6, 0x4c, 0xb2, 0xc4, 0x9f, 0x39, 0xf3, 0x31,
+	0xd5, 0x6b, 0xf2, 0x04, 0xc2, 0x76, 0x5f, 0x24, 0xc3, 0x99, 0x3b, 0x0f, 0x69, 0x4f, 0x90, 0x8f,
+	0xc0, 0x57, 0x15, 0x89, 0x64, 0x34, 0x73, 0xe7, 0xd1, 0x45, 0x64, 0x82, 0x2a, 0x8e, 0x36, 0x3b,
+	0xe9, 0x1f, 0x0e, 0xf8, 0xcb, 0x6f, 0x90, 0xc9, 0x2e, 0x69, 0xc7, 0x4a, 0x7a, 0x0a, 0xc1, 0x0a,
+	0x59, 0x8e, 0x7c, 0x91, 0x9b, 0xfa, 0x3b, 0x4c, 0x52, 0x18, 0xd7, 0x1c, 0xb7, 0x3f, 0x54, 0xd7,
+	0x0b, 0xad, 0x80, 0xab, 0xef, 0xed, 0x70, 0x64, 0x06, 0x91, 0xc1, 0x6f, 0xd5, 0xa7, 0x87, 0xfa,
+	0x88, 0x4d, 0x91, 0x8f, 0x61, 0xa4, 0x34, 0x28, 0xba, 0x24, 0xc7, 0xb6, 0x32, 0xb4, 0xdd, 0x54,
+	0xd1, 0x9a, 0xc8, 0xdf, 0x56, 0xeb, 0x75, 0x21, 0x93, 0xa0, 0x89, 0x66, 0x73, 0x2f, 0xbd, 0xc0,
+	0x8b, 0xfd, 0x97, 0x5e, 0xe0, 0xc7, 0xc3, 0xf4, 0x67, 0x88, 0x74, 0x59, 0x14, 0xe5, 0x86, 0x97,
+	0x07, 0x8b, 0x4b, 0x60, 0x24, 0x36, 0x59, 0x86, 0x42, 0xe8, 0xda, 0x02, 0xda, 0x42, 0x1d, 0x8c,
+	0x09, 0x79, 0xb7, 0x34, 0x9b, 0x4b, 0xff, 0x72, 0x60, 0xc4, 0xf1, 0xe6, 0xa7, 0x4a, 0xe2, 0xc1,
+	0xaf, 0xcf, 0x20, 0xca, 0x58, 0x99, 0x17, 0x39, 0x93, 0xd8, 0xa9, 0x67, 0x53, 0xad, 0xaf, 0xdc,
+	0xce, 0x57, 0x5d, 0xdc, 0x56, 0xf2, 0xc6, 0x01, 0x3b, 0xdc, 0x5e, 0x6e, 0xfe, 0x7e, 0x6e, 0x2a,
+	0xb6, 0xc1, 0xb6, 0xec, 0x16, 0xa5, 0x7c, 0x23, 0x8b, 0x35, 0x0a, 0xc9, 0xd6, 0x75, 0x32, 0x9a,
+	0x39, 0x73, 0x97, 0xf6, 0x84, 0x52, 0xa6, 0xe6, 0xa8, 0x4a, 0xd3, 0x3a, 0x07, 0xb4, 0x85, 0xe9,
+	0x77, 0xf0, 0xc0, 0x14, 0xfd, 0x2f, 0xc2, 0xce, 0x20, 0xda, 0x56, 0x12, 0xbf, 0xe7, 0xac, 0x94,
+	0x98, 0x1b, 0x71, 0x6d, 0x2a, 0xfd, 0xdd, 0x81, 0x40, 0x94, 0xac, 0x16, 0xcb, 0xea, 0xff, 0x1b,
+	0xef, 0x13, 0x38, 0x51, 0xa5, 0x2c, 0xca, 0x6c, 0xb5, 0xc9, 0x31, 0xb7, 0x9f, 0x68, 0x7f, 0x83,
+	0x3c, 0x83, 0xd8, 0x26, 0xb5, 0x20, 0x9e, 0x3e, 0xbc, 0xc7, 0x1f, 0xec, 0xb0, 0xae, 0x87, 0x86,
+	0xf7, 0xf6, 0xd0, 0x29, 0x4c, 0xda, 0x62, 0xee, 0x57, 0x25, 0xcd, 0x60, 0xbc, 0xc6, 0xf5, 0x25,
+	0x72, 0x73, 0xc6, 0xb2, 0x9f, 0xb3, 0x6b, 0xbf, 0xc7, 0x30, 0x44, 0xce, 0x5f, 0x89, 0x6b, 0x53,
+	0xba, 0x41, 0x7d, 0x2a, 0xee, 0xbd, 0xa9, 0x44, 0x10, 0x72, 0xbc, 0x79, 0x23, 0x99, 0xdc, 0x88,
+	0xf4, 0x57, 0x07, 0xa0, 0x46, 0xe4, 0x0d, 0xfc, 0xef, 0xb9, 0xa6, 0x5c, 0xc1, 0x91, 0x65, 0x4b,
+	0x76, 0xb9, 0x6a, 0x06, 0x55, 0x40, 0x7b, 0x42, 0x25, 0xac, 0x14, 0xfb, 0x3a, 0xfb, 0x45, 0x0b,
+	0xe8, 0xd2, 0x16, 0x92, 0x0f, 0x01, 0xd6, 0x4c, 0x66, 0x4b, 0xdb, 0x91, 0x16, 0xa3, 0xbe, 0x5b,
+	0xe2, 0x3b, 0xd9, 0x6c, 0x37, 0x6e, 0xec, 0x09, 0xab, 0xdc, 0x91, 0x5d, 0x6e, 0xfa, 0xe7, 0x00,
+	0x40, 0x55, 0xf5, 0xde, 0xe9, 0x13, 0xf0, 0x78, 0x65, 0x32, 0x0f, 0xa9, 0x5e, 0x77, 0x2f, 0xe1,
+	0xdd, 0x63, 0x2e, 0xff, 0x8e, 0xb9, 0x9e, 0x40, 0xd8, 0xac, 0x7f, 0xe4, 0x2b, 0x9d, 0x6a, 0x48,
+	0x7b, 0x42, 0x49, 0xb0, 0x45, 0x2e, 0x8a, 0xaa, 0xd4, 0xb9, 0xfa, 0xb4, 0x85, 0xba, 0xdd, 0xf5,
+	0x14, 0x6a, 0x8a, 0x6c, 0xc6, 0x93, 0x4d, 0xed, 0x35, 0x6e, 0x78, 0xa0, 0x71, 0x4f, 0xe1, 0x81,
+	0xc2, 0x2f, 0x90, 0x71, 0x79, 0x89, 0x4c, 0x26, 0xa0, 0x85, 0xde, 0x25, 0x95, 0x60, 0x8d, 0x93,
+	0x92, 0x48, 0xbf, 0x91, 0x41, 0xe4, 0x29, 0xf8, 0xea, 0xb9, 0x45, 0x32, 0xd6, 0xfe, 0x38, 0x31,
+	0xfe, 0xe8, 0x2d, 0x40, 0x9b, 0xfd, 0x74, 0x02, 0x63, 0x8e, 0x37, 0x14, 0x59, 0xd3, 0x23, 0xe9,
+	0x53, 0x38, 0xe6, 0x2d, 0x30, 0xee, 0x3c, 0xf8, 0xc3, 0xf6, 0xec, 0x05, 0x84, 0xdd, 0x2f, 0x16,
+	0x09, 0xc0, 0x7b, 0x5d, 0x55, 0x75, 0x7c, 0xa4, 0x56, 0x8b, 0xb2, 0x90, 0xb1, 0x43, 0x22, 0x18,
+	0x51, 0xcc, 0xaa, 0x2d, 0xf2, 0x78, 0x40, 0x00, 0x86, 0xcf, 0x71, 0x85, 0x12, 0x63, 0x97, 0x4c,
+	0x00, 0x5e, 0xe9, 0x2c, 0xc5, 0xb2, 0xa8, 0x63, 0xef, 0xe2, 0xef, 0x01, 0x78, 0x94, 0x5d, 0x49,
+	0x72, 0x0e, 0xe1, 0xb2, 0xab, 0xac, 0x1d, 0xfe, 0x7a, 0x74, 0x4f, 0x89, 0x8d, 0x9a, 0xbc, 0xd2,
+	0x23, 0xf2, 0x05, 0x44, 0x1c, 0x6f, 0x36, 0x28, 0xa4, 0x9e, 0xbd, 0x13, 0x73, 0xc8, 0x8c, 0xa5,
+	0xe9, 0xa3, 0x5d, 0xdc, 0x5d, 0xfb, 0x0a, 0x8e, 0x8b, 0x52, 0x48, 0xb6, 0x5a, 0xbd, 0x69, 0x07,
+	0xcf, 0xb1, 0x39, 0xda, 0x36, 0xef, 0xf4, 0x83, 0x3b, 0x44, 0x77, 0xf9, 0x53, 0x18, 0xb1, 0x3c,
+	0x7f, 0xad, 0xfe, 0x1d, 0xd8, 0x5d, 0x37, 0x7d, 0x68, 0x80, 0xdd, 0xd8, 0xe9, 0x11, 0xf9, 0x0c,
+	0x80, 0xe3, 0xba, 0xda, 0xe2, 0x7b, 0xdf, 0x38, 0x87, 0xa1, 0x68, 0x6c, 0x1e, 0xf7, 0xf9, 0x37,
+	0x8f, 0x36, 0x3d, 0xb1, 0xee, 0x9b, 0xce, 0x3e, 0x22, 0x5f, 0x42, 0xd8, 0x3d, 0x19, 0x79, 0xd8,
+	0xdf, 0xe9, 0x1e, 0x75, 0xfa, 0xb8, 0x23, 0x77, 0x5e, 0x36, 0x3d, 0xba, 0x1c, 0xea, 0xbf, 0x3d,
+	0x9f, 0xff, 0x33, 0x00, 0x6d, 0x25, 0xfd, 0xb3, 0x0c, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -403,6 +1154,16 @@ type RaftClient interface {
 	Heartbeat(ctx context.Context, in *HBeat, opts ...grpc.CallOption) (*HBeatReturn, error)
 	// RequestVote 发起选举，索要选票
 	RequestVote(ctx context.Context, in *ReqVote, opts ...grpc.CallOption) (*ReqVoteReturn, error)
+	// InstallSnapshot 安装快照
+	InstallSnapshot(ctx context.Context, in *Snapshot, opts ...grpc.CallOption) (*SnapshotReturn, error)
+	// AddNode 向集群中添加节点，由Leader节点通过日志提交
+	AddNode(ctx context.Context, in *Node, opts ...grpc.CallOption) (*MemberReturn, error)
+	// RemoveNode 从集群中移除节点，由Leader节点通过日志提交
+	RemoveNode(ctx context.Context, in *Node, opts ...grpc.CallOption) (*MemberReturn, error)
+	// Status 获取节点状态
+	Status(ctx context.Context, in *ReqStatus, opts ...grpc.CallOption) (*NodeStatus, error)
+	// ReadIndex 获取线性一致读的提交索引
+	ReadIndex(ctx context.Context, in *ReqReadIndex, opts ...grpc.CallOption) (*ReadIndexReturn, error)
 }
 
 type raftClient struct {
@@ -431,12 +1192,67 @@ func (c *raftClient) RequestVote(ctx context.Context, in *ReqVote, opts ...grpc.
 	return out, nil
 }
 
+func (c *raftClient) InstallSnapshot(ctx context.Context, in *Snapshot, opts ...grpc.CallOption) (*SnapshotReturn, error) {
+	out := new(SnapshotReturn)
+	err := c.cc.Invoke(ctx, "/rafts.Raft/installSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftClient) AddNode(ctx context.Context, in *Node, opts ...grpc.CallOption) (*MemberReturn, error) {
+	out := new(MemberReturn)
+	err := c.cc.Invoke(ctx, "/rafts.Raft/addNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftClient) RemoveNode(ctx context.Context, in *Node, opts ...grpc.CallOption) (*MemberReturn, error) {
+	out := new(MemberReturn)
+	err := c.cc.Invoke(ctx, "/rafts.Raft/removeNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftClient) Status(ctx context.Context, in *ReqStatus, opts ...grpc.CallOption) (*NodeStatus, error) {
+	out := new(NodeStatus)
+	err := c.cc.Invoke(ctx, "/rafts.Raft/status", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *raftClient) ReadIndex(ctx context.Context, in *ReqReadIndex, opts ...grpc.CallOption) (*ReadIndexReturn, error) {
+	out := new(ReadIndexReturn)
+	err := c.cc.Invoke(ctx, "/rafts.Raft/readIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RaftServer is the server API for Raft service.
 type RaftServer interface {
 	// HeartBeat 发送心跳
 	Heartbeat(context.Context, *HBeat) (*HBeatReturn, error)
 	// RequestVote 发起选举，索要选票
 	RequestVote(context.Context, *ReqVote) (*ReqVoteReturn, error)
+	// InstallSnapshot 安装快照
+	InstallSnapshot(context.Context, *Snapshot) (*SnapshotReturn, error)
+	// AddNode 向集群中添加节点，由Leader节点通过日志提交
+	AddNode(context.Context, *Node) (*MemberReturn, error)
+	// RemoveNode 从集群中移除节点，由Leader节点通过日志提交
+	RemoveNode(context.Context, *Node) (*MemberReturn, error)
+	// Status 获取节点状态
+	Status(context.Context, *ReqStatus) (*NodeStatus, error)
+	// ReadIndex 获取线性一致读的提交索引
+	ReadIndex(context.Context, *ReqReadIndex) (*ReadIndexReturn, error)
 }
 
 func RegisterRaftServer(s *grpc.Server, srv RaftServer) {
@@ -479,6 +1295,96 @@ func _Raft_RequestVote_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Raft_InstallSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Snapshot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftServer).InstallSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rafts.Raft/InstallSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftServer).InstallSnapshot(ctx, req.(*Snapshot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Raft_AddNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Node)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftServer).AddNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rafts.Raft/AddNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftServer).AddNode(ctx, req.(*Node))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Raft_RemoveNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Node)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftServer).RemoveNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rafts.Raft/RemoveNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftServer).RemoveNode(ctx, req.(*Node))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Raft_Status_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftServer).Status(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rafts.Raft/Status",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftServer).Status(ctx, req.(*ReqStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Raft_ReadIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReqReadIndex)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RaftServer).ReadIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rafts.Raft/ReadIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RaftServer).ReadIndex(ctx, req.(*ReqReadIndex))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Raft_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rafts.Raft",
 	HandlerType: (*RaftServer)(nil),
@@ -491,6 +1397,26 @@ var _Raft_serviceDesc = grpc.ServiceDesc{
 			MethodName: "requestVote",
 			Handler:    _Raft_RequestVote_Handler,
 		},
+		{
+			MethodName: "installSnapshot",
+			Handler:    _Raft_InstallSnapshot_Handler,
+		},
+		{
+			MethodName: "addNode",
+			Handler:    _Raft_AddNode_Handler,
+		},
+		{
+			MethodName: "removeNode",
+			Handler:    _Raft_RemoveNode_Handler,
+		},
+		{
+			MethodName: "status",
+			Handler:    _Raft_Status_Handler,
+		},
+		{
+			MethodName: "readIndex",
+			Handler:    _Raft_ReadIndex_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rafts/server.proto",
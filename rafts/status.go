@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2019. Aberic - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rafts
+
+import (
+	"errors"
+)
+
+// roleNames 角色名称
+var roleNames = map[int]string{
+	RoleLeader:    "Leader",
+	RoleCandidate: "Candidate",
+	RoleFollower:  "Follower",
+}
+
+// Status 获取自身节点状态，自身为Leader时包含各Follower节点的日志复制进度及可达状态
+func Status() (*NodeStatus, error) {
+	return obtainRaft().status()
+}
+
+func (r *Raft) status() (*NodeStatus, error) {
+	r.lock.Lock()
+	role := r.role
+	if nil == role {
+		r.lock.Unlock()
+		return nil, errors.New("raft cluster is not started")
+	}
+	lastLogIndex, _ := r.log.lastIndexAndTerm()
+	status := &NodeStatus{
+		Id:            r.self.Id,
+		Url:           r.self.Url,
+		Role:          roleNames[role.role()],
+		Term:          r.term,
+		LeaderId:      r.persistence.leaderID,
+		Version:       r.log.applied(),
+		CommitIndex:   r.log.committed(),
+		LastLogIndex:  lastLogIndex,
+		LastHeartbeat: r.scheduled.time,
+		Member:        r.isMember(r.self.Id),
+	}
+	r.lock.Unlock()
+	status.LeaderUrl = r.leaderURL()
+	if status.LeaderId == r.self.Id {
+		status.LeaderUrl = r.self.Url
+	}
+	if l, ok := role.(*leader); ok {
+		status.Peers = l.peers()
+	}
+	return status, nil
+}
@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2019. Aberic - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rafts
+
+import (
+	"github.com/aberic/fabric-client/config"
+	"github.com/aberic/fabric-client/service"
+	"gopkg.in/yaml.v3"
+)
+
+// store 配置集合，已提交的配置操作日志按顺序应用至配置集合
+type store interface {
+	// add 新增或覆盖配置
+	add(configID string, conf *config.Config)
+	// retain 仅保留configIDs中的配置
+	retain(configIDs []string)
+	// delete 删除配置
+	delete(configID string)
+	// reset 使用快照重置配置集合
+	reset(configs map[string]*config.Config)
+	// configs 获取配置集合副本
+	configs() map[string]config.Config
+}
+
+// serviceStore 服务配置集合
+type serviceStore struct{}
+
+func (serviceStore) add(configID string, conf *config.Config) {
+	service.AddConfig(configID, conf)
+}
+
+func (serviceStore) retain(configIDs []string) {
+	service.Recover(configIDs)
+}
+
+func (serviceStore) delete(configID string) {
+	service.DeleteConfig(configID)
+}
+
+func (serviceStore) reset(configs map[string]*config.Config) {
+	service.RecoverConfig(configs)
+}
+
+func (serviceStore) configs() map[string]config.Config {
+	return service.GetASyncConfig()
+}
+
+// applyEntry 将配置操作应用至配置集合
+func applyEntry(s store, entry *Entry) error {
+	switch entry.Type {
+	case EntryType_Init:
+		conf := &config.Config{}
+		if err := yaml.Unmarshal(entry.Data, conf); nil != err {
+			return err
+		}
+		s.add(entry.ConfigID, conf)
+	case EntryType_Recover:
+		s.retain(entry.ConfigIDs)
+	case EntryType_Delete:
+		s.delete(entry.ConfigID)
+	}
+	return nil
+}
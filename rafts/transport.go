@@ -0,0 +1,96 @@
+/*
+ * Copyright (c) 2019. Aberic - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package rafts
+
+import (
+	"context"
+	"github.com/aberic/fabric-client/grpc/proto/utils"
+	"google.golang.org/grpc"
+	"time"
+)
+
+// rpcTimeout 心跳及投票请求超时时间，避免无响应的节点阻塞心跳发送及选举
+const rpcTimeout = timeout * time.Millisecond
+
+// transport 节点间通信，测试时可替换为进程内通信
+type transport interface {
+	// heartbeat 向节点复制日志，也作为心跳
+	heartbeat(node *Node, hBeat *HBeat) (*HBeatReturn, error)
+	// requestVote 向节点索要选票
+	requestVote(node *Node, rv *ReqVote) (*ReqVoteReturn, error)
+	// installSnapshot 向节点发送配置快照
+	installSnapshot(node *Node, snapshot *Snapshot) (*SnapshotReturn, error)
+	// readIndex 向Leader节点获取线性一致读的提交索引
+	readIndex(node *Node) (*ReadIndexReturn, error)
+}
+
+// rpcTransport 通过grpc与其他节点通信
+type rpcTransport struct{}
+
+func (rpcTransport) heartbeat(node *Node, hBeat *HBeat) (*HBeatReturn, error) {
+	hbr, err := utils.RPC(node.Url, func(conn *grpc.ClientConn) (interface{}, error) {
+		// 创建grpc客户端
+		cli := NewRaftClient(conn)
+		ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+		defer cancel()
+		//客户端向grpc服务端发起请求
+		return cli.Heartbeat(ctx, hBeat)
+	})
+	if nil != err {
+		return nil, err
+	}
+	return hbr.(*HBeatReturn), nil
+}
+
+func (rpcTransport) requestVote(node *Node, rv *ReqVote) (*ReqVoteReturn, error) {
+	rvr, err := utils.RPC(node.Url, func(conn *grpc.ClientConn) (interface{}, error) {
+		// 创建grpc客户端
+		cli := NewRaftClient(conn)
+		ctx, cancel := context.WithTimeout(context.Background(), rpcTimeout)
+		defer cancel()
+		//客户端向grpc服务端发起请求
+		return cli.RequestVote(ctx, rv)
+	})
+	if nil != err {
+		return nil, err
+	}
+	return rvr.(*ReqVoteReturn), nil
+}
+
+func (rpcTransport) installSnapshot(node *Node, snapshot *Snapshot) (*SnapshotReturn, error) {
+	sr, err := utils.RPC(node.Url, func(conn *grpc.ClientConn) (interface{}, error) {
+		// 创建grpc客户端
+		cli := NewRaftClient(conn)
+		//客户端向grpc服务端发起请求
+		return cli.InstallSnapshot(context.Background(), snapshot)
+	})
+	if nil != err {
+		return nil, err
+	}
+	return sr.(*SnapshotReturn), nil
+}
+
+func (rpcTransport) readIndex(node *Node) (*ReadIndexReturn, error) {
+	rir, err := utils.RPC(node.Url, func(conn *grpc.ClientConn) (interface{}, error) {
+		// 创建grpc客户端
+		cli := NewRaftClient(conn)
+		//客户端向grpc服务端发起请求
+		return cli.ReadIndex(context.Background(), &ReqReadIndex{})
+	})
+	if nil != err {
+		return nil, err
+	}
+	return rir.(*ReadIndexReturn), nil
+}
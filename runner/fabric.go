@@ -18,9 +18,11 @@ package main
 import (
 	pb "github.com/aberic/fabric-client/grpc/proto/chain"
 	gr "github.com/aberic/fabric-client/grpc/proto/generate"
+	"github.com/aberic/fabric-client/grpc/proto/utils"
 	"github.com/aberic/fabric-client/grpc/server/chains"
 	"github.com/aberic/fabric-client/grpc/server/generate"
 	"github.com/aberic/fabric-client/rafts"
+	"github.com/aberic/fabric-client/service"
 	"github.com/aberic/gnomon"
 	"google.golang.org/grpc"
 	"net"
@@ -39,6 +41,10 @@ func main() {
 		gnomon.Log().Info("raft k8s")
 		rafts.NewRaft()
 	}
+	// 组网时仅由Leader节点检查及通知，避免各节点重复告警
+	if err := service.StartCertExpiryCheck(func() bool { return rafts.Character() == rafts.RoleLeader }); nil != err {
+		gnomon.Log().Error("cert expiry", gnomon.Log().Err(err))
+	}
 	grpcListener()
 }
 
@@ -57,14 +63,21 @@ func logSet() {
 func grpcListener() {
 	var (
 		listener net.Listener
+		options  []grpc.ServerOption
 		err      error
 	)
 	//  创建server端监听端口
 	if listener, err = net.Listen("tcp", ":19877"); nil != err {
 		panic(err)
 	}
+	//  配置节点间通信mTLS时启用TLS
+	if options, err = utils.ServerOptions(); nil != err {
+		panic(err)
+	}
+	//  变更类请求统一由Leader节点处理
+	options = append(options, grpc.UnaryInterceptor(chains.Forward), grpc.StreamInterceptor(chains.ForwardStream))
 	//  创建grpc的server
-	rpcServer := grpc.NewServer()
+	rpcServer := grpc.NewServer(options...)
 
 	//  注册服务
 	gr.RegisterGenerateServer(rpcServer, &generate.CreationServer{})
@@ -32,15 +32,20 @@ func init() {
 	Configs = map[string]*config.Config{}
 }
 
+// Get 获取连接配置，raft日志应用时可能同时变更配置集合，须持有锁读取
 func Get(configID string) *config.Config {
+	defer lock.Unlock()
+	lock.Lock()
 	return Configs[configID]
 }
 
+// GetBytes 获取连接配置的yaml数据
 func GetBytes(configID string) []byte {
-	if nil == Configs[configID] {
+	conf := Get(configID)
+	if nil == conf {
 		return nil
 	}
-	confData, err := yaml.Marshal(Configs[configID])
+	confData, err := yaml.Marshal(conf)
 	if err != nil {
 		gnomon.Log().Debug("client", gnomon.Log().Err(err))
 	}
@@ -69,6 +74,17 @@ func RecoverConfig(configs map[string]*config.Config) {
 	Configs = configs
 }
 
+// ConfigIDs 获取连接配置ID集合
+func ConfigIDs() []string {
+	defer lock.Unlock()
+	lock.Lock()
+	ids := make([]string, 0, len(Configs))
+	for id := range Configs {
+		ids = append(ids, id)
+	}
+	return ids
+}
+
 func GetASyncConfig() map[string]config.Config {
 	defer lock.Unlock()
 	lock.Lock()
@@ -79,7 +95,26 @@ func GetASyncConfig() map[string]config.Config {
 	return cs
 }
 
+// AddConfig 注册已生成的连接配置
+func AddConfig(configID string, conf *config.Config) {
+	defer lock.Unlock()
+	lock.Lock()
+	Configs[configID] = conf
+}
+
+// DeleteConfig 删除连接配置
+func DeleteConfig(configID string) {
+	defer lock.Unlock()
+	lock.Lock()
+	delete(Configs, configID)
+}
+
 func InitConfig(in *pb.ReqInit) {
+	AddConfig(in.Client.ConfigID, NewConfig(in))
+}
+
+// NewConfig 根据初始化请求生成连接配置
+func NewConfig(in *pb.ReqInit) *config.Config {
 	conf := &config.Config{}
 	conf.InitSelfClient(in.Client.Tls, in.Client.LeagueName, in.Client.Organization, in.Client.UserName, in.Client.Level)
 	for _, peer := range in.ChannelPeer {
@@ -111,7 +146,5 @@ func InitConfig(in *pb.ReqInit) {
 	for _, cert := range in.CertificateAuthority {
 		conf.AddOrSetSelfCertificateAuthority(cert.LeagueName, cert.CertName, cert.Url, cert.CaName, cert.EnrollId, cert.EnrollSecret)
 	}
-	defer lock.Unlock()
-	lock.Lock()
-	Configs[in.Client.ConfigID] = conf
+	return conf
 }
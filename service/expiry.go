@@ -0,0 +1,193 @@
+/*
+ * Copyright (c) 2019. Aberic - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/aberic/fabric-client/config"
+	"github.com/aberic/fabric-client/geneses"
+	"github.com/aberic/fabric-client/grpc/proto/generate"
+	"github.com/aberic/gnomon"
+	"github.com/robfig/cron"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+)
+
+// 证书有效期检查环境变量
+const (
+	CertExpiryCron    = "CERT_EXPIRY_CRON"    // CERT_EXPIRY_CRON=0 0 1 * * ? 检查周期，默认每天1点
+	CertExpiryDays    = "CERT_EXPIRY_DAYS"    // CERT_EXPIRY_DAYS=30 证书在该天数内过期时告警
+	CertExpiryWebhook = "CERT_EXPIRY_WEBHOOK" // CERT_EXPIRY_WEBHOOK=http://example.com/hook 告警时以POST JSON通知的地址
+)
+
+// certExpiryNotice webhook通知内容
+type certExpiryNotice struct {
+	WithinDays int32                  `json:"withinDays"`
+	Certs      []*generate.CertExpiry `json:"certs"`
+}
+
+// CertExpiries 汇总联盟crypto-config及服务配置引用证书的有效期，按过期时间升序
+//
+// leagueDomain 为空时扫描所有联盟及服务配置，withinDays 大于0时仅返回该天数内过期的证书
+func CertExpiries(leagueDomain string, withinDays int32) ([]*generate.CertExpiry, error) {
+	var (
+		now           = time.Now()
+		expiries      []*generate.CertExpiry
+		leagueDomains = []string{leagueDomain}
+		err           error
+	)
+	if leagueDomain == "" {
+		if leagueDomains, err = geneses.LeagueDomains(); nil != err {
+			return nil, err
+		}
+	}
+	scanned := map[string]bool{}
+	for _, domain := range leagueDomains {
+		leagueExpiries, err := geneses.LeagueCertExpiries(domain, now)
+		if nil != err {
+			return nil, err
+		}
+		for _, expiry := range leagueExpiries {
+			scanned[expiry.Path] = true
+		}
+		expiries = append(expiries, leagueExpiries...)
+	}
+	if leagueDomain == "" {
+		for configID, conf := range GetASyncConfig() {
+			for _, certPath := range configCertPaths(&conf) {
+				if scanned[certPath] {
+					continue
+				}
+				scanned[certPath] = true
+				configExpiries, err := geneses.CertExpiries(certPath, strings.Join([]string{"config", configID}, ":"), now)
+				if nil != err {
+					gnomon.Log().Warn("cert expiry", gnomon.Log().Field("config", configID), gnomon.Log().Err(err))
+					continue
+				}
+				expiries = append(expiries, configExpiries...)
+			}
+		}
+	}
+	var result []*generate.CertExpiry
+	for _, expiry := range expiries {
+		if withinDays <= 0 || expiry.DaysRemaining < withinDays {
+			result = append(result, expiry)
+		}
+	}
+	sort.SliceStable(result, func(i, j int) bool { return result[i].NotAfter < result[j].NotAfter })
+	return result, nil
+}
+
+// configCertPaths 服务配置中引用的证书路径，包括组织用户证书、客户端tls证书及节点、CA的tls根证书
+func configCertPaths(conf *config.Config) []string {
+	var paths []string
+	appendPath := func(path string) {
+		if path != "" {
+			paths = append(paths, path)
+		}
+	}
+	if nil != conf.Client && nil != conf.Client.TLSCerts && nil != conf.Client.TLSCerts.Client && nil != conf.Client.TLSCerts.Client.Cert {
+		appendPath(conf.Client.TLSCerts.Client.Cert.Path)
+	}
+	for _, org := range conf.Organizations {
+		for _, user := range org.Users {
+			if nil != user.Cert {
+				appendPath(user.Cert.Path)
+			}
+		}
+	}
+	for _, peer := range conf.Peers {
+		if nil != peer.TLSCACerts {
+			appendPath(peer.TLSCACerts.Path)
+		}
+	}
+	for _, orderer := range conf.Orderers {
+		if nil != orderer.TLSCACerts {
+			appendPath(orderer.TLSCACerts.Path)
+		}
+	}
+	for _, ca := range conf.CertificateAuthorities {
+		if nil == ca.TLSCACerts {
+			continue
+		}
+		appendPath(ca.TLSCACerts.Path)
+		if nil != ca.TLSCACerts.Client && nil != ca.TLSCACerts.Client.Cert {
+			appendPath(ca.TLSCACerts.Client.Cert.Path)
+		}
+	}
+	return paths
+}
+
+// StartCertExpiryCheck 按CERT_EXPIRY_CRON周期检查证书有效期，leader不为nil时仅在其返回true的节点上检查，避免集群内重复告警
+func StartCertExpiryCheck(leader func() bool) error {
+	spec := gnomon.Env().GetD(CertExpiryCron, "0 0 1 * * ?")
+	withinDays := int32(gnomon.Env().GetIntD(CertExpiryDays, 30))
+	webhook := gnomon.Env().Get(CertExpiryWebhook)
+	c := cron.New()
+	if err := c.AddFunc(spec, func() {
+		if nil != leader && !leader() {
+			return
+		}
+		if _, err := CheckCertExpiry(withinDays, webhook); nil != err {
+			gnomon.Log().Error("cert expiry", gnomon.Log().Err(err))
+		}
+	}); nil != err {
+		return err
+	}
+	c.Start()
+	gnomon.Log().Info("cert expiry", gnomon.Log().Field("cron", spec), gnomon.Log().Field("withinDays", withinDays))
+	return nil
+}
+
+// CheckCertExpiry 检查withinDays天内过期的证书，记录告警日志，webhook不为空时以POST JSON通知
+func CheckCertExpiry(withinDays int32, webhook string) ([]*generate.CertExpiry, error) {
+	expiries, err := CertExpiries("", withinDays)
+	if nil != err {
+		return nil, err
+	}
+	if len(expiries) == 0 {
+		return nil, nil
+	}
+	for _, expiry := range expiries {
+		gnomon.Log().Warn("cert expiry", gnomon.Log().Field("path", expiry.Path), gnomon.Log().Field("subject", expiry.Subject),
+			gnomon.Log().Field("daysRemaining", expiry.DaysRemaining))
+	}
+	if webhook == "" {
+		return expiries, nil
+	}
+	return expiries, notifyCertExpiry(webhook, &certExpiryNotice{WithinDays: withinDays, Certs: expiries})
+}
+
+func notifyCertExpiry(webhook string, notice *certExpiryNotice) error {
+	data, err := json.Marshal(notice)
+	if nil != err {
+		return err
+	}
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Post(webhook, "application/json", bytes.NewReader(data))
+	if nil != err {
+		return err
+	}
+	defer func() { _ = resp.Body.Close() }()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("cert expiry webhook returns %s", resp.Status)
+	}
+	return nil
+}
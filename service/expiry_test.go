@@ -0,0 +1,101 @@
+/*
+ * Copyright (c) 2019. Aberic - All Rights Reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ * http://www.apache.org/licenses/LICENSE-2.0
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+package service
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"github.com/aberic/fabric-client/config"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestCheckCertExpiry(t *testing.T) {
+	tmpPath, err := ioutil.TempDir("", "cert-expiry")
+	if nil != err {
+		t.Fatal(err)
+	}
+	defer func() { _ = os.RemoveAll(tmpPath) }()
+	certPath := filepath.Join(tmpPath, "Admin@org1.example.com-cert.pem")
+	writeExpiryCert(certPath, 10*24*time.Hour, t)
+	conf := &config.Config{}
+	conf.AddOrSetOrgForOrganizations("Org1", "Org1MSP", tmpPath, map[string]string{"Admin": certPath}, nil, nil)
+	AddConfig("expiry", conf)
+	defer Recover(nil)
+
+	var notice certExpiryNotice
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&notice); nil != err {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+	}))
+	defer server.Close()
+	expiries, err := CheckCertExpiry(30, server.URL)
+	if nil != err {
+		t.Fatal(err)
+	}
+	var found bool
+	for _, expiry := range notice.Certs {
+		if expiry.Path == certPath && expiry.Source == "config:expiry" && expiry.DaysRemaining == 9 {
+			found = true
+		}
+	}
+	if !found || len(notice.Certs) != len(expiries) || notice.WithinDays != 30 {
+		t.Errorf("webhook should be notified with expiring config cert, got %v", notice.Certs)
+	}
+	if expiries, err = CertExpiries("", 5); nil != err {
+		t.Fatal(err)
+	}
+	for _, expiry := range expiries {
+		if expiry.Path == certPath {
+			t.Error("cert expiring after window should be filtered")
+		}
+	}
+	if _, err = CertExpiries("../"+filepath.Base(tmpPath), 0); nil == err {
+		t.Error("league domain with illegal path element should be refused")
+	}
+}
+
+func writeExpiryCert(certPath string, validity time.Duration, t *testing.T) {
+	priKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if nil != err {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "Admin@org1.example.com"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(validity),
+	}
+	certData, err := x509.CreateCertificate(rand.Reader, template, template, &priKey.PublicKey, priKey)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: certData}), 0644); nil != err {
+		t.Fatal(err)
+	}
+}